@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/diag/colors"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
@@ -28,6 +29,7 @@ import (
 
 func newCancelCmd() *cobra.Command {
 	var yes bool
+	var forceUnlock bool
 	var cmd = &cobra.Command{
 		Use:   "cancel [<stack-name>]",
 		Args:  cmdutil.MaximumNArgs(1),
@@ -39,7 +41,11 @@ func newCancelCmd() *cobra.Command {
 			"inconsistent state if a resource operation was pending when the update was canceled.\n" +
 			"\n" +
 			"After this command completes successfully, the stack will be ready for further\n" +
-			"updates.",
+			"updates.\n" +
+			"\n" +
+			"Stacks that are not managed by the Pulumi service can't be canceled, but they are locked\n" +
+			"while an operation is in progress. If an operation crashed and left its lock behind, pass\n" +
+			"--force-unlock to remove the lock.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			// Use the stack provided or, if missing, default to the current one.
 			stack := ""
@@ -56,14 +62,43 @@ func newCancelCmd() *cobra.Command {
 				return err
 			}
 
+			stackName := string(s.Ref().Name())
+
+			// Local stacks have no running update that can be canceled, but their locks may be removed.
+			if localBackend, ok := s.Backend().(filestate.Backend); ok {
+				if !forceUnlock {
+					return errors.New("the `cancel` command is not supported for local stacks; " +
+						"pass --force-unlock to remove a lock left behind by a crashed operation")
+				}
+
+				prompt := fmt.Sprintf("This will remove the lock on '%s' even if an operation is running!", stackName)
+				if !yes && !confirmPrompt(prompt, stackName, opts) {
+					return errors.New("confirmation declined")
+				}
+
+				lock, err := localBackend.ForceUnlock(commandContext(), s.Ref())
+				if err != nil {
+					return err
+				}
+
+				msg := fmt.Sprintf(
+					"%sThe lock held on '%s' by %s@%s (pid %d) for a %s has been removed!%s",
+					colors.SpecAttention, stackName, lock.User, lock.Host, lock.PID, lock.Operation, colors.Reset)
+				fmt.Println(opts.Color.Colorize(msg))
+
+				return nil
+			}
+			if forceUnlock {
+				return errors.New("--force-unlock is only supported for local stacks")
+			}
+
 			// Ensure that we are targeting the Pulumi cloud.
 			backend, ok := s.Backend().(httpstate.Backend)
 			if !ok {
-				return errors.New("the `cancel` command is not supported for local stacks")
+				return errors.New("the `cancel` command is not supported for this stack's backend")
 			}
 
 			// Ensure the user really wants to do this.
-			stackName := string(s.Ref().Name())
 			prompt := fmt.Sprintf("This will irreversibly cancel the currently running update for '%s'!", stackName)
 			if !yes && !confirmPrompt(prompt, stackName, opts) {
 				return errors.New("confirmation declined")
@@ -86,6 +121,9 @@ func newCancelCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&yes, "yes", "y", false,
 		"Skip confirmation prompts, and proceed with cancellation anyway")
+	cmd.PersistentFlags().BoolVar(
+		&forceUnlock, "force-unlock", false,
+		"Remove the lock on a local stack that was left behind by a crashed operation")
 
 	return cmd
 }
@@ -30,6 +30,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/tokens"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
@@ -68,6 +69,7 @@ func newConfigCmd() *cobra.Command {
 		&stack, "stack", "s", "",
 		"The name of the stack to operate on. Defaults to the current stack")
 
+	cmd.AddCommand(newConfigCpCmd(&stack))
 	cmd.AddCommand(newConfigGetCmd(&stack))
 	cmd.AddCommand(newConfigRmCmd(&stack))
 	cmd.AddCommand(newConfigSetCmd(&stack))
@@ -76,11 +78,126 @@ func newConfigCmd() *cobra.Command {
 	return cmd
 }
 
+func newConfigCpCmd(stack *string) *cobra.Command {
+	var path bool
+	var destinationStackName string
+
+	cpCmd := &cobra.Command{
+		Use:   "cp [key]",
+		Short: "Copy config to another stack",
+		Long: "Copy config to another stack.\n" +
+			"\n" +
+			"Copies the config from the current stack, or the one given with --stack, to the stack given\n" +
+			"with --dest. If a key is given, only that key is copied; otherwise, all keys are copied.\n" +
+			"Secrets are decrypted and then re-encrypted for the destination stack.",
+		Args: cmdutil.MaximumNArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if destinationStackName == "" {
+				return errors.New("a destination stack must be given with --dest")
+			}
+			src, err := requireStack(*stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			dest, err := requireStack(destinationStackName, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			if src.Ref().String() == dest.Ref().String() {
+				return errors.New("the source and destination stacks must be different")
+			}
+
+			srcConfig, err := workspace.DetectProjectStack(src.Ref().Name())
+			if err != nil {
+				return err
+			}
+
+			// Only fetch the stacks' crypters if a secret is copied, as doing so may prompt for a passphrase.
+			var decrypter config.Decrypter
+			var encrypter config.Encrypter
+			copyValue := func(v config.Value) (config.Value, error) {
+				if v.Secure() && decrypter == nil {
+					d, cerr := backend.GetStackCrypter(src)
+					if cerr != nil {
+						return config.Value{}, errors.Wrap(cerr, "could not create a decrypter")
+					}
+					e, cerr := backend.GetStackCrypter(dest)
+					if cerr != nil {
+						return config.Value{}, errors.Wrap(cerr, "could not create an encrypter")
+					}
+					decrypter, encrypter = d, e
+				}
+				return v.Copy(decrypter, encrypter)
+			}
+
+			// Copy the values before loading the destination's configuration, as encrypting a value for the first
+			// time may save a new encryption salt to it.
+			var key config.Key
+			var keyPath []interface{}
+			copied := make(config.Map)
+			if len(args) == 0 {
+				for k, v := range srcConfig.Config {
+					newV, copyErr := copyValue(v)
+					if copyErr != nil {
+						return errors.Wrapf(copyErr, "copying configuration key '%s'", prettyKey(k))
+					}
+					copied[k] = newV
+				}
+			} else {
+				if key, keyPath, err = parseConfigKeyPath(args[0], path); err != nil {
+					return errors.Wrap(err, "invalid configuration key")
+				}
+				v, ok, getErr := srcConfig.Config.GetPath(key, keyPath)
+				if getErr != nil {
+					return getErr
+				}
+				if !ok {
+					return errors.Errorf(
+						"configuration key '%s' not found for stack '%s'", args[0], src.Ref())
+				}
+				if copied[key], err = copyValue(v); err != nil {
+					return errors.Wrapf(err, "copying configuration key '%s'", args[0])
+				}
+			}
+
+			destConfig, err := workspace.DetectProjectStack(dest.Ref().Name())
+			if err != nil {
+				return err
+			}
+			for k, v := range copied {
+				if err = destConfig.Config.SetPath(k, keyPath, v); err != nil {
+					return err
+				}
+			}
+
+			return workspace.SaveProjectStack(dest.Ref().Name(), destConfig)
+		}),
+	}
+	cpCmd.PersistentFlags().BoolVar(
+		&path, "path", false,
+		"The key contains a path to a property in a map or list to copy")
+	cpCmd.PersistentFlags().StringVarP(
+		&destinationStackName, "dest", "d", "",
+		"The name of the stack to copy the config to")
+
+	return cpCmd
+}
+
 func newConfigGetCmd(stack *string) *cobra.Command {
+	var path bool
+
 	getCmd := &cobra.Command{
 		Use:   "get <key>",
 		Short: "Get a single configuration value",
-		Args:  cmdutil.SpecificArgs([]string{"key"}),
+		Long: "Get a single configuration value.\n" +
+			"\n" +
+			"Object and array values are printed as JSON. Use --path to get a value nested within an\n" +
+			"object or array, for example `pulumi config get --path 'db.hosts[0]'`.",
+		Args: cmdutil.SpecificArgs([]string{"key"}),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
@@ -91,23 +208,32 @@ func newConfigGetCmd(stack *string) *cobra.Command {
 				return err
 			}
 
-			key, err := parseConfigKey(args[0])
+			key, keyPath, err := parseConfigKeyPath(args[0], path)
 			if err != nil {
 				return errors.Wrap(err, "invalid configuration key")
 			}
 
-			return getConfig(s, key)
+			return getConfig(s, key, keyPath)
 		}),
 	}
+	getCmd.PersistentFlags().BoolVar(
+		&path, "path", false,
+		"The key contains a path to a property in a map or list to get")
 
 	return getCmd
 }
 
 func newConfigRmCmd(stack *string) *cobra.Command {
+	var path bool
+
 	rmCmd := &cobra.Command{
 		Use:   "rm <key>",
 		Short: "Remove configuration value",
-		Args:  cmdutil.SpecificArgs([]string{"key"}),
+		Long: "Remove configuration value.\n" +
+			"\n" +
+			"Use --path to remove a value nested within an object or array, for example\n" +
+			"`pulumi config rm --path 'db.hosts[0]'`.",
+		Args: cmdutil.SpecificArgs([]string{"key"}),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
@@ -119,7 +245,7 @@ func newConfigRmCmd(stack *string) *cobra.Command {
 			}
 			stackName := s.Ref().Name()
 
-			key, err := parseConfigKey(args[0])
+			key, keyPath, err := parseConfigKeyPath(args[0], path)
 			if err != nil {
 				return errors.Wrap(err, "invalid configuration key")
 			}
@@ -130,12 +256,17 @@ func newConfigRmCmd(stack *string) *cobra.Command {
 			}
 
 			if ps.Config != nil {
-				delete(ps.Config, key)
+				if err = ps.Config.RemovePath(key, keyPath); err != nil {
+					return err
+				}
 			}
 
 			return workspace.SaveProjectStack(stackName, ps)
 		}),
 	}
+	rmCmd.PersistentFlags().BoolVar(
+		&path, "path", false,
+		"The key contains a path to a property in a map or list to remove")
 
 	return rmCmd
 }
@@ -215,13 +346,18 @@ func newConfigRefreshCmd(stack *string) *cobra.Command {
 func newConfigSetCmd(stack *string) *cobra.Command {
 	var plaintext bool
 	var secret bool
+	var path bool
 
 	setCmd := &cobra.Command{
 		Use:   "set <key> [value]",
 		Short: "Set configuration value",
 		Long: "Configuration values can be accessed when a stack is being deployed and used to configure behavior. \n" +
 			"If a value is not present on the command line, pulumi will prompt for the value. Multi-line values\n" +
-			"may be set by piping a file to standard in.",
+			"may be set by piping a file to standard in.\n" +
+			"\n" +
+			"Use --path to set a value nested within an object or array, creating the object or array if\n" +
+			"needed. For example, `pulumi config set --path 'db.hosts[0]' example.com` sets the first\n" +
+			"element of the hosts array of the db object. Each nested value may be secret or plaintext.",
 		Args: cmdutil.RangeArgs(1, 2),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			opts := display.Options{
@@ -235,7 +371,7 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 			}
 			stackName := s.Ref().Name()
 
-			key, err := parseConfigKey(args[0])
+			key, keyPath, err := parseConfigKeyPath(args[0], path)
 			if err != nil {
 				return errors.Wrap(err, "invalid configuration key")
 			}
@@ -291,7 +427,9 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 				return err
 			}
 
-			ps.Config[key] = v
+			if err = ps.Config.SetPath(key, keyPath, v); err != nil {
+				return err
+			}
 
 			return workspace.SaveProjectStack(stackName, ps)
 		}),
@@ -303,6 +441,9 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 	setCmd.PersistentFlags().BoolVar(
 		&secret, "secret", false,
 		"Encrypt the value instead of storing it in plaintext")
+	setCmd.PersistentFlags().BoolVar(
+		&path, "path", false,
+		"The key contains a path to a property in a map or list to set")
 
 	return setCmd
 }
@@ -322,6 +463,30 @@ func parseConfigKey(key string) (config.Key, error) {
 	return config.ParseKey(key)
 }
 
+// parseConfigKeyPath parses a configuration key. If path is true, the key is parsed as a property path, such as
+// `app:db.hosts[0]`, whose first element is the configuration key and whose remaining elements locate a value within
+// that key's object or array value.
+func parseConfigKeyPath(key string, path bool) (config.Key, []interface{}, error) {
+	if !path {
+		k, err := parseConfigKey(key)
+		return k, nil, err
+	}
+
+	p, err := resource.ParsePropertyPath(key)
+	if err != nil {
+		return config.Key{}, nil, err
+	}
+	first, ok := p[0].(string)
+	if !ok {
+		return config.Key{}, nil, errors.Errorf("the path '%s' must begin with a configuration key", key)
+	}
+	k, err := parseConfigKey(first)
+	if err != nil {
+		return config.Key{}, nil, err
+	}
+	return k, []interface{}(p[1:]), nil
+}
+
 func prettyKey(k config.Key) string {
 	proj, err := workspace.DetectProject()
 	if err != nil {
@@ -390,7 +555,7 @@ func listConfig(stack backend.Stack, showSecrets bool) error {
 	return nil
 }
 
-func getConfig(stack backend.Stack, key config.Key) error {
+func getConfig(stack backend.Stack, key config.Key, path []interface{}) error {
 	ps, err := workspace.DetectProjectStack(stack.Ref().Name())
 	if err != nil {
 		return err
@@ -398,7 +563,11 @@ func getConfig(stack backend.Stack, key config.Key) error {
 
 	cfg := ps.Config
 
-	if v, ok := cfg[key]; ok {
+	v, ok, err := cfg.GetPath(key, path)
+	if err != nil {
+		return err
+	}
+	if ok {
 		var d config.Decrypter
 		if v.Secure() {
 			var err error
@@ -416,8 +585,12 @@ func getConfig(stack backend.Stack, key config.Key) error {
 		return nil
 	}
 
+	name := prettyKey(key)
+	if len(path) > 0 {
+		name = resource.PropertyPath(append([]interface{}{name}, path...)).String()
+	}
 	return errors.Errorf(
-		"configuration key '%s' not found for stack '%s'", prettyKey(key), stack.Ref())
+		"configuration key '%s' not found for stack '%s'", name, stack.Ref())
 }
 
 var (
@@ -24,6 +24,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/engine"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
 )
 
 func newDestroyCmd() *cobra.Command {
@@ -34,7 +35,10 @@ func newDestroyCmd() *cobra.Command {
 
 	// Flags for engine.UpdateOptions.
 	var analyzers []string
+	var continueOnError bool
 	var diffDisplay bool
+	var eventLogPath string
+	var jsonDisplay bool
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -42,6 +46,8 @@ func newDestroyCmd() *cobra.Command {
 	var showSames bool
 	var nonInteractive bool
 	var skipPreview bool
+	var targets []string
+	var targetDependents bool
 	var yes bool
 
 	var cmd = &cobra.Command{
@@ -58,6 +64,15 @@ func newDestroyCmd() *cobra.Command {
 			"is generally irreversible and should be used with great care.",
 		Args: cmdutil.NoArgs,
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			// The JSON document must be the only thing printed, so a JSON update never prompts or shows a preview,
+			// and therefore must be approved up front.
+			if jsonDisplay {
+				if !yes {
+					return errors.New("--yes must be passed in to proceed when using --json")
+				}
+				nonInteractive, skipPreview = true, true
+			}
+
 			interactive := isInteractive(nonInteractive)
 			if !interactive {
 				yes = true // auto-approve changes, since we cannot prompt.
@@ -67,6 +82,11 @@ func newDestroyCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			eventLog, err := createEventLog(eventLogPath)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(eventLog)
 
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
@@ -75,6 +95,8 @@ func newDestroyCmd() *cobra.Command {
 				ShowSameResources:    showSames,
 				IsInteractive:        interactive,
 				DiffDisplay:          diffDisplay,
+				JSONDisplay:          jsonDisplay,
+				EventLog:             eventLog,
 				Debug:                debug,
 			}
 
@@ -92,11 +114,19 @@ func newDestroyCmd() *cobra.Command {
 				return errors.Wrap(err, "gathering environment metadata")
 			}
 
+			updateTargets, err := parseUpdateTargets(targets, targetDependents)
+			if err != nil {
+				return err
+			}
+
 			opts.Engine = engine.UpdateOptions{
-				Analyzers: analyzers,
-				Parallel:  parallel,
-				Debug:     debug,
-				Refresh:   refresh,
+				Analyzers:        analyzers,
+				Parallel:         parallel,
+				Debug:            debug,
+				Refresh:          refresh,
+				UpdateTargets:    updateTargets,
+				TargetDependents: targetDependents,
+				ContinueOnError:  continueOnError,
 			}
 
 			_, err = s.Destroy(commandContext(), backend.UpdateOperation{
@@ -127,9 +157,18 @@ func newDestroyCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceVar(
 		&analyzers, "analyzer", []string{},
 		"Run one or more analyzers as part of this update")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue the destroy after a resource operation fails, skipping only the operations that depend on it")
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log every engine event to the given file as newline-delimited JSON")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Emit a single JSON document describing the operation instead of a display; requires --yes")
 	cmd.PersistentFlags().BoolVar(
 		&nonInteractive, "non-interactive", false, "Disable interactive mode")
 	cmd.PersistentFlags().IntVarP(
@@ -150,6 +189,13 @@ func newDestroyCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&skipPreview, "skip-preview", false,
 		"Do not perform a preview before performing the destroy")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to destroy. Other resources will not be destroyed. "+
+			"Multiple resources can be specified using --target urn1 --target urn2")
+	cmd.PersistentFlags().BoolVar(
+		&targetDependents, "target-dependents", false,
+		"Allows destroying of dependent targets discovered but not specified in --target list")
 	cmd.PersistentFlags().BoolVarP(
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the destroy after previewing it")
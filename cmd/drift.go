@@ -0,0 +1,282 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+// The exit codes of `pulumi drift` when it does not exit successfully, which it does only if no resources have drifted.
+const (
+	driftExitError = 1 // drift could not be detected.
+	driftExitDrift = 2 // one or more resources have drifted.
+)
+
+func newDriftCmd() *cobra.Command {
+	var debug bool
+	var stack string
+
+	// Flags for engine.UpdateOptions.
+	var jsonDisplay bool
+	var nonInteractive bool
+	var parallel int
+	var targets []string
+
+	var cmd = &cobra.Command{
+		Use:   "drift",
+		Short: "Detect resources that have changed outside of Pulumi",
+		Long: "Detect resources that have changed outside of Pulumi.\n" +
+			"\n" +
+			"This command reads the current state of each of the stack's resources from its provider and\n" +
+			"compares it with the state recorded in the stack's checkpoint, reporting the properties of each\n" +
+			"resource that differ and any resources that have been deleted. Unlike `pulumi refresh`, it never\n" +
+			"changes the stack's state.\n" +
+			"\n" +
+			"The command exits with code 0 if no resources have drifted, 2 if any resources have drifted, and\n" +
+			"1 if drift could not be detected, which makes it suitable for use in scheduled jobs.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			report, err := detectDrift(stack, debug, jsonDisplay, nonInteractive, parallel, targets)
+			if err != nil {
+				return cmdutil.ExitCodeError{Code: driftExitError, Err: err}
+			}
+
+			if jsonDisplay {
+				if err = printJSON(makeDriftReportJSON(report)); err != nil {
+					return cmdutil.ExitCodeError{Code: driftExitError, Err: err}
+				}
+			} else {
+				printDriftReport(report, cmdutil.GetGlobalColorization())
+			}
+
+			if report.HasDrift() {
+				return cmdutil.ExitCodeError{Code: driftExitDrift}
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&debug, "debug", "d", false,
+		"Print detailed debugging output during resource operations")
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+
+	// Flags for engine.UpdateOptions.
+	cmd.PersistentFlags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Emit a single JSON document describing the drift instead of a display")
+	cmd.PersistentFlags().BoolVar(
+		&nonInteractive, "non-interactive", false, "Disable interactive mode")
+	cmd.PersistentFlags().IntVarP(
+		&parallel, "parallel", "p", defaultParallel,
+		"Allow P resource operations to run in parallel at once (<=1 for no parallelism)")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to check for drift. Other resources will not be checked. "+
+			"Multiple resources can be specified using --target urn1 --target urn2")
+
+	return cmd
+}
+
+// detectDrift previews a refresh of the given stack, returning the drift that it observes. The stack's state is never
+// changed.
+func detectDrift(stackName string, debug, jsonDisplay, nonInteractive bool, parallel int,
+	targets []string) (*deploy.DriftReport, error) {
+
+	opts := backend.UpdateOptions{
+		AutoApprove: true,
+		PreviewOnly: true,
+		Display: display.Options{
+			Color:           cmdutil.GetGlobalColorization(),
+			IsInteractive:   isInteractive(nonInteractive) && !jsonDisplay,
+			SuppressDisplay: jsonDisplay,
+			Debug:           debug,
+		},
+	}
+
+	s, err := requireStack(stackName, false, opts.Display, false /*setCurrent*/)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, root, err := readProject()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := getUpdateMetadata("", root)
+	if err != nil {
+		return nil, errors.Wrap(err, "gathering environment metadata")
+	}
+
+	updateTargets, err := parseUpdateTargets(targets, false)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &deploy.DriftReport{}
+	opts.Engine = engine.UpdateOptions{
+		Parallel:      parallel,
+		Debug:         debug,
+		UpdateTargets: updateTargets,
+		RecordDrift:   report,
+	}
+
+	_, err = s.Refresh(commandContext(), backend.UpdateOperation{
+		Proj:   proj,
+		Root:   root,
+		M:      m,
+		Opts:   opts,
+		Scopes: cancellationScopes,
+	})
+	switch {
+	case err == context.Canceled:
+		return nil, errors.New("drift detection cancelled")
+	case err != nil:
+		return nil, PrintEngineError(err)
+	default:
+		return report, nil
+	}
+}
+
+// driftReportJSON is the shape of the JSON output of `pulumi drift`.
+type driftReportJSON struct {
+	Drift     bool                `json:"drift"`
+	Resources []resourceDriftJSON `json:"resources"`
+}
+
+// resourceDriftJSON describes how the actual state of a single resource differs from its state in the checkpoint.
+type resourceDriftJSON struct {
+	URN        resource.URN        `json:"urn"`
+	Type       string              `json:"type"`
+	Deleted    bool                `json:"deleted,omitempty"`
+	Properties []propertyDriftJSON `json:"properties,omitempty"`
+}
+
+// propertyDriftJSON describes a single output property whose actual value differs from its value in the checkpoint.
+// Secret values are never shown; they are displayed as "[secret]".
+type propertyDriftJSON struct {
+	Key string      `json:"key"`
+	Op  string      `json:"op"` // one of "add", "update", or "delete".
+	Old interface{} `json:"old,omitempty"`
+	New interface{} `json:"new,omitempty"`
+}
+
+func makeDriftReportJSON(report *deploy.DriftReport) driftReportJSON {
+	result := driftReportJSON{Drift: report.HasDrift(), Resources: []resourceDriftJSON{}}
+	for _, drift := range report.Resources {
+		result.Resources = append(result.Resources, resourceDriftJSON{
+			URN:        drift.URN,
+			Type:       string(drift.Type),
+			Deleted:    drift.Deleted,
+			Properties: propertyDrift(drift),
+		})
+	}
+	return result
+}
+
+// propertyDrift returns the output properties of the given resource that have drifted, sorted by key.
+func propertyDrift(drift deploy.ResourceDrift) []propertyDriftJSON {
+	if drift.Diff == nil {
+		return nil
+	}
+
+	var result []propertyDriftJSON
+	for _, k := range drift.Diff.Keys() {
+		if v, has := drift.Diff.Adds[k]; has {
+			result = append(result, propertyDriftJSON{Key: string(k), Op: "add", New: driftValue(v)})
+		} else if v, has := drift.Diff.Deletes[k]; has {
+			result = append(result, propertyDriftJSON{Key: string(k), Op: "delete", Old: driftValue(v)})
+		} else if update, has := drift.Diff.Updates[k]; has {
+			result = append(result, propertyDriftJSON{
+				Key: string(k),
+				Op:  "update",
+				Old: driftValue(update.Old),
+				New: driftValue(update.New),
+			})
+		}
+	}
+	return result
+}
+
+// driftValue converts a property value into a JSON-friendly form, hiding any secrets.
+func driftValue(v resource.PropertyValue) interface{} {
+	return v.MapRepl(nil, func(v resource.PropertyValue) (interface{}, bool) {
+		switch {
+		case v.IsComputed() || v.IsOutput():
+			return plugin.UnknownStringValue, true
+		case v.IsSecret():
+			return "[secret]", true
+		default:
+			return nil, false
+		}
+	})
+}
+
+func printDriftReport(report *deploy.DriftReport, color colors.Colorization) {
+	fmt.Printf("\n")
+	if !report.HasDrift() {
+		fmt.Printf("No drift detected\n")
+		return
+	}
+
+	fmt.Printf("%s\n", color.Colorize(fmt.Sprintf("%sDrift detected in %d resource(s):%s",
+		colors.SpecHeadline, len(report.Resources), colors.Reset)))
+	for _, drift := range report.Resources {
+		fmt.Printf("    %s\n", drift.URN)
+		if drift.Deleted {
+			fmt.Printf("        %s\n", color.Colorize(deploy.OpDelete.Prefix()+"deleted"+colors.Reset))
+			continue
+		}
+		for _, p := range propertyDrift(drift) {
+			var line string
+			switch p.Op {
+			case "add":
+				line = fmt.Sprintf("%s%s: %s", deploy.OpCreate.Prefix(), p.Key, formatDriftValue(p.New))
+			case "update":
+				line = fmt.Sprintf("%s%s: %s => %s", deploy.OpUpdate.Prefix(), p.Key,
+					formatDriftValue(p.Old), formatDriftValue(p.New))
+			case "delete":
+				line = fmt.Sprintf("%s%s: %s", deploy.OpDelete.Prefix(), p.Key, formatDriftValue(p.Old))
+			}
+			fmt.Printf("        %s\n", color.Colorize(line+colors.Reset))
+		}
+	}
+}
+
+// formatDriftValue formats a value produced by driftValue for display.
+func formatDriftValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -48,10 +48,13 @@ waiting for changes to these resources to complete. You should confirm whether o
 operations listed completed successfully by checking the state of the appropriate provider.
 For example, if you are using AWS, you can confirm using the AWS Console.
 
-Once you have confirmed the status of the interrupted operations, you can repair your stack
-using 'pulumi stack export' to export your stack to a file. For each operation that succeeded,
-remove that operation from the "pending_operations" section of the file. Once this is complete,
-use 'pulumi stack import' to import the repaired stack.`)
+You can ask Pulumi to do this for you by running 'pulumi refresh --resolve-pending', which reads
+the actual state of each of these resources and adopts or drops it from your stack accordingly.
+
+Alternatively, once you have confirmed the status of the interrupted operations, you can repair
+your stack using 'pulumi stack export' to export your stack to a file. For each operation that
+succeeded, remove that operation from the "pending_operations" section of the file. Once this is
+complete, use 'pulumi stack import' to import the repaired stack.`)
 	contract.IgnoreError(writer.Flush())
 
 	cmdutil.Diag().Errorf(diag.RawMessage("" /*urn*/, buf.String()))
@@ -0,0 +1,302 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newHistoryCmd() *cobra.Command {
+	var stackName string
+	var jsonOut bool
+	var pageSize int
+	var page int
+	var showConfig bool
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the update history of a stack",
+		Long: "Show the update history of a stack.\n" +
+			"\n" +
+			"This command lists the updates that have been performed on a stack, newest first, including\n" +
+			"each update's kind, result, timing, message, resource changes, and information about the\n" +
+			"environment (such as the git commit or CI build) from which it was run.\n" +
+			"\n" +
+			"Use --show-config to also display how the stack's configuration changed in each update.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if pageSize < 0 {
+				return errors.New("--page-size must not be negative")
+			}
+			if page < 1 {
+				return errors.New("--page must be at least 1")
+			}
+
+			s, err := requireStack(stackName, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			updates, err := backend.GetStackHistory(commandContext(), s)
+			if err != nil {
+				return errors.Wrap(err, "getting history")
+			}
+
+			// Compute the config changes before paging, so that the oldest update on a page is compared
+			// against its predecessor rather than against nothing.
+			var configChanges [][]configChange
+			if showConfig {
+				configChanges = make([][]configChange, len(updates))
+				for i := range updates {
+					var prev config.Map
+					if i+1 < len(updates) {
+						prev = updates[i+1].Config
+					}
+					configChanges[i] = diffConfig(prev, updates[i].Config)
+				}
+			}
+
+			start, end := historyPage(len(updates), pageSize, page)
+			if jsonOut {
+				entries := make([]updateInfoJSON, 0, end-start)
+				for i := start; i < end; i++ {
+					var changes []configChange
+					if showConfig {
+						changes = configChanges[i]
+					}
+					entries = append(entries, makeUpdateInfoJSON(updates[i], showConfig, changes))
+				}
+				return printJSON(entries)
+			}
+
+			if start == end {
+				if len(updates) == 0 {
+					fmt.Printf("Stack %s has no updates\n", s.Ref())
+				} else {
+					fmt.Printf("No updates on page %d (stack %s has %d updates)\n", page, s.Ref(), len(updates))
+				}
+				return nil
+			}
+			for i := start; i < end; i++ {
+				var changes []configChange
+				if showConfig {
+					changes = configChanges[i]
+				}
+				printUpdateInfo(updates[i], showConfig, changes, opts)
+			}
+			if end < len(updates) {
+				fmt.Printf("Showing updates %d-%d of %d; use --page %d to see more\n",
+					start+1, end, len(updates), page+1)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the history as JSON")
+	cmd.PersistentFlags().IntVar(
+		&pageSize, "page-size", 10, "The number of updates to show per page; 0 shows all updates")
+	cmd.PersistentFlags().IntVar(
+		&page, "page", 1, "The page of updates to show, starting at 1 for the most recent updates")
+	cmd.PersistentFlags().BoolVar(
+		&showConfig, "show-config", false, "Show the changes to the stack's configuration made by each update")
+
+	return cmd
+}
+
+// historyPage returns the half-open range of indices of the updates to show on the given 1-based page.
+func historyPage(count, pageSize, page int) (int, int) {
+	if pageSize == 0 {
+		if page == 1 {
+			return 0, count
+		}
+		return count, count
+	}
+
+	start := (page - 1) * pageSize
+	if start > count {
+		start = count
+	}
+	end := start + pageSize
+	if end > count {
+		end = count
+	}
+	return start, end
+}
+
+// configChange describes a change to a single configuration value between two updates. Secret values are never
+// shown; they are displayed as "[secret]".
+type configChange struct {
+	Key config.Key `json:"key"`
+	Op  string     `json:"op"` // one of "add", "update", or "delete".
+	Old *string    `json:"old,omitempty"`
+	New *string    `json:"new,omitempty"`
+}
+
+// diffConfig returns the changes made to the configuration between the prev and next configurations, sorted by key.
+func diffConfig(prev, next config.Map) []configChange {
+	blinding := config.NewBlindingDecrypter()
+	valueOf := func(v config.Value) *string {
+		s, err := v.Value(blinding)
+		if err != nil {
+			s = "[unknown]"
+		}
+		return &s
+	}
+
+	var changes []configChange
+	for k, nv := range next {
+		if pv, has := prev[k]; !has {
+			changes = append(changes, configChange{Key: k, Op: "add", New: valueOf(nv)})
+		} else if pv != nv {
+			changes = append(changes, configChange{Key: k, Op: "update", Old: valueOf(pv), New: valueOf(nv)})
+		}
+	}
+	for k, pv := range prev {
+		if _, has := next[k]; !has {
+			changes = append(changes, configChange{Key: k, Op: "delete", Old: valueOf(pv)})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key.String() < changes[j].Key.String()
+	})
+	return changes
+}
+
+// updateInfoJSON is the shape of an update in the JSON output of `pulumi history`.
+type updateInfoJSON struct {
+	Kind            apitype.UpdateKind `json:"kind"`
+	Result          string             `json:"result"`
+	Message         string             `json:"message,omitempty"`
+	StartTime       string             `json:"startTime"`
+	EndTime         string             `json:"endTime,omitempty"`
+	ResourceChanges map[string]int     `json:"resourceChanges,omitempty"`
+	Environment     map[string]string  `json:"environment,omitempty"`
+	Config          map[string]string  `json:"config,omitempty"`
+	ConfigChanges   []configChange     `json:"configChanges,omitempty"`
+}
+
+func makeUpdateInfoJSON(info backend.UpdateInfo, showConfig bool, changes []configChange) updateInfoJSON {
+	result := updateInfoJSON{
+		Kind:        info.Kind,
+		Result:      string(info.Result),
+		Message:     info.Message,
+		StartTime:   time.Unix(info.StartTime, 0).UTC().Format(time.RFC3339),
+		Environment: info.Environment,
+	}
+	if info.EndTime != 0 {
+		result.EndTime = time.Unix(info.EndTime, 0).UTC().Format(time.RFC3339)
+	}
+	if len(info.ResourceChanges) > 0 {
+		result.ResourceChanges = make(map[string]int)
+		for op, count := range info.ResourceChanges {
+			result.ResourceChanges[string(op)] = count
+		}
+	}
+	if showConfig {
+		result.Config = make(map[string]string)
+		blinding := config.NewBlindingDecrypter()
+		for k, v := range info.Config {
+			s, err := v.Value(blinding)
+			if err != nil {
+				s = "[unknown]"
+			}
+			result.Config[k.String()] = s
+		}
+		result.ConfigChanges = changes
+	}
+	return result
+}
+
+func printUpdateInfo(info backend.UpdateInfo, showConfig bool, changes []configChange, opts display.Options) {
+	start := time.Unix(info.StartTime, 0)
+	fmt.Printf("%s\n", opts.Color.Colorize(fmt.Sprintf("%s%s (%s)%s",
+		colors.SpecHeadline, strings.Title(string(info.Kind)), info.Result, colors.Reset)))
+	if info.EndTime != 0 {
+		end := time.Unix(info.EndTime, 0)
+		fmt.Printf("    Started: %s (%v), took %v\n", humanize.Time(start), start, end.Sub(start))
+	} else {
+		fmt.Printf("    Started: %s (%v)\n", humanize.Time(start), start)
+	}
+	if info.Message != "" {
+		fmt.Printf("    Message: %s\n", info.Message)
+	}
+
+	// Print the resource changes in the order in which the engine displays them.
+	var summary []string
+	for _, op := range deploy.StepOps {
+		if count, has := info.ResourceChanges[op]; has && count > 0 {
+			summary = append(summary, opts.Color.Colorize(fmt.Sprintf("%s%d %s%s",
+				op.Prefix(), count, op, colors.Reset)))
+		}
+	}
+	if len(summary) > 0 {
+		fmt.Printf("    Resources: %s\n", strings.Join(summary, ", "))
+	}
+
+	if len(info.Environment) > 0 {
+		fmt.Printf("    Environment:\n")
+		keys := make([]string, 0, len(info.Environment))
+		for k := range info.Environment {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Printf("        %s: %s\n", k, info.Environment[k])
+		}
+	}
+
+	if showConfig {
+		if len(changes) == 0 {
+			fmt.Printf("    Config: no changes\n")
+		} else {
+			fmt.Printf("    Config changes:\n")
+			for _, c := range changes {
+				var line string
+				switch c.Op {
+				case "add":
+					line = fmt.Sprintf("%s%s: %s", deploy.OpCreate.Prefix(), c.Key, *c.New)
+				case "update":
+					line = fmt.Sprintf("%s%s: %s => %s", deploy.OpUpdate.Prefix(), c.Key, *c.Old, *c.New)
+				case "delete":
+					line = fmt.Sprintf("%s%s: %s", deploy.OpDelete.Prefix(), c.Key, *c.Old)
+				}
+				fmt.Printf("        %s\n", opts.Color.Colorize(line+colors.Reset))
+			}
+		}
+	}
+	fmt.Printf("\n")
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource/config"
+)
+
+func TestHistoryPage(t *testing.T) {
+	cases := []struct {
+		count, pageSize, page int
+		start, end            int
+	}{
+		{count: 25, pageSize: 10, page: 1, start: 0, end: 10},
+		{count: 25, pageSize: 10, page: 3, start: 20, end: 25},
+		{count: 25, pageSize: 10, page: 4, start: 25, end: 25},
+		{count: 25, pageSize: 0, page: 1, start: 0, end: 25},
+		{count: 25, pageSize: 0, page: 2, start: 25, end: 25},
+		{count: 0, pageSize: 10, page: 1, start: 0, end: 0},
+	}
+	for _, c := range cases {
+		start, end := historyPage(c.count, c.pageSize, c.page)
+		assert.Equal(t, c.start, start, "%+v", c)
+		assert.Equal(t, c.end, end, "%+v", c)
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	strptr := func(s string) *string { return &s }
+
+	prev := config.Map{
+		config.MustMakeKey("proj", "same"):    config.NewValue("a"),
+		config.MustMakeKey("proj", "changed"): config.NewValue("b"),
+		config.MustMakeKey("proj", "removed"): config.NewValue("c"),
+		config.MustMakeKey("proj", "secret"):  config.NewSecureValue("ciphertext1"),
+	}
+	next := config.Map{
+		config.MustMakeKey("proj", "same"):    config.NewValue("a"),
+		config.MustMakeKey("proj", "changed"): config.NewValue("bb"),
+		config.MustMakeKey("proj", "added"):   config.NewValue("d"),
+		config.MustMakeKey("proj", "secret"):  config.NewSecureValue("ciphertext2"),
+	}
+
+	assert.Equal(t, []configChange{
+		{Key: config.MustMakeKey("proj", "added"), Op: "add", New: strptr("d")},
+		{Key: config.MustMakeKey("proj", "changed"), Op: "update", Old: strptr("b"), New: strptr("bb")},
+		{Key: config.MustMakeKey("proj", "removed"), Op: "delete", Old: strptr("c")},
+		{Key: config.MustMakeKey("proj", "secret"), Op: "update", Old: strptr("[secret]"), New: strptr("[secret]")},
+	}, diffConfig(prev, next))
+
+	// The first update is compared against an empty configuration.
+	assert.Len(t, diffConfig(nil, next), 4)
+	assert.Empty(t, diffConfig(next, next))
+}
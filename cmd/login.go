@@ -59,6 +59,14 @@ func newLoginCmd() *cobra.Command {
 			"will store your state information on your computer underneath ~/.pulumi. It is then up to you to\n" +
 			"manage this state, including backing it up, using it in a team environment, and so on.\n" +
 			"\n" +
+			"To share state without the service, you may instead pass s3://<bucket>[/<prefix>], where checkpoints\n" +
+			"will be stored as objects in the given S3 bucket. Add ?region=<region> to select the bucket's region,\n" +
+			"and ?endpoint=<url> to use an S3-compatible service such as MinIO. For instance,\n" +
+			"\n" +
+			"    $ pulumi login s3://my-state-bucket/team?region=us-west-2\n" +
+			"\n" +
+			"Credentials for S3 are read from the standard AWS environment variables and configuration files.\n" +
+			"\n" +
 			"As a shortcut, you may pass --local to use your home directory (this is an alias for file://~):\n" +
 			"\n" +
 			"    $ pulumi login --local\n",
@@ -0,0 +1,119 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/resource/schema"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+)
+
+func newPluginSchemaCmd() *cobra.Command {
+	var validate bool
+	var cmd = &cobra.Command{
+		Use:   "schema NAME [VERSION]",
+		Args:  cmdutil.RangeArgs(1, 2),
+		Short: "Print the schema of a resource provider plugin",
+		Long: "Print the schema of a resource provider plugin.\n" +
+			"\n" +
+			"The schema is a JSON document that describes the resources, functions, and types\n" +
+			"supported by the provider. The plugin must already be installed.  If VERSION is not\n" +
+			"specified, the latest installed version of the plugin is used.\n" +
+			"\n" +
+			"Pass --validate to check that the schema is well-formed instead of printing it.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			var version *semver.Version
+			if len(args) > 1 {
+				v, err := semver.ParseTolerant(args[1])
+				if err != nil {
+					return errors.Wrap(err, "invalid plugin semver")
+				}
+				version = &v
+			}
+
+			data, err := getProviderSchema(tokens.Package(name), version)
+			if err != nil {
+				return err
+			}
+
+			spec, err := schema.ParsePackageSpec(data)
+			if err != nil {
+				return errors.Wrapf(err, "the schema for resource plugin %s is not valid JSON", name)
+			}
+
+			if validate {
+				if err = spec.Validate(); err != nil {
+					return errors.Wrapf(err, "the schema for resource plugin %s is invalid", name)
+				}
+				fmt.Printf("The schema for resource plugin %s is valid.\n", name)
+				return nil
+			}
+
+			var out bytes.Buffer
+			if err = json.Indent(&out, data, "", "    "); err != nil {
+				return errors.Wrap(err, "formatting schema")
+			}
+			out.WriteString("\n")
+			_, err = out.WriteTo(os.Stdout)
+			return err
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVar(
+		&validate, "validate", false,
+		"Validate the schema rather than printing it")
+
+	return cmd
+}
+
+// getProviderSchema loads the given resource provider plugin and fetches its schema.
+func getProviderSchema(pkg tokens.Package, version *semver.Version) ([]byte, error) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := plugin.NewContext(cmdutil.Diag(), cmdutil.Diag(), nil, nil, nil, pwd, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	prov, err := ctx.Host.Provider(pkg, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading resource plugin %s", pkg)
+	}
+	if prov == nil {
+		return nil, errors.Errorf("could not find resource plugin %s", pkg)
+	}
+
+	data, err := prov.GetSchema(schema.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching schema for resource plugin %s", pkg)
+	}
+	return data, nil
+}
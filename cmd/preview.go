@@ -21,23 +21,30 @@ import (
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
 )
 
 func newPreviewCmd() *cobra.Command {
 	var debug bool
 	var expectNop bool
 	var message string
+	var savePlan string
 	var stack string
 
 	// Flags for engine.UpdateOptions.
 	var analyzers []string
 	var diffDisplay bool
+	var eventLogPath string
+	var jsonDisplay bool
 	var nonInteractive bool
 	var parallel int
 	var showConfig bool
 	var showReplacementSteps bool
 	var showSames bool
+	var targets []string
+	var targetDependents bool
 
 	var cmd = &cobra.Command{
 		Use:        "preview",
@@ -53,23 +60,41 @@ func newPreviewCmd() *cobra.Command {
 			"operations must take place to achieve the desired state. No changes to the stack will\n" +
 			"actually take place.\n" +
 			"\n" +
+			"Use --save-plan to save the planned operations to a file. Passing that file to `pulumi up --plan`\n" +
+			"ensures that the update performs no operations other than those shown by this preview. A plan can\n" +
+			"only be saved if every operation that it describes is fully known during the preview.\n" +
+			"\n" +
 			"The program to run is loaded from the project in the current directory. Use the `-C` or\n" +
 			"`--cwd` flag to use a different directory.",
 		Args: cmdutil.NoArgs,
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			updateTargets, err := parseUpdateTargets(targets, targetDependents)
+			if err != nil {
+				return err
+			}
+			eventLog, err := createEventLog(eventLogPath)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(eventLog)
+
 			opts := backend.UpdateOptions{
 				Engine: engine.UpdateOptions{
-					Analyzers: analyzers,
-					Parallel:  parallel,
-					Debug:     debug,
+					Analyzers:        analyzers,
+					Parallel:         parallel,
+					Debug:            debug,
+					UpdateTargets:    updateTargets,
+					TargetDependents: targetDependents,
 				},
 				Display: display.Options{
 					Color:                cmdutil.GetGlobalColorization(),
 					ShowConfig:           showConfig,
 					ShowReplacementSteps: showReplacementSteps,
 					ShowSameResources:    showSames,
-					IsInteractive:        isInteractive(nonInteractive),
+					IsInteractive:        isInteractive(nonInteractive) && !jsonDisplay,
 					DiffDisplay:          diffDisplay,
+					JSONDisplay:          jsonDisplay,
+					EventLog:             eventLog,
 					Debug:                debug,
 				},
 			}
@@ -79,6 +104,12 @@ func newPreviewCmd() *cobra.Command {
 				return err
 			}
 
+			var plan *deploy.SavedPlan
+			if savePlan != "" {
+				plan = &deploy.SavedPlan{}
+				opts.Engine.RecordPlan = plan
+			}
+
 			proj, root, err := readProject()
 			if err != nil {
 				return err
@@ -101,6 +132,12 @@ func newPreviewCmd() *cobra.Command {
 				return PrintEngineError(err)
 			case expectNop && changes != nil && changes.HasChanges():
 				return errors.New("error: no changes were expected but changes were proposed")
+			case plan != nil:
+				// A plan that depends on unknown values could not be checked before an update changed resources.
+				if err = plan.CheckKnown(); err != nil {
+					return errors.Wrap(err, "the plan cannot be saved")
+				}
+				return writeSavedPlan(savePlan, s, plan)
 			default:
 				return nil
 			}
@@ -120,6 +157,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVarP(
 		&message, "message", "m", "",
 		"Optional message to associate with the preview operation")
+	cmd.PersistentFlags().StringVar(
+		&savePlan, "save-plan", "",
+		"Save the planned operations to the given file, for use with `pulumi up --plan`")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -128,6 +168,12 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log every engine event to the given file as newline-delimited JSON")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Emit a single JSON document describing the planned operations instead of a display")
 	cmd.PersistentFlags().BoolVar(
 		&nonInteractive, "non-interactive", false, "Disable interactive mode")
 	cmd.PersistentFlags().IntVarP(
@@ -142,6 +188,13 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&showSames, "show-sames", false,
 		"Show resources that needn't be updated because they haven't changed, alongside those that do")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to update. Other resources will not be updated. "+
+			"Multiple resources can be specified using --target urn1 --target urn2")
+	cmd.PersistentFlags().BoolVar(
+		&targetDependents, "target-dependents", false,
+		"Allows updating of dependent targets discovered but not specified in --target list")
 
 	return cmd
 }
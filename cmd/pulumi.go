@@ -150,16 +150,20 @@ func NewPulumiCmd() *cobra.Command {
 	cmd.AddCommand(newUpCmd())
 	cmd.AddCommand(newPreviewCmd())
 	cmd.AddCommand(newDestroyCmd())
+	cmd.AddCommand(newWatchCmd())
 	//     - Stack Management Commands:
 	cmd.AddCommand(newStackCmd())
 	cmd.AddCommand(newConfigCmd())
+	cmd.AddCommand(newHistoryCmd())
 	//     - Service Commands:
 	cmd.AddCommand(newLoginCmd())
 	cmd.AddCommand(newLogoutCmd())
 	cmd.AddCommand(newWhoAmICmd())
 	//     - Advanced Commands:
 	cmd.AddCommand(newCancelCmd())
+	cmd.AddCommand(newDriftCmd())
 	cmd.AddCommand(newRefreshCmd())
+	cmd.AddCommand(newStateCmd())
 	//     - Other Commands:
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newPluginCmd())
@@ -24,6 +24,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/engine"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
 )
 
 func newRefreshCmd() *cobra.Command {
@@ -35,12 +36,17 @@ func newRefreshCmd() *cobra.Command {
 	// Flags for engine.UpdateOptions.
 	var analyzers []string
 	var diffDisplay bool
+	var eventLogPath string
+	var jsonDisplay bool
 	var parallel int
+	var resolvePending bool
 	var showConfig bool
 	var showReplacementSteps bool
 	var showSames bool
 	var nonInteractive bool
 	var skipPreview bool
+	var targets []string
+	var targetDependents bool
 	var yes bool
 
 	var cmd = &cobra.Command{
@@ -53,10 +59,23 @@ func newRefreshCmd() *cobra.Command {
 			"the program text isn't updated accordingly, subsequent updates may still appear to be out of\n" +
 			"synch with respect to the cloud provider's source of truth.\n" +
 			"\n" +
+			"If a previous update was interrupted, the stack may contain pending operations whose outcome\n" +
+			"is unknown. Pass `--resolve-pending` to look up each such resource and adopt or drop it\n" +
+			"according to its actual state.\n" +
+			"\n" +
 			"The program to run is loaded from the project in the current directory. Use the `-C` or\n" +
 			"`--cwd` flag to use a different directory.",
 		Args: cmdutil.NoArgs,
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			// The JSON document must be the only thing printed, so a JSON update never prompts or shows a preview,
+			// and therefore must be approved up front.
+			if jsonDisplay {
+				if !yes {
+					return errors.New("--yes must be passed in to proceed when using --json")
+				}
+				nonInteractive, skipPreview = true, true
+			}
+
 			interactive := isInteractive(nonInteractive)
 			if !interactive {
 				yes = true // auto-approve changes, since we cannot prompt.
@@ -66,6 +85,11 @@ func newRefreshCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			eventLog, err := createEventLog(eventLogPath)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(eventLog)
 
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
@@ -74,6 +98,8 @@ func newRefreshCmd() *cobra.Command {
 				ShowSameResources:    showSames,
 				IsInteractive:        interactive,
 				DiffDisplay:          diffDisplay,
+				JSONDisplay:          jsonDisplay,
+				EventLog:             eventLog,
 				Debug:                debug,
 			}
 
@@ -92,10 +118,18 @@ func newRefreshCmd() *cobra.Command {
 				return errors.Wrap(err, "gathering environment metadata")
 			}
 
+			updateTargets, err := parseUpdateTargets(targets, targetDependents)
+			if err != nil {
+				return err
+			}
+
 			opts.Engine = engine.UpdateOptions{
-				Analyzers: analyzers,
-				Parallel:  parallel,
-				Debug:     debug,
+				Analyzers:        analyzers,
+				Parallel:         parallel,
+				Debug:            debug,
+				UpdateTargets:    updateTargets,
+				TargetDependents: targetDependents,
+				ResolvePending:   resolvePending,
 			}
 
 			changes, err := s.Refresh(commandContext(), backend.UpdateOperation{
@@ -139,11 +173,20 @@ func newRefreshCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log every engine event to the given file as newline-delimited JSON")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Emit a single JSON document describing the operation instead of a display; requires --yes")
 	cmd.PersistentFlags().BoolVar(
 		&nonInteractive, "non-interactive", false, "Disable interactive mode")
 	cmd.PersistentFlags().IntVarP(
 		&parallel, "parallel", "p", defaultParallel,
 		"Allow P resource operations to run in parallel at once (<=1 for no parallelism)")
+	cmd.PersistentFlags().BoolVar(
+		&resolvePending, "resolve-pending", false,
+		"Resolve any operations left pending by an interrupted update by reading their resources' actual state")
 	cmd.PersistentFlags().BoolVar(
 		&showReplacementSteps, "show-replacement-steps", false,
 		"Show detailed resource replacement creates and deletes instead of a single step")
@@ -153,6 +196,13 @@ func newRefreshCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&skipPreview, "skip-preview", false,
 		"Do not perform a preview before performing the refresh")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to refresh. Other resources will not be refreshed. "+
+			"Multiple resources can be specified using --target urn1 --target urn2")
+	cmd.PersistentFlags().BoolVar(
+		&targetDependents, "target-dependents", false,
+		"Allows refreshing of dependent targets discovered but not specified in --target list")
 	cmd.PersistentFlags().BoolVarP(
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the refresh after previewing it")
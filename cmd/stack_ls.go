@@ -18,11 +18,14 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/backend/state"
@@ -33,11 +36,21 @@ import (
 
 func newStackLsCmd() *cobra.Command {
 	var allStacks bool
+	var tags []string
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "List all known stacks",
 		Args:  cmdutil.NoArgs,
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			var tagFilters []stackTagFilter
+			for _, t := range tags {
+				filter, err := parseStackTagFilter(t)
+				if err != nil {
+					return err
+				}
+				tagFilters = append(tagFilters, filter)
+			}
+
 			// Ensure we are in a project; if not, we will fail.
 			projPath, err := workspace.DetectProjectPath()
 			if err != nil {
@@ -78,6 +91,11 @@ func newStackLsCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if len(tagFilters) > 0 {
+				if stackSummaries, err = filterStacksByTags(b, stackSummaries, tagFilters); err != nil {
+					return err
+				}
+			}
 			// Sort by stack name.
 			sort.Slice(stackSummaries, func(i, j int) bool {
 				return stackSummaries[i].Name().String() < stackSummaries[j].Name().String()
@@ -148,6 +166,80 @@ func newStackLsCmd() *cobra.Command {
 	}
 	cmd.PersistentFlags().BoolVarP(
 		&allStacks, "all", "a", false, "List all stacks instead of just stacks for the current project")
+	cmd.PersistentFlags().StringArrayVar(
+		&tags, "tag", nil,
+		"Only list stacks that have the given tag, given as name or name=value; may be repeated")
 
 	return cmd
 }
+
+// stackTagLookupParallelism is the number of stacks whose tags are looked up at once when a backend does not return
+// stack tags along with its stack summaries.
+const stackTagLookupParallelism = 8
+
+// filterStacksByTags returns the stacks that satisfy all of the given tag filters. The tags returned along with each
+// stack summary are used if possible; the tags of any other stacks are looked up concurrently.
+func filterStacksByTags(b backend.Backend, summaries []backend.StackSummary,
+	filters []stackTagFilter) ([]backend.StackSummary, error) {
+
+	tags := make([]map[apitype.StackTagName]string, len(summaries))
+	var missing []int
+	for i, summary := range summaries {
+		if tags[i] = summary.Tags(); tags[i] == nil {
+			missing = append(missing, i)
+		}
+	}
+	if err := lookupStackTags(b, summaries, tags, missing); err != nil {
+		return nil, err
+	}
+
+	var result []backend.StackSummary
+	for i, summary := range summaries {
+		matches := true
+		for _, f := range filters {
+			if !f.matches(tags[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, summary)
+		}
+	}
+	return result, nil
+}
+
+// lookupStackTags fetches the tags of the stacks at the given indices of summaries, storing them at the same indices
+// of tags.
+func lookupStackTags(b backend.Backend, summaries []backend.StackSummary, tags []map[apitype.StackTagName]string,
+	indices []int) error {
+
+	errs := make([]error, len(indices))
+	sem := make(chan bool, stackTagLookupParallelism)
+	var wg sync.WaitGroup
+	for j, i := range indices {
+		wg.Add(1)
+		sem <- true
+		go func(j, i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			name := summaries[i].Name()
+			stackTags, err := b.GetStackTags(commandContext(), name)
+			if err != nil {
+				errs[j] = errors.Wrapf(err, "getting tags for stack '%s'", name)
+				return
+			}
+			tags[i] = stackTags
+		}(j, i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
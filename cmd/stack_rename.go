@@ -0,0 +1,110 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/backend/state"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func newStackRenameCmd() *cobra.Command {
+	var stack string
+	var cmd = &cobra.Command{
+		Use:   "rename <new-stack-name>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Rename an existing stack",
+		Long: "Rename an existing stack.\n" +
+			"\n" +
+			"This command renames a stack, along with its configuration file and update history. Because\n" +
+			"the stack's name is part of the URN of each of its resources, the URNs are rewritten to match.\n" +
+			"The resources themselves are not changed.\n" +
+			"\n" +
+			"If the stack being renamed is the currently selected stack, the renamed stack is selected.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			s, err := requireStack(stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			newName := tokens.QName(args[0])
+
+			// Don't clobber the configuration of some other stack.
+			oldConfigPath, err := workspace.DetectProjectStackPath(s.Ref().Name())
+			if err != nil {
+				return err
+			}
+			newConfigPath, err := workspace.DetectProjectStackPath(newName)
+			if err != nil {
+				return err
+			}
+			if _, err = os.Stat(newConfigPath); err == nil {
+				return errors.Errorf("a configuration file for stack '%s' already exists at %s", newName, newConfigPath)
+			}
+
+			current, err := state.CurrentStack(commandContext(), s.Backend())
+			if err != nil {
+				return err
+			}
+			wasCurrent := current != nil && current.Ref().String() == s.Ref().String()
+
+			// Rename the configuration file first, so that it can be restored if the backend fails to rename the
+			// stack. The stack may not have a configuration file at all.
+			renamedConfig := true
+			if err = os.Rename(oldConfigPath, newConfigPath); err != nil {
+				if !os.IsNotExist(err) {
+					return errors.Wrapf(err, "renaming configuration file %s", oldConfigPath)
+				}
+				renamedConfig = false
+			}
+
+			newRef, err := backend.RenameStack(commandContext(), s, newName)
+			if err != nil {
+				if renamedConfig {
+					if restoreErr := os.Rename(newConfigPath, oldConfigPath); restoreErr != nil {
+						return errors.Errorf("%v; additionally, the configuration file could not be restored from %s: %v",
+							err, newConfigPath, restoreErr)
+					}
+				}
+				return err
+			}
+			if wasCurrent {
+				if err = state.SetCurrentStack(newRef.String()); err != nil {
+					return err
+				}
+			}
+
+			fmt.Printf("Renamed stack '%s' to '%s'\n", s.Ref(), newRef)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+
+	return cmd
+}
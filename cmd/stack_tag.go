@@ -0,0 +1,226 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStackTagCmd() *cobra.Command {
+	var stack string
+
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Manage stack tags",
+		Long: "Manage stack tags.\n" +
+			"\n" +
+			"Stacks have associated metadata in the form of tags. Each tag consists of a name and value.\n" +
+			"Tags such as pulumi:project, pulumi:runtime and git:remote are set automatically from the\n" +
+			"project and its git repository each time the stack is updated; other tags may be set freely\n" +
+			"and used to organize stacks, for example with `pulumi stack ls --tag`.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+
+	cmd.AddCommand(newStackTagGetCmd(&stack))
+	cmd.AddCommand(newStackTagLsCmd(&stack))
+	cmd.AddCommand(newStackTagRmCmd(&stack))
+	cmd.AddCommand(newStackTagSetCmd(&stack))
+
+	return cmd
+}
+
+func newStackTagGetCmd(stack *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name>",
+		Short: "Get a single stack tag value",
+		Args:  cmdutil.SpecificArgs([]string{"name"}),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+			s, err := requireStack(*stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			tags, err := backend.GetStackTags(commandContext(), s)
+			if err != nil {
+				return err
+			}
+			value, has := tags[name]
+			if !has {
+				return errors.Errorf("stack tag '%s' not found for stack '%s'", name, s.Ref())
+			}
+
+			fmt.Println(value)
+			return nil
+		}),
+	}
+}
+
+func newStackTagLsCmd(stack *string) *cobra.Command {
+	var jsonOut bool
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List all stack tags",
+		Args:  cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+			s, err := requireStack(*stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			tags, err := backend.GetStackTags(commandContext(), s)
+			if err != nil {
+				return err
+			}
+
+			if jsonOut {
+				if tags == nil {
+					tags = make(map[apitype.StackTagName]string)
+				}
+				return printJSON(tags)
+			}
+			printStackTags(tags)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit stack tags as JSON")
+
+	return cmd
+}
+
+func printStackTags(tags map[apitype.StackTagName]string) {
+	names := make([]string, 0, len(tags))
+	maxName := len("NAME")
+	for name := range tags {
+		names = append(names, name)
+		if len(name) > maxName {
+			maxName = len(name)
+		}
+	}
+	sort.Strings(names)
+
+	format := fmt.Sprintf("%%-%ds %%s\n", maxName)
+	fmt.Printf(format, "NAME", "VALUE")
+	for _, name := range names {
+		fmt.Printf(format, name, tags[name])
+	}
+}
+
+func newStackTagRmCmd(stack *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rm <name>",
+		Short: "Remove a stack tag",
+		Args:  cmdutil.SpecificArgs([]string{"name"}),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+			s, err := requireStack(*stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			tags, err := backend.GetStackTags(commandContext(), s)
+			if err != nil {
+				return err
+			}
+			if _, has := tags[name]; !has {
+				return nil
+			}
+			delete(tags, name)
+
+			return backend.UpdateStackTags(commandContext(), s, tags)
+		}),
+	}
+}
+
+func newStackTagSetCmd(stack *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <name> <value>",
+		Short: "Set a stack tag",
+		Args:  cmdutil.SpecificArgs([]string{"name", "value"}),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			name, value := args[0], args[1]
+
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+			s, err := requireStack(*stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			tags, err := backend.GetStackTags(commandContext(), s)
+			if err != nil {
+				return err
+			}
+			if tags == nil {
+				tags = make(map[apitype.StackTagName]string)
+			}
+			tags[name] = value
+
+			return backend.UpdateStackTags(commandContext(), s, tags)
+		}),
+	}
+}
+
+// stackTagFilter selects the stacks that have a particular tag, optionally with a particular value.
+type stackTagFilter struct {
+	name  apitype.StackTagName
+	value *string // if nil, the tag may have any value.
+}
+
+// parseStackTagFilter parses a filter of the form "name" or "name=value".
+func parseStackTagFilter(s string) (stackTagFilter, error) {
+	name, value := s, (*string)(nil)
+	if eq := strings.Index(s, "="); eq != -1 {
+		v := s[eq+1:]
+		name, value = s[:eq], &v
+	}
+	if name == "" {
+		return stackTagFilter{}, errors.Errorf("invalid tag filter %q; expected name or name=value", s)
+	}
+	return stackTagFilter{name: name, value: value}, nil
+}
+
+// matches returns true if the given tags satisfy the filter.
+func (f stackTagFilter) matches(tags map[apitype.StackTagName]string) bool {
+	value, has := tags[f.name]
+	return has && (f.value == nil || *f.value == value)
+}
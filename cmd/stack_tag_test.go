@@ -0,0 +1,43 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+)
+
+func TestStackTagFilter(t *testing.T) {
+	tags := map[apitype.StackTagName]string{"env": "prod", "team": ""}
+
+	matches := func(s string) bool {
+		f, err := parseStackTagFilter(s)
+		assert.NoError(t, err)
+		return f.matches(tags)
+	}
+	assert.True(t, matches("env"))
+	assert.True(t, matches("env=prod"))
+	assert.False(t, matches("env=dev"))
+	assert.False(t, matches("env="))
+	assert.True(t, matches("team="))
+	assert.False(t, matches("owner"))
+	assert.True(t, matches("env=prod") && matches("team"))
+
+	_, err := parseStackTagFilter("=prod")
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Edit the current stack's state",
+		Long: "Edit the current stack's state\n" +
+			"\n" +
+			"Subcommands of this command can be used to surgically edit parts of a stack's state. These can be\n" +
+			"useful when troubleshooting a stack or when performing specific edits that otherwise would require\n" +
+			"editing the state file by hand with `pulumi stack export` and `pulumi stack import`.\n" +
+			"\n" +
+			"Unlike hand edits, these commands never leave resources referring to resources that no longer\n" +
+			"exist, and the edited state is checked for integrity before it is saved.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newStateDeleteCmd())
+	cmd.AddCommand(newStateUnprotectCmd())
+	cmd.AddCommand(newStateRenameCmd())
+	cmd.AddCommand(newStateMoveCmd())
+	return cmd
+}
+
+// stateEdit is the state of a stack that is being edited by one of the `pulumi state` commands.
+type stateEdit struct {
+	stack    backend.Stack    // the stack being edited.
+	snapshot *deploy.Snapshot // the stack's current snapshot, which is edited in place.
+	resource *resource.State  // the resource being edited.
+}
+
+// beginStateEdit loads the given stack's snapshot and locates the resource with the given URN in it.
+func beginStateEdit(stackName string, urn resource.URN, opts display.Options) (*stateEdit, error) {
+	s, err := requireStack(stackName, false, opts, false /*setCurrent*/)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := s.Snapshot(commandContext())
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		return nil, errors.Errorf("stack '%s' has no resources", s.Ref())
+	}
+	res, err := locateResource(snap, urn)
+	if err != nil {
+		return nil, err
+	}
+	return &stateEdit{stack: s, snapshot: snap, resource: res}, nil
+}
+
+// locateResource returns the single resource in the snapshot with the given URN.
+func locateResource(snap *deploy.Snapshot, urn resource.URN) (*resource.State, error) {
+	var found *resource.State
+	for _, res := range snap.Resources {
+		if res.URN == urn {
+			if found != nil {
+				return nil, errors.Errorf("there are multiple resources named %s; run `pulumi refresh` or "+
+					"`pulumi up` to clean up resources that are pending deletion first", urn)
+			}
+			found = res
+		}
+	}
+	if found == nil {
+		return nil, errors.Errorf("no resource named %s found", urn)
+	}
+	return found, nil
+}
+
+// confirmStateEdit asks the user to confirm an edit unless yes is true.
+func confirmStateEdit(prompt string, yes bool, opts display.Options) error {
+	if yes {
+		return nil
+	}
+	if !cmdutil.Interactive() {
+		return errors.New("--yes must be passed in to proceed when running in non-interactive mode")
+	}
+	if !confirmPrompt(prompt, "yes", opts) {
+		return errors.New("confirmation declined")
+	}
+	return nil
+}
+
+// verifyStateEdit ensures that an edited snapshot is well-formed, so that it is safe to save.
+func verifyStateEdit(s backend.Stack, snap *deploy.Snapshot) error {
+	if err := snap.VerifyIntegrity(); err != nil {
+		return errors.Wrapf(err, "the edited state of stack '%s' is invalid; no changes were made", s.Ref())
+	}
+	return nil
+}
+
+// saveStateEdit saves an edited snapshot, which must already have been verified, as the stack's new state.
+func saveStateEdit(s backend.Stack, snap *deploy.Snapshot) error {
+	crypter, err := backend.GetStackCrypter(s)
+	if err != nil {
+		return err
+	}
+	sdep, err := stack.SerializeDeployment(snap, crypter)
+	if err != nil {
+		return errors.Wrap(err, "serializing deployment")
+	}
+	bytes, err := json.Marshal(sdep)
+	if err != nil {
+		return err
+	}
+	return backend.ImportStackDeployment(commandContext(), s, &apitype.UntypedDeployment{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Deployment: bytes,
+	})
+}
+
+// printStateEditResult prints a message describing a successful edit.
+func printStateEditResult(msg string, opts display.Options) {
+	fmt.Println(opts.Color.Colorize(fmt.Sprintf("%s%s%s", colors.SpecAttention, msg, colors.Reset)))
+}
@@ -0,0 +1,86 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStateDeleteCmd() *cobra.Command {
+	var stackName string
+	var cascade bool
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <resource URN>",
+		Short: "Deletes a resource from a stack's state",
+		Long: "Deletes a resource from a stack's state\n" +
+			"\n" +
+			"This command deletes a resource from a stack's state, as long as it is safe to do so. The resource\n" +
+			"itself is not deleted from the cloud; Pulumi simply stops managing it.\n" +
+			"\n" +
+			"Resources that are protected may not be deleted. If other resources refer to the resource, as their\n" +
+			"parent, as a dependency, or as their provider, the deletion is refused unless --cascade is passed,\n" +
+			"in which case those resources are deleted as well.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			urn := resource.URN(args[0])
+			e, err := beginStateEdit(stackName, urn, opts)
+			if err != nil {
+				return err
+			}
+			if err = edit.DeleteResource(e.snapshot, e.resource, cascade); err != nil {
+				return err
+			}
+			if err = verifyStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("This will delete %s from the state of stack '%s'.", urn, e.stack.Ref())
+			if cascade {
+				prompt = fmt.Sprintf("This will delete %s and all resources that refer to it from the state "+
+					"of stack '%s'.", urn, e.stack.Ref())
+			}
+			if err = confirmStateEdit(prompt, yes, opts); err != nil {
+				return err
+			}
+			if err = saveStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			printStateEditResult("Resource deleted successfully", opts)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().BoolVar(
+		&cascade, "cascade", false, "Also delete the resources that refer to the deleted resource")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false, "Skip confirmation prompts, and proceed with the deletion anyway")
+	return cmd
+}
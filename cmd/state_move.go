@@ -0,0 +1,130 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStateMoveCmd() *cobra.Command {
+	var stackName string
+	var destName string
+	var cascade bool
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "move <resource URN> --dest <stack>",
+		Short: "Moves a resource from one stack's state to another's",
+		Long: "Moves a resource from one stack's state to another's\n" +
+			"\n" +
+			"This command moves a resource from the state of one stack into the state of another stack of the\n" +
+			"same project. The resource itself is not changed; it is simply managed by the destination stack\n" +
+			"from then on. The providers used by the resource are copied into the destination stack.\n" +
+			"\n" +
+			"If other resources refer to the resource, as their parent, as a dependency, or as their provider,\n" +
+			"the move is refused unless --cascade is passed, in which case those resources are moved as well.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if destName == "" {
+				return errors.New("a destination stack must be specified with --dest")
+			}
+
+			urn := resource.URN(args[0])
+			e, err := beginStateEdit(stackName, urn, opts)
+			if err != nil {
+				return err
+			}
+			dest, err := requireStack(destName, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			if dest.Ref().String() == e.stack.Ref().String() {
+				return errors.New("the source and destination stacks must be different")
+			}
+			destSnap, err := dest.Snapshot(commandContext())
+			if err != nil {
+				return err
+			}
+			if destSnap == nil {
+				destSnap = deploy.NewSnapshot(e.snapshot.Manifest, nil, nil)
+			}
+
+			// Secrets are encrypted differently for each stack, so the moved resources' secrets must be decrypted
+			// before the destination stack can re-encrypt them.
+			crypter, err := backend.GetStackCrypter(e.stack)
+			if err != nil {
+				return err
+			}
+			if err = stack.DecryptSnapshot(e.snapshot, crypter); err != nil {
+				return err
+			}
+
+			if err = edit.MoveResource(e.snapshot, destSnap, e.resource, dest.Ref().Name(), cascade); err != nil {
+				return err
+			}
+			if err = verifyStateEdit(dest, destSnap); err != nil {
+				return err
+			}
+			if err = verifyStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("This will move %s from stack '%s' to stack '%s'.", urn, e.stack.Ref(), dest.Ref())
+			if cascade {
+				prompt = fmt.Sprintf("This will move %s and all resources that refer to it from stack '%s' to "+
+					"stack '%s'.", urn, e.stack.Ref(), dest.Ref())
+			}
+			if err = confirmStateEdit(prompt, yes, opts); err != nil {
+				return err
+			}
+
+			// Save the destination first, so that a failure part way through never loses track of the resources.
+			if err = saveStateEdit(dest, destSnap); err != nil {
+				return errors.Wrapf(err, "saving stack '%s'", dest.Ref())
+			}
+			if err = saveStateEdit(e.stack, e.snapshot); err != nil {
+				return errors.Wrapf(err, "saving stack '%s'; the moved resources are now in both stacks", e.stack.Ref())
+			}
+
+			printStateEditResult("Resource moved successfully", opts)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "", "The name of the stack to move the resource from. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&destName, "dest", "", "The name of the stack to move the resource to")
+	cmd.PersistentFlags().BoolVar(
+		&cascade, "cascade", false, "Also move the resources that refer to the moved resource")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false, "Skip confirmation prompts, and proceed with the move anyway")
+	return cmd
+}
@@ -0,0 +1,78 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStateRenameCmd() *cobra.Command {
+	var stackName string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "rename <resource URN> <new name>",
+		Short: "Renames a resource in a stack's state",
+		Long: "Renames a resource in a stack's state\n" +
+			"\n" +
+			"This command changes the name, and hence the URN, of a resource in a stack's state. All references\n" +
+			"to the resource from other resources are updated to match. This is useful after renaming a resource\n" +
+			"in a program, so that the next update does not replace it.",
+		Args: cmdutil.ExactArgs(2),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			urn := resource.URN(args[0])
+			e, err := beginStateEdit(stackName, urn, opts)
+			if err != nil {
+				return err
+			}
+			if err = edit.RenameResource(e.snapshot, e.resource, tokens.QName(args[1])); err != nil {
+				return err
+			}
+			if err = verifyStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("This will rename %s to %s in the state of stack '%s'.",
+				urn, e.resource.URN, e.stack.Ref())
+			if err = confirmStateEdit(prompt, yes, opts); err != nil {
+				return err
+			}
+			if err = saveStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			printStateEditResult(fmt.Sprintf("Resource renamed to %s", e.resource.URN), opts)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false, "Skip confirmation prompts, and proceed with the rename anyway")
+	return cmd
+}
@@ -0,0 +1,68 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+)
+
+func newStateUnprotectCmd() *cobra.Command {
+	var stackName string
+
+	cmd := &cobra.Command{
+		Use:   "unprotect <resource URN>",
+		Short: "Unprotect a resource in a stack's state",
+		Long: "Unprotect a resource in a stack's state\n" +
+			"\n" +
+			"This command clears the 'protect' bit on a resource in a stack's state, allowing it to be\n" +
+			"deleted by a subsequent update, destroy, or `pulumi state delete`.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			e, err := beginStateEdit(stackName, resource.URN(args[0]), opts)
+			if err != nil {
+				return err
+			}
+			if !e.resource.Protect {
+				printStateEditResult("Resource is not protected; no changes were made", opts)
+				return nil
+			}
+			if err = edit.UnprotectResource(e.snapshot, e.resource); err != nil {
+				return err
+			}
+			if err = verifyStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+			if err = saveStateEdit(e.stack, e.snapshot); err != nil {
+				return err
+			}
+
+			printStateEditResult("Resource unprotected successfully", opts)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "", "The name of the stack to operate on. Defaults to the current stack")
+	return cmd
+}
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 
@@ -24,9 +25,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	survey "gopkg.in/AlecAivazis/survey.v1"
+	surveycore "gopkg.in/AlecAivazis/survey.v1/core"
 
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
 	"github.com/pulumi/pulumi/pkg/engine"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
@@ -49,18 +53,32 @@ func newUpCmd() *cobra.Command {
 
 	// Flags for engine.UpdateOptions.
 	var analyzers []string
+	var continueOnError bool
 	var diffDisplay bool
+	var eventLogPath string
+	var jsonDisplay bool
 	var nonInteractive bool
 	var parallel int
+	var planPath string
 	var refresh bool
 	var showConfig bool
 	var showReplacementSteps bool
 	var showSames bool
 	var skipPreview bool
+	var targets []string
+	var targetDependents bool
 	var yes bool
 
 	// up implementation used when the source of the Pulumi program is in the current working directory.
 	upWorkingDirectory := func(opts backend.UpdateOptions) error {
+		proj, root, err := readProject()
+		if err != nil {
+			return err
+		}
+		if err = checkAnalyzersPreviewed(proj, analyzers, opts); err != nil {
+			return err
+		}
+
 		s, err := requireStack(stack, true, opts.Display, true /*setCurrent*/)
 		if err != nil {
 			return err
@@ -78,24 +96,35 @@ func newUpCmd() *cobra.Command {
 			}
 		}
 
-		proj, root, err := readProject()
+		m, err := getUpdateMetadata(message, root)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "gathering environment metadata")
 		}
 
-		m, err := getUpdateMetadata(message, root)
+		updateTargets, err := parseUpdateTargets(targets, targetDependents)
 		if err != nil {
-			return errors.Wrap(err, "gathering environment metadata")
+			return err
+		}
+
+		var plan *deploy.SavedPlan
+		if planPath != "" {
+			if plan, err = readSavedPlan(planPath, s); err != nil {
+				return err
+			}
 		}
 
 		opts.Engine = engine.UpdateOptions{
-			Analyzers: analyzers,
-			Parallel:  parallel,
-			Debug:     debug,
-			Refresh:   refresh,
+			Analyzers:        analyzers,
+			Parallel:         parallel,
+			Debug:            debug,
+			Refresh:          refresh,
+			UpdateTargets:    updateTargets,
+			TargetDependents: targetDependents,
+			ContinueOnError:  continueOnError,
+			Plan:             plan,
 		}
 
-		changes, err := s.Update(commandContext(), backend.UpdateOperation{
+		changes, err := updateResolvingPending(s, backend.UpdateOperation{
 			Proj:   proj,
 			Root:   root,
 			M:      m,
@@ -200,6 +229,9 @@ func newUpCmd() *cobra.Command {
 		if err = workspace.SaveProject(proj); err != nil {
 			return errors.Wrap(err, "saving project")
 		}
+		if err = checkAnalyzersPreviewed(proj, analyzers, opts); err != nil {
+			return err
+		}
 
 		// Create the stack, if needed.
 		if s == nil {
@@ -224,11 +256,19 @@ func newUpCmd() *cobra.Command {
 			return errors.Wrap(err, "gathering environment metadata")
 		}
 
+		updateTargets, err := parseUpdateTargets(targets, targetDependents)
+		if err != nil {
+			return err
+		}
+
 		opts.Engine = engine.UpdateOptions{
-			Analyzers: analyzers,
-			Parallel:  parallel,
-			Debug:     debug,
-			Refresh:   refresh,
+			Analyzers:        analyzers,
+			Parallel:         parallel,
+			Debug:            debug,
+			Refresh:          refresh,
+			UpdateTargets:    updateTargets,
+			TargetDependents: targetDependents,
+			ContinueOnError:  continueOnError,
 		}
 
 		// TODO for the URL case:
@@ -236,7 +276,7 @@ func newUpCmd() *cobra.Command {
 		// - attempt `destroy` on any update errors.
 		// - show template.Quickstart?
 
-		changes, err := s.Update(commandContext(), backend.UpdateOperation{
+		changes, err := updateResolvingPending(s, backend.UpdateOperation{
 			Proj:   proj,
 			Root:   root,
 			M:      m,
@@ -270,9 +310,39 @@ func newUpCmd() *cobra.Command {
 			"afterwards so that the stack may be updated incrementally again later on.\n" +
 			"\n" +
 			"The program to run is loaded from the project in the current directory by default. Use the `-C` or\n" +
-			"`--cwd` flag to use a different directory.",
+			"`--cwd` flag to use a different directory.\n" +
+			"\n" +
+			"Use --plan to pass a plan saved by `pulumi preview --save-plan`. The preview that precedes the update\n" +
+			"is checked against the plan, and the update fails before any resources are changed if it would perform\n" +
+			"any operation that the plan does not describe, for example because the program, its configuration, or\n" +
+			"the stack's resources have changed since the plan was saved. A plan can only be saved if every planned\n" +
+			"operation is fully known during the preview, so that it can be checked in full up front.",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			// A saved plan is checked in full by the preview that precedes the update, which fails before any
+			// resources are changed if the plan no longer matches. The update checks each step against the plan again
+			// before it is performed. A refresh would change the stack's state before the plan could be checked.
+			if planPath != "" {
+				if skipPreview || jsonDisplay {
+					return errors.New("--plan may not be used with --skip-preview or --json")
+				}
+				if refresh {
+					return errors.New("--plan may not be used with --refresh")
+				}
+				if len(args) > 0 {
+					return errors.New("--plan may not be used when updating a stack from a URL")
+				}
+			}
+
+			// The JSON document must be the only thing printed, so a JSON update never prompts or shows a preview,
+			// and therefore must be approved up front.
+			if jsonDisplay {
+				if !yes {
+					return errors.New("--yes must be passed in to proceed when using --json")
+				}
+				nonInteractive, skipPreview = true, true
+			}
+
 			interactive := isInteractive(nonInteractive)
 			if !interactive {
 				yes = true // auto-approve changes, since we cannot prompt.
@@ -282,6 +352,11 @@ func newUpCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			eventLog, err := createEventLog(eventLogPath)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(eventLog)
 
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
@@ -290,6 +365,8 @@ func newUpCmd() *cobra.Command {
 				ShowSameResources:    showSames,
 				IsInteractive:        interactive,
 				DiffDisplay:          diffDisplay,
+				JSONDisplay:          jsonDisplay,
+				EventLog:             eventLog,
 				Debug:                debug,
 			}
 
@@ -321,15 +398,29 @@ func newUpCmd() *cobra.Command {
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
 		&analyzers, "analyzer", []string{},
-		"Run one or more analyzers as part of this update")
+		"Run one or more analyzers as part of this update. Stack-wide policy failures can only block the update "+
+			"during its preview, so an update that runs analyzers may not skip its preview")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue the update after a resource operation fails, skipping only the operations that depend on it")
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log every engine event to the given file as newline-delimited JSON")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Emit a single JSON document describing the operation instead of a display; requires --yes")
 	cmd.PersistentFlags().BoolVar(
 		&nonInteractive, "non-interactive", false, "Disable interactive mode")
 	cmd.PersistentFlags().IntVarP(
 		&parallel, "parallel", "p", defaultParallel,
 		"Allow P resource operations to run in parallel at once (<=1 for no parallelism)")
+	cmd.PersistentFlags().StringVar(
+		&planPath, "plan", "",
+		"Fail rather than perform any operation not described by the given plan, "+
+			"saved by `pulumi preview --save-plan`")
 	cmd.PersistentFlags().BoolVarP(
 		&refresh, "refresh", "r", false,
 		"Refresh the state of the stack's resources before this update")
@@ -345,6 +436,13 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&skipPreview, "skip-preview", false,
 		"Do not perform a preview before performing the update")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to update. Other resources will not be updated. "+
+			"Multiple resources can be specified using --target urn1 --target urn2")
+	cmd.PersistentFlags().BoolVar(
+		&targetDependents, "target-dependents", false,
+		"Allows updating of dependent targets discovered but not specified in --target list")
 	cmd.PersistentFlags().BoolVarP(
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the update after previewing it")
@@ -467,3 +565,69 @@ func isPreconfiguredEmptyStack(
 
 	return true
 }
+
+// checkAnalyzersPreviewed returns an error if an update of the given project would run analyzers without first being
+// previewed. Stack analysis can only run once every step of an update has completed, so a mandatory policy failure
+// only prevents changes when it is reported by the preview that precedes the update.
+func checkAnalyzersPreviewed(proj *workspace.Project, analyzers []string, opts backend.UpdateOptions) error {
+	if !opts.SkipPreview {
+		return nil
+	}
+	if len(analyzers) == 0 && (proj.Analyzers == nil || len(*proj.Analyzers) == 0) {
+		return nil
+	}
+	return errors.New("an update that runs analyzers must be previewed, so --skip-preview and --json may not be used")
+}
+
+// updateResolvingPending performs the given update. If the update cannot proceed because an interrupted update left
+// operations pending, an interactive user is offered the chance to resolve them with `pulumi refresh
+// --resolve-pending`, after which the update is retried. An update that has been approved up front with --yes is
+// never prompted; it fails, as resolving pending operations is not among the changes that were approved.
+func updateResolvingPending(s backend.Stack, op backend.UpdateOperation) (engine.ResourceChanges, error) {
+	changes, err := s.Update(commandContext(), op)
+	pending, ok := err.(deploy.PlanPendingOperationsError)
+	if !ok || op.Opts.AutoApprove || !op.Opts.Display.IsInteractive ||
+		!confirmResolvePending(pending, op.Opts.Display) {
+		return changes, err
+	}
+
+	refresh := op
+	refresh.Opts.AutoApprove = true
+	refresh.Opts.Engine = engine.UpdateOptions{
+		Analyzers:      op.Opts.Engine.Analyzers,
+		Parallel:       op.Opts.Engine.Parallel,
+		Debug:          op.Opts.Engine.Debug,
+		ResolvePending: true,
+	}
+	if _, err = s.Refresh(commandContext(), refresh); err != nil {
+		return nil, err
+	}
+
+	return s.Update(commandContext(), op)
+}
+
+// confirmResolvePending lists the given pending operations and asks the user whether they should be resolved.
+func confirmResolvePending(e deploy.PlanPendingOperationsError, opts display.Options) bool {
+	fmt.Print(opts.Color.Colorize(fmt.Sprintf(
+		"%sThe stack has %d resource(s) with operations left pending by an interrupted update:%s\n",
+		colors.SpecAttention, len(e.Operations), colors.Reset)))
+	for _, op := range e.Operations {
+		fmt.Printf("  * %s, interrupted while %s\n", op.Resource.URN, op.Type)
+	}
+
+	surveycore.DisableColor = true
+	surveycore.QuestionIcon = ""
+	surveycore.SelectFocusIcon = opts.Color.Colorize(colors.BrightGreen + ">" + colors.Reset)
+	prompt := "\b" + opts.Color.Colorize(
+		colors.SpecPrompt+"Do you want to resolve them by refreshing the stack before updating?"+colors.Reset)
+
+	var response string
+	if err := survey.AskOne(&survey.Select{
+		Message: prompt,
+		Options: []string{"yes", "no"},
+		Default: "no",
+	}, &response, nil); err != nil {
+		return false
+	}
+	return response == "yes"
+}
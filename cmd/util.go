@@ -17,12 +17,15 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/golang/glog"
 	multierror "github.com/hashicorp/go-multierror"
@@ -40,6 +43,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/backend/state"
 	"github.com/pulumi/pulumi/pkg/diag/colors"
 	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
 	"github.com/pulumi/pulumi/pkg/util/cancel"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
 	"github.com/pulumi/pulumi/pkg/util/contract"
@@ -586,3 +592,79 @@ func updateFlagsToOptions(interactive, skipPreview, yes bool) (backend.UpdateOpt
 		SkipPreview: skipPreview,
 	}, nil
 }
+
+// parseUpdateTargets converts the URNs passed via the --target flag into a list of update targets. If any of the
+// targets is not a well-formed URN, an error is returned.
+func parseUpdateTargets(targets []string, targetDependents bool) ([]resource.URN, error) {
+	if len(targets) == 0 {
+		if targetDependents {
+			return nil, errors.New("--target-dependents may only be passed along with --target")
+		}
+		return nil, nil
+	}
+
+	urns := make([]resource.URN, len(targets))
+	for i, t := range targets {
+		if !strings.HasPrefix(t, resource.URNPrefix) {
+			return nil, errors.Errorf("invalid --target '%s': expected a resource URN", t)
+		}
+		urns[i] = resource.URN(t)
+	}
+	return urns, nil
+}
+
+// printJSON prints the given value to stdout as indented JSON.
+func printJSON(v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// writeSavedPlan writes the given saved plan to the file at the given path. Secret values in the plan are encrypted
+// using the stack's crypter.
+func writeSavedPlan(path string, s backend.Stack, plan *deploy.SavedPlan) error {
+	crypter, err := backend.GetStackCrypter(s)
+	if err != nil {
+		return err
+	}
+	versioned, err := stack.SerializeSavedPlan(plan, crypter)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(versioned, "", "    ")
+	if err != nil {
+		return errors.Wrap(err, "marshalling plan")
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// readSavedPlan reads the saved plan in the file at the given path. Secret values in the plan are decrypted using the
+// stack's crypter.
+func readSavedPlan(path string, s backend.Stack) (*deploy.SavedPlan, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading plan")
+	}
+	plan, err := stack.UnmarshalVersionedSavedPlan(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read plan file '%s'", path)
+	}
+	crypter, err := backend.GetStackCrypter(s)
+	if err != nil {
+		return nil, err
+	}
+	return stack.DeserializeSavedPlan(*plan, crypter)
+}
+
+// createEventLog creates an empty event log at the given path, if any, replacing any existing file. Doing so up front
+// reports an unusable path before any operation begins. The log is shared by all of the operations the command
+// performs, and must be closed once they are done.
+func createEventLog(path string) (*display.EventLog, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return display.NewEventLog(path)
+}
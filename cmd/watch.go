@@ -0,0 +1,431 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/util/archive"
+	"github.com/pulumi/pulumi/pkg/util/cancel"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func newWatchCmd() *cobra.Command {
+	var debug bool
+	var message string
+	var stack string
+
+	// Flags for engine.UpdateOptions.
+	var nonInteractive bool
+	var parallel int
+	var refresh bool
+	var showConfig bool
+	var showReplacementSteps bool
+	var showSames bool
+
+	// Flags for the watcher itself.
+	var pollInterval time.Duration
+	var debounce time.Duration
+
+	var cmd = &cobra.Command{
+		Use:   "watch",
+		Short: "Continuously update the resources in a stack as its program changes",
+		Long: "Continuously update the resources in a stack as its program changes.\n" +
+			"\n" +
+			"This command watches the project directory and runs `pulumi up --skip-preview` whenever the\n" +
+			"files in it change. Files excluded by .pulumiignore are not watched. Updates run one at a\n" +
+			"time; if further changes are made while an update is in progress, that update is cancelled\n" +
+			"and a new one is started once the changes settle.\n" +
+			"\n" +
+			"Press ^C to stop watching. If an update is in progress, it is cancelled first; press ^C\n" +
+			"again to terminate it immediately.\n" +
+			"\n" +
+			"This command is intended for development stacks: changes are applied without a preview\n" +
+			"and without confirmation.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			if pollInterval <= 0 {
+				return errors.New("--poll-interval must be positive")
+			}
+			if debounce < 0 {
+				return errors.New("--debounce must not be negative")
+			}
+
+			interactive := isInteractive(nonInteractive)
+			opts := backend.UpdateOptions{
+				AutoApprove: true,
+				SkipPreview: true,
+				Engine: engine.UpdateOptions{
+					Parallel: parallel,
+					Debug:    debug,
+					Refresh:  refresh,
+				},
+				Display: display.Options{
+					Color:                cmdutil.GetGlobalColorization(),
+					ShowConfig:           showConfig,
+					ShowReplacementSteps: showReplacementSteps,
+					ShowSameResources:    showSames,
+					IsInteractive:        interactive,
+					Debug:                debug,
+				},
+			}
+
+			s, err := requireStack(stack, true, opts.Display, true /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+			_, root, err := readProject()
+			if err != nil {
+				return err
+			}
+
+			w := &watcher{
+				root:         root,
+				pollInterval: pollInterval,
+				debounce:     debounce,
+				scopes:       &watchCancellationScopeSource{},
+				update: func(scopes backend.CancellationScopeSource) error {
+					// Re-read the project on every run, since it is one of the files being watched.
+					proj, projRoot, readErr := readProject()
+					if readErr != nil {
+						return readErr
+					}
+					m, metadataErr := getUpdateMetadata(message, projRoot)
+					if metadataErr != nil {
+						return errors.Wrap(metadataErr, "gathering environment metadata")
+					}
+					_, updateErr := s.Update(commandContext(), backend.UpdateOperation{
+						Proj:   proj,
+						Root:   projRoot,
+						M:      m,
+						Opts:   opts,
+						Scopes: scopes,
+					})
+					return updateErr
+				},
+				color: opts.Display.Color,
+			}
+			return w.run()
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&debug, "debug", "d", false,
+		"Print detailed debugging output during resource operations")
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().StringVarP(
+		&message, "message", "m", "",
+		"Optional message to associate with each update")
+
+	// Flags for engine.UpdateOptions.
+	cmd.PersistentFlags().BoolVar(
+		&nonInteractive, "non-interactive", false, "Disable interactive mode")
+	cmd.PersistentFlags().IntVarP(
+		&parallel, "parallel", "p", defaultParallel,
+		"Allow P resource operations to run in parallel at once (<=1 for no parallelism)")
+	cmd.PersistentFlags().BoolVarP(
+		&refresh, "refresh", "r", false,
+		"Refresh the state of the stack's resources before each update")
+	cmd.PersistentFlags().BoolVar(
+		&showConfig, "show-config", false,
+		"Show configuration keys and variables")
+	cmd.PersistentFlags().BoolVar(
+		&showReplacementSteps, "show-replacement-steps", false,
+		"Show detailed resource replacement creates and deletes instead of a single step")
+	cmd.PersistentFlags().BoolVar(
+		&showSames, "show-sames", false,
+		"Show resources that don't need be updated because they haven't changed, alongside those that do")
+
+	cmd.PersistentFlags().DurationVar(
+		&pollInterval, "poll-interval", 500*time.Millisecond,
+		"How often to check the project directory for changes")
+	cmd.PersistentFlags().DurationVar(
+		&debounce, "debounce", time.Second,
+		"How long to wait for changes to settle before starting an update")
+
+	return cmd
+}
+
+// fileStamp records enough about a watched file to notice when it changes.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// snapshotProject returns the stamps of every file in the project rooted at root that would be archived for an
+// update. The stack state directory is skipped so that updates of stacks stored alongside the project do not
+// trigger further updates.
+func snapshotProject(root string) (map[string]fileStamp, error) {
+	bookkeeping := path.Join(root, workspace.BookkeepingDir)
+	files := make(map[string]fileStamp)
+	err := archive.Walk(root, true, func(p string, info os.FileInfo) error {
+		if info.IsDir() || p == bookkeeping || strings.HasPrefix(p, bookkeeping+"/") {
+			return nil
+		}
+		files[p] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// projectChanged returns true if the two snapshots of a project differ.
+func projectChanged(prev, next map[string]fileStamp) bool {
+	if len(prev) != len(next) {
+		return true
+	}
+	for p, stamp := range next {
+		if old, has := prev[p]; !has || old != stamp {
+			return true
+		}
+	}
+	return false
+}
+
+// watcher runs updates serially whenever the files in a project directory change.
+type watcher struct {
+	root         string
+	pollInterval time.Duration
+	debounce     time.Duration
+	scopes       *watchCancellationScopeSource
+	update       func(scopes backend.CancellationScopeSource) error
+	color        colors.Colorization
+}
+
+// run watches the project until it is interrupted, running an update after each batch of changes settles. An
+// update is run once at startup, so that the stack reflects the program as it is when watching begins.
+func (w *watcher) run() error {
+	files, err := snapshotProject(w.root)
+	if err != nil {
+		return err
+	}
+
+	sigint := make(chan os.Signal, 1)
+	signal.Notify(sigint, os.Interrupt)
+	defer signal.Stop(sigint)
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	updateDone := make(chan error)
+	running, pending, stopping := false, true, false
+	var lastChange time.Time
+	for {
+		if stopping && !running {
+			return nil
+		}
+		if pending && !running && !stopping && time.Since(lastChange) >= w.debounce {
+			pending, running = false, true
+			w.printf("%sUpdating stack to reflect the program in %s%s\n", colors.SpecHeadline, w.root, colors.Reset)
+			go func() {
+				updateDone <- w.update(w.scopes)
+			}()
+		}
+
+		select {
+		case <-ticker.C:
+			next, snapErr := snapshotProject(w.root)
+			if snapErr != nil {
+				return snapErr
+			}
+			if projectChanged(files, next) {
+				files, pending, lastChange = next, true, time.Now()
+				if running {
+					w.scopes.cancel(false)
+				}
+			}
+		case err = <-updateDone:
+			running = false
+			w.scopes.reset()
+			switch {
+			case err == context.Canceled && pending && !stopping:
+				w.printf("%sUpdate cancelled because the project changed%s\n", colors.SpecAttention, colors.Reset)
+			case err == context.Canceled:
+				w.printf("%sUpdate cancelled%s\n", colors.SpecAttention, colors.Reset)
+			case err != nil:
+				cmdutil.Diag().Errorf(diag.Message("", "update failed: %v"), PrintEngineError(err))
+			}
+			if !pending && !stopping {
+				w.printf("%sWatching %s for changes; press ^C to stop%s\n", colors.SpecInfo, w.root, colors.Reset)
+			}
+		case <-sigint:
+			if !running {
+				return nil
+			}
+			stopping = true
+			w.scopes.cancel(true)
+		}
+	}
+}
+
+func (w *watcher) printf(format string, args ...interface{}) {
+	fmt.Printf("\n%s", w.color.Colorize(fmt.Sprintf(format, args...)))
+}
+
+// watchCancellationScopeSource provides cancellation scopes for the updates run by `pulumi watch`. The watcher owns
+// the handling of ^C for as long as it runs, and forwards cancellation requests to the scope of the update in
+// progress, if any.
+type watchCancellationScopeSource struct {
+	lock    sync.Mutex
+	current *watchCancellationScope // the scope of the update in progress, if it has created one.
+	early   []bool                  // requests made before the update in progress created its scope.
+}
+
+// cancel asks the update in progress, if any, to cancel. If interrupt is true, the request is due to ^C; a second
+// such request terminates the update. A request made before the update has created its cancellation scope is
+// delivered once the scope is created.
+func (s *watchCancellationScopeSource) cancel(interrupt bool) {
+	s.lock.Lock()
+	current := s.current
+	if current == nil {
+		s.early = append(s.early, interrupt)
+	}
+	s.lock.Unlock()
+
+	if current != nil {
+		current.request(interrupt)
+	}
+}
+
+// reset discards any requests that were not delivered to a cancellation scope. It is called once an update finishes.
+func (s *watchCancellationScopeSource) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.early = nil
+}
+
+func (s *watchCancellationScopeSource) NewScope(events chan<- engine.Event,
+	isPreview bool) backend.CancellationScope {
+
+	cancelContext, cancelSource := cancel.NewContext(context.Background())
+
+	c := &watchCancellationScope{
+		source:   s,
+		context:  cancelContext,
+		requests: make(chan bool),
+		closed:   make(chan bool),
+		done:     make(chan bool),
+	}
+
+	// Messages are dropped once the scope is closed, as the display may no longer be accepting events.
+	send := func(message string) {
+		select {
+		case events <- engine.Event{
+			Type: engine.StdoutColorEvent,
+			Payload: engine.StdoutEventPayload{
+				Message: message,
+				Color:   colors.Always,
+			},
+		}:
+		case <-c.closed:
+		}
+	}
+
+	interrupted := false
+	handle := func(interrupt bool) {
+		switch {
+		case interrupt && interrupted:
+			send(colors.BrightRed + "^C received; terminating" + colors.Reset)
+			cancelSource.Terminate()
+		case interrupt:
+			interrupted = true
+			message := "^C received; cancelling and stopping. " +
+				"If you would like to terminate immediately, press ^C again.\n"
+			if !isPreview {
+				message += colors.BrightRed + "Note that terminating immediately may lead to orphaned resources " +
+					"and other inconsistencies.\n" + colors.Reset
+			}
+			send(message)
+			cancelSource.Cancel()
+		default:
+			// The watcher reports cancellations due to changes once the update has finished, as the display
+			// may no longer be accepting events by the time the changes are noticed.
+			cancelSource.Cancel()
+		}
+	}
+
+	s.lock.Lock()
+	early := s.early
+	s.current, s.early = c, nil
+	s.lock.Unlock()
+
+	go func() {
+		defer close(c.done)
+		for _, interrupt := range early {
+			handle(interrupt)
+		}
+		for {
+			select {
+			case interrupt := <-c.requests:
+				handle(interrupt)
+			case <-c.closed:
+				return
+			}
+		}
+	}()
+
+	return c
+}
+
+type watchCancellationScope struct {
+	source   *watchCancellationScopeSource
+	context  *cancel.Context
+	requests chan bool // cancellation requests for the scope's update.
+	closed   chan bool // closed when the scope is closed.
+	done     chan bool // closed once the scope has stopped handling requests.
+}
+
+// request delivers a cancellation request to the scope, unless the scope is closed first.
+func (s *watchCancellationScope) request(interrupt bool) {
+	select {
+	case s.requests <- interrupt:
+	case <-s.closed:
+	}
+}
+
+func (s *watchCancellationScope) Context() *cancel.Context {
+	return s.context
+}
+
+func (s *watchCancellationScope) Close() {
+	s.source.lock.Lock()
+	if s.source.current == s {
+		s.source.current = nil
+	}
+	s.source.lock.Unlock()
+
+	close(s.closed)
+	<-s.done
+}
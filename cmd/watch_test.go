@@ -0,0 +1,94 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func TestSnapshotProject(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch-test")
+	assert.NoError(t, err)
+	defer func() {
+		contract.IgnoreError(os.RemoveAll(dir))
+	}()
+
+	write := func(name, contents string) {
+		assert.NoError(t, os.MkdirAll(path.Dir(path.Join(dir, name)), 0755))
+		assert.NoError(t, ioutil.WriteFile(path.Join(dir, name), []byte(contents), 0644))
+	}
+	write(".pulumiignore", "ignored/")
+	write("index.js", "1")
+
+	snapshot := func() map[string]fileStamp {
+		files, snapErr := snapshotProject(dir)
+		assert.NoError(t, snapErr)
+		return files
+	}
+	first := snapshot()
+	assert.Len(t, first, 2)
+
+	// Changes to ignored files and to stack state kept in the project do not count.
+	write("ignored/foo.txt", "foo")
+	write(path.Join(workspace.BookkeepingDir, "stacks", "dev.json"), "{}")
+	assert.False(t, projectChanged(first, snapshot()))
+
+	// Changes to other files do.
+	write("index.js", "12")
+	second := snapshot()
+	assert.True(t, projectChanged(first, second))
+	write("lib/util.js", "")
+	assert.True(t, projectChanged(second, snapshot()))
+}
+
+func TestWatchCancellationScopes(t *testing.T) {
+	source := &watchCancellationScopeSource{}
+	events := make(chan engine.Event, 8)
+
+	// A ^C that arrives before the update has created its scope is applied once the scope is created.
+	source.cancel(true)
+	scope := source.NewScope(events, false)
+	<-scope.Context().Canceled()
+	select {
+	case <-scope.Context().Terminated():
+		assert.Fail(t, "update terminated by a single ^C")
+	default:
+	}
+
+	// A second ^C terminates the update.
+	source.cancel(true)
+	<-scope.Context().Terminated()
+	scope.Close()
+
+	// A request made once the scope is closed does not block, and is discarded once the update finishes.
+	source.cancel(false)
+	source.reset()
+	scope = source.NewScope(events, false)
+	select {
+	case <-scope.Context().Canceled():
+		assert.Fail(t, "update canceled by a request made before it started")
+	default:
+	}
+	scope.Close()
+}
@@ -103,6 +103,8 @@ const (
 	OperationTypeDeleting OperationType = "deleting"
 	// OperationTypeReading is the state of resources that are being read.
 	OperationTypeReading OperationType = "reading"
+	// OperationTypeImporting is the state of resources that are being imported.
+	OperationTypeImporting OperationType = "importing"
 )
 
 // OperationV1 represents an operation that the engine is performing. It consists of a Resource, which is the state
@@ -196,6 +198,19 @@ type ResourceV2 struct {
 	InitErrors []string `json:"initErrors" yaml:"initErrors,omitempty"`
 	// Provider is a reference to the provider that is associated with this resource.
 	Provider string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	// CustomTimeouts overrides the timeouts of this resource's provisioning operations, if any.
+	CustomTimeouts *CustomTimeoutsV1 `json:"customTimeouts,omitempty" yaml:"customTimeouts,omitempty"`
+}
+
+// CustomTimeoutsV1 captures the custom timeouts, in seconds, of a resource's provisioning operations. A timeout of zero
+// means that the provider's default timeout is used.
+type CustomTimeoutsV1 struct {
+	// Create is the timeout for creating the resource.
+	Create float64 `json:"create,omitempty" yaml:"create,omitempty"`
+	// Update is the timeout for updating the resource.
+	Update float64 `json:"update,omitempty" yaml:"update,omitempty"`
+	// Delete is the timeout for deleting the resource.
+	Delete float64 `json:"delete,omitempty" yaml:"delete,omitempty"`
 }
 
 // ManifestV1 captures meta-information about this checkpoint file, such as versions of binaries, etc.
@@ -224,6 +239,9 @@ type ConfigValue struct {
 	String string `json:"string"`
 	// Secret is true if this value is a secret and false otherwise.
 	Secret bool `json:"secret"`
+	// Object is true if this value is an object or array, in which case String is its JSON representation, with
+	// each secret leaf represented as {"secure": "<base64-encoded ciphertext>"}.
+	Object bool `json:"object,omitempty"`
 }
 
 // StackTagName is the key for the tags bag in stack. This is just a string, but we use a type alias to provide a richer
@@ -243,6 +261,9 @@ const (
 	// GitHubRepositoryNameTag is a tag that represents the name of a repository on GitHub that this stack
 	// may be associated with (inferred by the CLI based on git remote info).
 	GitHubRepositoryNameTag StackTagName = "gitHub:repo"
+	// GitRemoteTag is a tag that represents the URL of the git remote that this stack may be associated with
+	// (inferred by the CLI from the "origin" remote).
+	GitRemoteTag StackTagName = "git:remote"
 )
 
 // Stack describes a Stack running on a Pulumi Cloud.
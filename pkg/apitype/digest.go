@@ -0,0 +1,90 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apitype
+
+// UpdateDigestVersionCurrent is the current version of the UpdateDigest format. Consumers should check the version of
+// each digest they read, as fields may change meaning when it is incremented.
+const UpdateDigestVersionCurrent = 1
+
+// UpdateDigest is a machine-readable description of a preview, update, refresh, or destroy. It is printed as a single
+// JSON document when one of these commands is run with `--json`.
+type UpdateDigest struct {
+	// Version is the version of the digest format (see UpdateDigestVersionCurrent).
+	Version int `json:"version"`
+	// Kind is the kind of update that was performed.
+	Kind UpdateKind `json:"kind"`
+	// IsPreview is true if the digest describes planned rather than performed operations.
+	IsPreview bool `json:"isPreview"`
+	// Config is the configuration used for the update. Secret values are never included.
+	Config map[string]string `json:"config,omitempty"`
+	// Steps is the list of resource operations, in the order in which they were planned or performed.
+	Steps []StepDigest `json:"steps"`
+	// Diagnostics is the list of messages (including program output) emitted during the update.
+	Diagnostics []DiagnosticDigest `json:"diagnostics,omitempty"`
+	// Outputs contains the stack's output properties.
+	Outputs map[string]interface{} `json:"outputs,omitempty"`
+	// ChangeSummary contains the number of resources affected by each kind of operation.
+	ChangeSummary map[string]int `json:"changeSummary,omitempty"`
+	// MaybeCorrupt is true if one or more resources may be corrupt as a result of a failed operation.
+	MaybeCorrupt bool `json:"maybeCorrupt,omitempty"`
+	// Duration is the duration of the update in seconds (zero for previews).
+	Duration float64 `json:"duration,omitempty"`
+}
+
+// StepDigest describes a single planned or performed resource operation.
+type StepDigest struct {
+	// Op is the operation (e.g., "create", "update", "replace", or "delete").
+	Op string `json:"op"`
+	// URN is the URN of the affected resource.
+	URN string `json:"urn"`
+	// Type is the type of the affected resource.
+	Type string `json:"type"`
+	// Provider is a reference to the provider that performs the operation, if any.
+	Provider string `json:"provider,omitempty"`
+	// OldInputs are the resource's inputs before the operation, if it existed before.
+	OldInputs map[string]interface{} `json:"oldInputs,omitempty"`
+	// NewInputs are the resource's inputs after the operation, if it exists afterwards.
+	NewInputs map[string]interface{} `json:"newInputs,omitempty"`
+	// Outputs are the resource's outputs after the operation, if the operation was performed.
+	Outputs map[string]interface{} `json:"outputs,omitempty"`
+	// ReplaceKeys are the top-level properties whose changes cause the resource to be replaced.
+	ReplaceKeys []string `json:"replaceKeys,omitempty"`
+	// DiffKeys are the top-level input properties whose values change.
+	DiffKeys []string `json:"diffKeys,omitempty"`
+	// DetailedDiff is the structured diff reported by the provider, keyed by property path, if it supplied one.
+	DetailedDiff map[string]PropertyDiffDigest `json:"detailedDiff,omitempty"`
+	// Failed is true if the operation was attempted and failed.
+	Failed bool `json:"failed,omitempty"`
+}
+
+// PropertyDiffDigest describes the change to a single property in a provider's detailed diff.
+type PropertyDiffDigest struct {
+	// Kind is the kind of change (e.g., "add", "update", or "delete-replace").
+	Kind string `json:"kind"`
+	// InputDiff is true if the change is between old and new inputs rather than old state and new inputs.
+	InputDiff bool `json:"inputDiff,omitempty"`
+}
+
+// DiagnosticDigest describes a diagnostic message emitted during an update.
+type DiagnosticDigest struct {
+	// URN is the URN of the resource the message is associated with, if any.
+	URN string `json:"urn,omitempty"`
+	// Prefix is the prefix displayed before the message, if any.
+	Prefix string `json:"prefix,omitempty"`
+	// Message is the text of the message, without colorization.
+	Message string `json:"message"`
+	// Severity is the severity of the message (e.g., "info", "warning", or "error").
+	Severity string `json:"severity"`
+}
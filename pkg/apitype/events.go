@@ -0,0 +1,152 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apitype
+
+// EngineEventVersionCurrent is the current version of the EngineEvent format. Every event carries the version with
+// which it was written, so that consumers of an event log can detect changes to the format.
+const EngineEventVersionCurrent = 1
+
+// EngineEvent is a single event emitted by the engine during an update, as written to an event log with
+// `--event-log`. Exactly one of the event-specific fields is set, according to Type.
+//
+// Property values never include the underlying values of secrets, and large assets are stripped, in the same way as
+// for the events displayed by the CLI.
+type EngineEvent struct {
+	// Version is the version of the event format (see EngineEventVersionCurrent).
+	Version int `json:"version"`
+	// Sequence is a number that increases by one with each event written to the log, starting at 1. All of the
+	// operations performed by a command share its log: the events of the update performed by `pulumi up` follow
+	// those of the preview that precedes it.
+	Sequence int `json:"sequence"`
+	// Timestamp is the time at which the event was received, in RFC 3339 format with nanosecond precision.
+	Timestamp string `json:"timestamp"`
+	// Type is the kind of event (e.g., "diag", "resource-pre", or "summary").
+	Type string `json:"type"`
+
+	CancelEvent                  *CancelEvent                  `json:"cancelEvent,omitempty"`
+	StdoutEvent                  *StdoutEngineEvent            `json:"stdoutEvent,omitempty"`
+	DiagnosticEvent              *DiagnosticEvent              `json:"diagnosticEvent,omitempty"`
+	PreludeEvent                 *PreludeEvent                 `json:"preludeEvent,omitempty"`
+	SummaryEvent                 *SummaryEvent                 `json:"summaryEvent,omitempty"`
+	ResourcePreEvent             *ResourcePreEvent             `json:"resourcePreEvent,omitempty"`
+	ResourceOutputsEvent         *ResourceOutputsEvent         `json:"resourceOutputsEvent,omitempty"`
+	ResourceOperationFailedEvent *ResourceOperationFailedEvent `json:"resourceOperationFailedEvent,omitempty"`
+}
+
+// CancelEvent is emitted when the engine has finished emitting events for an update.
+type CancelEvent struct{}
+
+// StdoutEngineEvent is emitted for output that is written directly to the console.
+type StdoutEngineEvent struct {
+	Message string `json:"message"`
+	Color   string `json:"color"`
+}
+
+// DiagnosticEvent is emitted for a diagnostic message, such as a log message from the program or a provider.
+type DiagnosticEvent struct {
+	URN       string `json:"urn,omitempty"`
+	Prefix    string `json:"prefix,omitempty"`
+	Message   string `json:"message"`
+	Color     string `json:"color"`
+	Severity  string `json:"severity"`
+	StreamID  int    `json:"streamID,omitempty"`
+	Ephemeral bool   `json:"ephemeral,omitempty"`
+}
+
+// PreludeEvent is emitted at the start of an update.
+type PreludeEvent struct {
+	// Config is the configuration used for the update. Secret values are blinded.
+	Config map[string]string `json:"config"`
+	// IsPreview is true if the update is a preview.
+	IsPreview bool `json:"isPreview"`
+}
+
+// SummaryEvent is emitted at the end of an update, with a summary of the changes made.
+type SummaryEvent struct {
+	// MaybeCorrupt is true if one or more resources may be corrupt as a result of a failed operation.
+	MaybeCorrupt bool `json:"maybeCorrupt"`
+	// DurationSeconds is the number of seconds the update took (zero for previews).
+	DurationSeconds int `json:"durationSeconds"`
+	// ResourceChanges contains the number of resources affected by each kind of operation.
+	ResourceChanges map[string]int `json:"resourceChanges"`
+	// IsPreview is true if the update is a preview.
+	IsPreview bool `json:"isPreview"`
+	// FailedResources contains the URNs of the resources whose operations failed, if the update continued after
+	// errors.
+	FailedResources []string `json:"failedResources,omitempty"`
+	// SkippedResources contains the URNs of the resources that were skipped because they depend on resources that
+	// failed, if the update continued after errors.
+	SkippedResources []string `json:"skippedResources,omitempty"`
+	// OrphanedResources contains the URNs of the resources that may have been created by an interrupted update but
+	// could not be looked up, and so were removed from the stack. They must be deleted manually if they exist.
+	OrphanedResources []string `json:"orphanedResources,omitempty"`
+}
+
+// StepEventMetadata describes a single resource operation.
+type StepEventMetadata struct {
+	// Op is the operation (e.g., "create", "update", "replace", or "delete").
+	Op string `json:"op"`
+	// URN is the URN of the affected resource.
+	URN string `json:"urn"`
+	// Type is the type of the affected resource.
+	Type string `json:"type"`
+	// Old is the state of the resource before the operation, if it existed before.
+	Old *StepEventStateMetadata `json:"old,omitempty"`
+	// New is the state of the resource after the operation, if it exists afterwards.
+	New *StepEventStateMetadata `json:"new,omitempty"`
+	// Keys are the top-level properties whose changes cause the resource to be replaced.
+	Keys []string `json:"keys,omitempty"`
+	// Logical is true if the operation corresponds to a resource in the program, rather than being one half of a
+	// replacement.
+	Logical bool `json:"logical,omitempty"`
+	// Provider is a reference to the provider that performs the operation, if any.
+	Provider string `json:"provider,omitempty"`
+	// DetailedDiff is the structured diff reported by the provider, keyed by property path, if it supplied one.
+	DetailedDiff map[string]PropertyDiffDigest `json:"detailedDiff,omitempty"`
+}
+
+// StepEventStateMetadata describes the state of a resource before or after an operation.
+type StepEventStateMetadata struct {
+	Type       string                 `json:"type"`
+	URN        string                 `json:"urn"`
+	Custom     bool                   `json:"custom,omitempty"`
+	Delete     bool                   `json:"delete,omitempty"`
+	ID         string                 `json:"id,omitempty"`
+	Parent     string                 `json:"parent,omitempty"`
+	Protect    bool                   `json:"protect,omitempty"`
+	Inputs     map[string]interface{} `json:"inputs,omitempty"`
+	Outputs    map[string]interface{} `json:"outputs,omitempty"`
+	Provider   string                 `json:"provider,omitempty"`
+	InitErrors []string               `json:"initErrors,omitempty"`
+}
+
+// ResourcePreEvent is emitted before a resource operation is planned or performed.
+type ResourcePreEvent struct {
+	Metadata StepEventMetadata `json:"metadata"`
+	Planning bool              `json:"planning,omitempty"`
+}
+
+// ResourceOutputsEvent is emitted after a resource operation has been planned or performed.
+type ResourceOutputsEvent struct {
+	Metadata StepEventMetadata `json:"metadata"`
+	Planning bool              `json:"planning,omitempty"`
+}
+
+// ResourceOperationFailedEvent is emitted when a resource operation fails.
+type ResourceOperationFailedEvent struct {
+	Metadata StepEventMetadata `json:"metadata"`
+	Status   int               `json:"status"`
+	Steps    int               `json:"steps"`
+}
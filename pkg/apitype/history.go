@@ -73,6 +73,8 @@ const (
 	OpCreateReplacement OpType = "create-replacement"
 	// OpDeleteReplaced indiciates an existing resource was deleted after replacement.
 	OpDeleteReplaced OpType = "delete-replaced"
+	// OpImport indicates an existing resource was imported.
+	OpImport OpType = "import"
 )
 
 // UpdateInfo describes a previous update.
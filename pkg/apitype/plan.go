@@ -0,0 +1,60 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apitype
+
+import (
+	"encoding/json"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+const (
+	// SavedPlanSchemaVersionCurrent is the current version of the `SavedPlan` schema.
+	// Any saved plans newer than this version will be rejected.
+	SavedPlanSchemaVersionCurrent = 1
+
+	// PlanUnknownSig is the signature of the objects that stand in for property values that were unknown when a plan
+	// was saved. It is stored under resource.SigKey, like the signatures of assets and secrets.
+	PlanUnknownSig = "7a5b9c30e3e14f2f9c0e2d8b1e6f4a93"
+)
+
+// VersionedSavedPlan is a version number plus a json document. The version number describes what
+// version of the SavedPlan structure the Plan member's json document can decode into.
+type VersionedSavedPlan struct {
+	Version int             `json:"version"`
+	Plan    json.RawMessage `json:"plan"`
+}
+
+// SavedPlanV1 is the set of steps that a preview expected an update to perform. An update that follows a saved plan
+// fails if it would perform any step that does not match the plan.
+type SavedPlanV1 struct {
+	// Steps are the planned steps, in the order in which they were generated.
+	Steps []PlannedStepV1 `json:"steps" yaml:"steps"`
+}
+
+// PlannedStepV1 is a single step that a preview expected an update to perform.
+type PlannedStepV1 struct {
+	// Op is the operation that the step performs. In addition to the operations described by OpType, this may be
+	// one of the read operations ("read" or "read-replacement").
+	Op OpType `json:"op" yaml:"op"`
+	// URN is the URN of the resource on which the step operates.
+	URN resource.URN `json:"urn" yaml:"urn"`
+	// Inputs are the expected inputs of the resource produced by the step, if any. Values that were unknown at the
+	// time of the preview are recorded as objects that carry PlanUnknownSig under resource.SigKey.
+	Inputs map[string]interface{} `json:"inputs,omitempty" yaml:"inputs,omitempty"`
+	// Outputs are the outputs of the existing resource on which the step operates, if any. The step may only be
+	// performed if the resource's outputs have not changed since the preview.
+	Outputs map[string]interface{} `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
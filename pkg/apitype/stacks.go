@@ -28,6 +28,9 @@ type StackSummary struct {
 
 	// ResourceCount is the number of resources associated with this stack, as applicable.
 	ResourceCount *int `json:"resourceCount,omitempty"`
+
+	// Tags are the stack's tags, as applicable.
+	Tags map[StackTagName]string `json:"tags,omitempty"`
 }
 
 // ListStacksResponse returns a set of stack summaries. This call is designed to be inexpensive.
@@ -51,6 +54,12 @@ type CreateStackRequest struct {
 	Tags map[StackTagName]string `json:"tags,omitEmpty"`
 }
 
+// StackRenameRequest defines the request body for renaming a Stack. The stack's current identifier is in the URL.
+type StackRenameRequest struct {
+	// The new name for the stack.
+	NewName string `json:"newName"`
+}
+
 // CreateStackResponseByName is the response from a create Stack request.
 type CreateStackResponseByName struct {
 	// The name of the cloud used if the default was sent.
@@ -120,7 +120,7 @@ func PreviewThenPrompt(ctx context.Context, kind apitype.UpdateKind, stack Stack
 	}
 
 	// If there are no changes, or we're auto-approving or just previewing, we can skip the confirmation prompt.
-	if op.Opts.AutoApprove || kind == apitype.PreviewUpdate {
+	if op.Opts.AutoApprove || op.Opts.PreviewOnly || kind == apitype.PreviewUpdate {
 		close(eventsChannel)
 		return changes, nil
 	}
@@ -194,7 +194,7 @@ func PreviewThenPromptThenExecute(ctx context.Context, kind apitype.UpdateKind,
 	op UpdateOperation, apply Applier) (engine.ResourceChanges, error) {
 	// Preview the operation to the user and ask them if they want to proceed.
 	changes, err := PreviewThenPrompt(ctx, kind, stack, op, apply)
-	if err != nil || kind == apitype.PreviewUpdate {
+	if err != nil || kind == apitype.PreviewUpdate || op.Opts.PreviewOnly {
 		return changes, err
 	}
 
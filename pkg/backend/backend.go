@@ -67,6 +67,8 @@ type StackSummary interface {
 	LastUpdate() *time.Time
 	// ResourceCount returns the stack's resource count, as applicable.
 	ResourceCount() *int
+	// Tags returns the stack's tags, or nil if the backend did not return them along with the summary.
+	Tags() map[apitype.StackTagName]string
 }
 
 // Backend is an interface that represents actions the engine will interact with to manage stacks of cloud resources.
@@ -89,9 +91,17 @@ type Backend interface {
 	// still contains resources.  Otherwise, if the stack contains resources, a non-nil error is returned, and the
 	// first boolean return value will be set to true.
 	RemoveStack(ctx context.Context, stackRef StackReference, force bool) (bool, error)
+	// RenameStack renames the given stack to newName, updating the URNs of all of its resources to match, and returns
+	// a reference to the renamed stack.
+	RenameStack(ctx context.Context, stackRef StackReference, newName tokens.QName) (StackReference, error)
 	// ListStacks returns a list of stack summaries for all known stacks in the target backend.
 	ListStacks(ctx context.Context, projectFilter *tokens.PackageName) ([]StackSummary, error)
 
+	// GetStackTags returns the tags for the given stack.
+	GetStackTags(ctx context.Context, stackRef StackReference) (map[apitype.StackTagName]string, error)
+	// UpdateStackTags replaces the tags for the given stack with the given set.
+	UpdateStackTags(ctx context.Context, stackRef StackReference, tags map[apitype.StackTagName]string) error
+
 	// GetStackCrypter returns an encrypter/decrypter for the given stack's secret config values.
 	GetStackCrypter(stackRef StackReference) (config.Crypter, error)
 
@@ -142,6 +152,8 @@ type UpdateOptions struct {
 	AutoApprove bool
 	// SkipPreview, when true, causes the preview step to be skipped.
 	SkipPreview bool
+	// PreviewOnly, when true, causes the operation to stop once it has been previewed, without prompting.
+	PreviewOnly bool
 }
 
 // CancellationScope provides a scoped source of cancellation and termination requests.
@@ -0,0 +1,68 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+)
+
+// NewBackendClient returns a deploy.BackendClient that reads information about the stacks managed by the given
+// backend. Stack names are interpreted by the backend's ParseStackReference.
+func NewBackendClient(backend Backend) deploy.BackendClient {
+	return &backendClient{backend: backend}
+}
+
+type backendClient struct {
+	backend Backend
+}
+
+// GetStackOutputs returns the outputs of the root resource of the named stack. Secret outputs remain secret.
+func (c *backendClient) GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error) {
+	ref, err := c.backend.ParseStackReference(name)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.backend.GetStack(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errors.Errorf("unknown stack %q", name)
+	}
+	snap, err := s.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if snap != nil {
+		for _, res := range snap.Resources {
+			if res.Type == resource.RootStackType && !res.Delete {
+				// Decrypt any secret outputs that the backend left encrypted; the stack's crypter is only consulted
+				// if there are any.
+				crypter, err := GetStackCrypter(s)
+				if err != nil {
+					return nil, err
+				}
+				return stack.DecryptProperties(res.Outputs, crypter)
+			}
+		}
+	}
+	return resource.PropertyMap{}, nil
+}
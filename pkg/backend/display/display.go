@@ -38,16 +38,38 @@ import (
 
 // ShowEvents reads events from the `events` channel until it is closed, displaying each event as
 // it comes in. Once all events have been read from the channel and displayed, it closes the `done`
-// channel so the caller can await all the events being written.
+// channel so the caller can await all the events being written. If an event log is set in the options, each event
+// is also written to that log as a line of JSON.
 func ShowEvents(op string, action apitype.UpdateKind, stack tokens.QName, proj tokens.PackageName,
 	events <-chan engine.Event, done chan<- bool, opts Options) {
-	if opts.DiffDisplay {
+	if opts.EventLog != nil {
+		events, done = startEventLogger(events, done, opts.EventLog)
+	}
+
+	if opts.SuppressDisplay {
+		discardEvents(events, done)
+	} else if opts.JSONDisplay {
+		ShowJSONEvents(op, action, events, done, opts)
+	} else if opts.DiffDisplay {
 		ShowDiffEvents(op, action, events, done, opts)
 	} else {
 		ShowProgressEvents(op, action, stack, proj, events, done, opts)
 	}
 }
 
+// discardEvents reads events from the `events` channel until the engine is finished, without displaying them.
+func discardEvents(events <-chan engine.Event, done chan<- bool) {
+	defer func() {
+		done <- true
+	}()
+
+	for e := range events {
+		if e.Type == engine.CancelEvent {
+			return
+		}
+	}
+}
+
 type nopSpinner struct {
 }
 
@@ -185,6 +207,34 @@ func renderSummaryEvent(action apitype.UpdateKind, event engine.SummaryEventPayl
 		fprintfIgnoreError(out, "    %d unchanged\n", c)
 	}
 
+	// If the update continued after errors, list the resources that failed and those that were skipped as a result.
+	if len(event.Failed) > 0 {
+		fprintIgnoreError(out, opts.Color.Colorize(fmt.Sprintf("\n%s%d %s failed:%s\n",
+			colors.SpecError, len(event.Failed), english.PluralWord(len(event.Failed), "resource", ""), colors.Reset)))
+		for _, urn := range event.Failed {
+			fprintfIgnoreError(out, "    %s\n", urn)
+		}
+	}
+	if len(event.Skipped) > 0 {
+		fprintIgnoreError(out, opts.Color.Colorize(fmt.Sprintf("\n%s%d %s skipped because of failures:%s\n",
+			colors.SpecWarning, len(event.Skipped), english.PluralWord(len(event.Skipped), "resource", ""),
+			colors.Reset)))
+		for _, urn := range event.Skipped {
+			fprintfIgnoreError(out, "    %s\n", urn)
+		}
+	}
+
+	// If pending operations were resolved, list the resources that could not be found and must be cleaned up by hand.
+	if len(event.Orphaned) > 0 {
+		fprintIgnoreError(out, opts.Color.Colorize(fmt.Sprintf(
+			"\n%s%d %s removed from the stack, and must be deleted manually if %s:%s\n",
+			colors.SpecWarning, len(event.Orphaned), english.PluralWord(len(event.Orphaned), "resource", ""),
+			english.PluralWord(len(event.Orphaned), "it exists", "they exist"), colors.Reset)))
+		for _, urn := range event.Orphaned {
+			fprintfIgnoreError(out, "    %s\n", urn)
+		}
+	}
+
 	// For actual deploys, we print some additional summary information
 	if !event.IsPreview {
 		fprintIgnoreError(out, opts.Color.Colorize(fmt.Sprintf("\n%sDuration: %s%s\n",
@@ -0,0 +1,242 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+)
+
+// ConvertEngineEvent converts an engine event into its serializable form. The sequence number and timestamp of the
+// result are left unset.
+//
+// The engine has already filtered the properties and messages carried by its events, removing secrets and stripping
+// large assets, so the result is safe to write to a log. Secret values are written as "[secret]".
+func ConvertEngineEvent(e engine.Event) (apitype.EngineEvent, error) {
+	apiEvent := apitype.EngineEvent{
+		Version: apitype.EngineEventVersionCurrent,
+		Type:    string(e.Type),
+	}
+
+	switch e.Type {
+	case engine.CancelEvent:
+		apiEvent.CancelEvent = &apitype.CancelEvent{}
+	case engine.StdoutColorEvent:
+		p := e.Payload.(engine.StdoutEventPayload)
+		apiEvent.StdoutEvent = &apitype.StdoutEngineEvent{
+			Message: p.Message,
+			Color:   string(p.Color),
+		}
+	case engine.DiagEvent:
+		p := e.Payload.(engine.DiagEventPayload)
+		apiEvent.DiagnosticEvent = &apitype.DiagnosticEvent{
+			URN:       string(p.URN),
+			Prefix:    p.Prefix,
+			Message:   p.Message,
+			Color:     string(p.Color),
+			Severity:  string(p.Severity),
+			StreamID:  int(p.StreamID),
+			Ephemeral: p.Ephemeral,
+		}
+	case engine.PreludeEvent:
+		p := e.Payload.(engine.PreludeEventPayload)
+		apiEvent.PreludeEvent = &apitype.PreludeEvent{
+			Config:    p.Config,
+			IsPreview: p.IsPreview,
+		}
+	case engine.SummaryEvent:
+		p := e.Payload.(engine.SummaryEventPayload)
+		changes := make(map[string]int)
+		for op, count := range p.ResourceChanges {
+			changes[string(op)] = count
+		}
+		apiEvent.SummaryEvent = &apitype.SummaryEvent{
+			MaybeCorrupt:    p.MaybeCorrupt,
+			DurationSeconds: int(p.Duration.Seconds()),
+			ResourceChanges: changes,
+			IsPreview:       p.IsPreview,
+		}
+		for _, urn := range p.Failed {
+			apiEvent.SummaryEvent.FailedResources = append(apiEvent.SummaryEvent.FailedResources, string(urn))
+		}
+		for _, urn := range p.Skipped {
+			apiEvent.SummaryEvent.SkippedResources = append(apiEvent.SummaryEvent.SkippedResources, string(urn))
+		}
+		for _, urn := range p.Orphaned {
+			apiEvent.SummaryEvent.OrphanedResources = append(apiEvent.SummaryEvent.OrphanedResources, string(urn))
+		}
+	case engine.ResourcePreEvent:
+		p := e.Payload.(engine.ResourcePreEventPayload)
+		apiEvent.ResourcePreEvent = &apitype.ResourcePreEvent{
+			Metadata: convertStepEventMetadata(p.Metadata),
+			Planning: p.Planning,
+		}
+	case engine.ResourceOutputsEvent:
+		p := e.Payload.(engine.ResourceOutputsEventPayload)
+		apiEvent.ResourceOutputsEvent = &apitype.ResourceOutputsEvent{
+			Metadata: convertStepEventMetadata(p.Metadata),
+			Planning: p.Planning,
+		}
+	case engine.ResourceOperationFailed:
+		p := e.Payload.(engine.ResourceOperationFailedPayload)
+		apiEvent.ResourceOperationFailedEvent = &apitype.ResourceOperationFailedEvent{
+			Metadata: convertStepEventMetadata(p.Metadata),
+			Status:   int(p.Status),
+			Steps:    p.Steps,
+		}
+	default:
+		return apiEvent, errors.Errorf("unknown event type %q", e.Type)
+	}
+	return apiEvent, nil
+}
+
+func convertStepEventMetadata(md engine.StepEventMetadata) apitype.StepEventMetadata {
+	result := apitype.StepEventMetadata{
+		Op:       string(md.Op),
+		URN:      string(md.URN),
+		Type:     string(md.Type),
+		Old:      convertStepEventStateMetadata(md.Old),
+		New:      convertStepEventStateMetadata(md.New),
+		Logical:  md.Logical,
+		Provider: md.Provider,
+	}
+	for _, k := range md.Keys {
+		result.Keys = append(result.Keys, string(k))
+	}
+	if md.DetailedDiff != nil {
+		result.DetailedDiff = make(map[string]apitype.PropertyDiffDigest)
+		for path, pdiff := range md.DetailedDiff {
+			result.DetailedDiff[path] = apitype.PropertyDiffDigest{
+				Kind:      pdiff.Kind.String(),
+				InputDiff: pdiff.InputDiff,
+			}
+		}
+	}
+	return result
+}
+
+func convertStepEventStateMetadata(md *engine.StepEventStateMetadata) *apitype.StepEventStateMetadata {
+	if md == nil {
+		return nil
+	}
+	return &apitype.StepEventStateMetadata{
+		Type:       string(md.Type),
+		URN:        string(md.URN),
+		Custom:     md.Custom,
+		Delete:     md.Delete,
+		ID:         string(md.ID),
+		Parent:     string(md.Parent),
+		Protect:    md.Protect,
+		Inputs:     digestProperties(md.Inputs),
+		Outputs:    digestProperties(md.Outputs),
+		Provider:   md.Provider,
+		InitErrors: md.InitErrors,
+	}
+}
+
+// EventLog is a file to which engine events are appended as newline-delimited JSON. A command creates a single log and
+// shares it between all of the operations that it performs, such as the preview and the update performed by
+// `pulumi up`, so that the sequence numbers of the logged events increase across the whole command.
+type EventLog struct {
+	lock     sync.Mutex    // Lock protecting the fields below.
+	path     string        // The path of the log file.
+	file     *os.File      // The log file.
+	writer   *bufio.Writer // A buffered writer for the log file.
+	encoder  *json.Encoder // An encoder that writes to writer.
+	sequence int           // The sequence number of the last event written to the log.
+	err      error         // The error that stopped writes to the log, if any.
+}
+
+// NewEventLog creates an empty event log at the given path, replacing any existing file.
+func NewEventLog(path string) (*EventLog, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating event log")
+	}
+	writer := bufio.NewWriter(file)
+	return &EventLog{path: path, file: file, writer: writer, encoder: json.NewEncoder(writer)}, nil
+}
+
+// Close closes the event log. Close may be called on a nil log, in which case it does nothing.
+func (l *EventLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+// write appends the given event to the log. A failure to write to the log does not fail the operation that emitted
+// the event; it is logged, and no further events are written.
+func (l *EventLog) write(e engine.Event) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.err != nil {
+		return
+	}
+	l.sequence++
+	err := logEngineEvent(l.encoder, e, l.sequence)
+	if err == nil {
+		err = l.writer.Flush()
+	}
+	if err != nil {
+		logging.V(3).Infof("failed to write to event log %s: %v", l.path, err)
+		l.err = err
+	}
+}
+
+// startEventLogger starts appending the events read from `events` to the given log. Each event is forwarded to the
+// returned events channel after it has been logged. Once the consumer of the returned channels signals that it is
+// done, `done` is signaled.
+func startEventLogger(events <-chan engine.Event, done chan<- bool,
+	log *EventLog) (<-chan engine.Event, chan<- bool) {
+
+	outEvents, outDone := make(chan engine.Event), make(chan bool)
+	go func() {
+		defer func() {
+			<-outDone
+			done <- true
+		}()
+
+		for e := range events {
+			log.write(e)
+
+			outEvents <- e
+			if e.Type == engine.CancelEvent {
+				return
+			}
+		}
+	}()
+
+	return outEvents, outDone
+}
+
+func logEngineEvent(encoder *json.Encoder, e engine.Event, sequence int) error {
+	apiEvent, err := ConvertEngineEvent(e)
+	if err != nil {
+		return err
+	}
+	apiEvent.Sequence, apiEvent.Timestamp = sequence, time.Now().Format(time.RFC3339Nano)
+	return encoder.Encode(apiEvent)
+}
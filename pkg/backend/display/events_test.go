@@ -0,0 +1,142 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+)
+
+func TestConvertEngineEvent(t *testing.T) {
+	urn := resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket")
+	e, err := ConvertEngineEvent(engine.Event{Type: engine.ResourcePreEvent, Payload: engine.ResourcePreEventPayload{
+		Metadata: engine.StepEventMetadata{
+			Op:      deploy.OpCreate,
+			URN:     urn,
+			Type:    "aws:s3/bucket:Bucket",
+			Logical: true,
+			New: &engine.StepEventStateMetadata{
+				URN:  urn,
+				Type: "aws:s3/bucket:Bucket",
+				Inputs: resource.PropertyMap{
+					"bucket":   resource.NewStringProperty("b"),
+					"password": resource.MakeSecret(resource.NewStringProperty("[secret]")),
+				},
+			},
+		},
+		Planning: true,
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, apitype.EngineEventVersionCurrent, e.Version)
+	assert.Equal(t, "resource-pre", e.Type)
+	if assert.NotNil(t, e.ResourcePreEvent) {
+		md := e.ResourcePreEvent.Metadata
+		assert.Equal(t, "create", md.Op)
+		assert.Equal(t, string(urn), md.URN)
+		assert.Nil(t, md.Old)
+		if assert.NotNil(t, md.New) {
+			assert.Equal(t, map[string]interface{}{"bucket": "b", "password": "[secret]"}, md.New.Inputs)
+		}
+		assert.True(t, e.ResourcePreEvent.Planning)
+	}
+
+	_, err = ConvertEngineEvent(engine.Event{Type: "bogus"})
+	assert.Error(t, err)
+}
+
+func TestEventLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "event-log")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer func() { assert.NoError(t, os.RemoveAll(dir)) }()
+	path := filepath.Join(dir, "events.json")
+
+	log, err := NewEventLog(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// Log two operations, such as the preview and update performed by `pulumi up`, to the same log.
+	var seen []engine.EventType
+	for _, isPreview := range []bool{true, false} {
+		events, done := make(chan engine.Event), make(chan bool)
+		logEvents, logDone := startEventLogger(events, done, log)
+
+		// Consume the forwarded events as a display would.
+		go func() {
+			for e := range logEvents {
+				seen = append(seen, e.Type)
+				if e.Type == engine.CancelEvent {
+					break
+				}
+			}
+			logDone <- true
+		}()
+
+		events <- engine.Event{Type: engine.PreludeEvent, Payload: engine.PreludeEventPayload{IsPreview: isPreview}}
+		events <- engine.Event{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{
+			Message: "hello", Severity: diag.Info,
+		}}
+		events <- engine.Event{Type: engine.CancelEvent}
+		<-done
+	}
+	assert.NoError(t, log.Close())
+
+	assert.Equal(t, []engine.EventType{
+		engine.PreludeEvent, engine.DiagEvent, engine.CancelEvent,
+		engine.PreludeEvent, engine.DiagEvent, engine.CancelEvent,
+	}, seen)
+
+	f, err := os.Open(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer func() { assert.NoError(t, f.Close()) }()
+
+	var logged []apitype.EngineEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e apitype.EngineEvent
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		logged = append(logged, e)
+	}
+	assert.NoError(t, scanner.Err())
+	if assert.Len(t, logged, 6) {
+		// Sequence numbers continue across the operations.
+		for i, e := range logged {
+			assert.Equal(t, i+1, e.Sequence)
+			_, err := time.Parse(time.RFC3339Nano, e.Timestamp)
+			assert.NoError(t, err)
+		}
+		assert.True(t, logged[0].PreludeEvent.IsPreview)
+		assert.Equal(t, "hello", logged[1].DiagnosticEvent.Message)
+		assert.NotNil(t, logged[2].CancelEvent)
+		assert.False(t, logged[3].PreludeEvent.IsPreview)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+)
+
+// ShowJSONEvents reads events from the `events` channel until it is closed, and then prints a single JSON document
+// (an apitype.UpdateDigest) describing the update to stdout.
+func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.Event, done chan<- bool, opts Options) {
+	digest := newUpdateDigest(action)
+	defer func() {
+		out, err := json.MarshalIndent(digest, "", "    ")
+		contract.IgnoreError(err)
+		fprintIgnoreError(os.Stdout, string(out)+"\n")
+		done <- true
+	}()
+
+	for e := range events {
+		if e.Type == engine.CancelEvent {
+			return
+		}
+		addDigestEvent(digest, e, opts)
+	}
+}
+
+func newUpdateDigest(action apitype.UpdateKind) *apitype.UpdateDigest {
+	return &apitype.UpdateDigest{
+		Version: apitype.UpdateDigestVersionCurrent,
+		Kind:    action,
+		Steps:   []apitype.StepDigest{},
+	}
+}
+
+// addDigestEvent records the information carried by a single engine event in the given digest.
+func addDigestEvent(digest *apitype.UpdateDigest, event engine.Event, opts Options) {
+	switch event.Type {
+	case engine.PreludeEvent:
+		payload := event.Payload.(engine.PreludeEventPayload)
+		digest.IsPreview = payload.IsPreview
+		if len(payload.Config) > 0 {
+			digest.Config = payload.Config
+		}
+	case engine.SummaryEvent:
+		payload := event.Payload.(engine.SummaryEventPayload)
+		digest.IsPreview = payload.IsPreview
+		digest.MaybeCorrupt = payload.MaybeCorrupt
+		digest.Duration = payload.Duration.Seconds()
+		digest.ChangeSummary = make(map[string]int)
+		for op, c := range payload.ResourceChanges {
+			digest.ChangeSummary[string(op)] = c
+		}
+	case engine.ResourcePreEvent:
+		step := event.Payload.(engine.ResourcePreEventPayload).Metadata
+		if step.Op == deploy.OpSame && !opts.ShowSameResources {
+			return
+		}
+		if !step.Logical && !opts.ShowReplacementSteps {
+			return
+		}
+		digest.Steps = append(digest.Steps, newStepDigest(step))
+	case engine.ResourceOutputsEvent:
+		step := event.Payload.(engine.ResourceOutputsEventPayload).Metadata
+		if step.New == nil {
+			return
+		}
+		if step.Type == resource.RootStackType {
+			digest.Outputs = digestProperties(step.New.Outputs)
+		}
+		if s := findStepDigest(digest, step); s != nil {
+			s.Outputs = digestProperties(step.New.Outputs)
+		}
+	case engine.ResourceOperationFailed:
+		step := event.Payload.(engine.ResourceOperationFailedPayload).Metadata
+		if s := findStepDigest(digest, step); s != nil {
+			s.Failed = true
+		}
+	case engine.DiagEvent:
+		payload := event.Payload.(engine.DiagEventPayload)
+		if payload.Ephemeral || (payload.Severity == diag.Debug && !opts.Debug) {
+			return
+		}
+		digest.Diagnostics = append(digest.Diagnostics, apitype.DiagnosticDigest{
+			URN:      string(payload.URN),
+			Prefix:   colors.Never.Colorize(payload.Prefix),
+			Message:  strings.TrimRight(colors.Never.Colorize(payload.Message), "\n"),
+			Severity: string(payload.Severity),
+		})
+	case engine.StdoutColorEvent:
+		payload := event.Payload.(engine.StdoutEventPayload)
+		digest.Diagnostics = append(digest.Diagnostics, apitype.DiagnosticDigest{
+			Message:  strings.TrimRight(colors.Never.Colorize(payload.Message), "\n"),
+			Severity: string(diag.Info),
+		})
+	default:
+		contract.Failf("unknown event type '%s'", event.Type)
+	}
+}
+
+// findStepDigest returns the most recent step in the digest for the given step's resource and operation, if any.
+func findStepDigest(digest *apitype.UpdateDigest, step engine.StepEventMetadata) *apitype.StepDigest {
+	for i := len(digest.Steps) - 1; i >= 0; i-- {
+		if s := &digest.Steps[i]; s.URN == string(step.URN) && s.Op == string(step.Op) {
+			return s
+		}
+	}
+	return nil
+}
+
+func newStepDigest(step engine.StepEventMetadata) apitype.StepDigest {
+	result := apitype.StepDigest{
+		Op:       string(step.Op),
+		URN:      string(step.URN),
+		Type:     string(step.Type),
+		Provider: step.Provider,
+	}
+
+	var oldInputs, newInputs resource.PropertyMap
+	if step.Old != nil {
+		oldInputs = step.Old.Inputs
+		result.OldInputs = digestProperties(oldInputs)
+	}
+	if step.New != nil {
+		newInputs = step.New.Inputs
+		result.NewInputs = digestProperties(newInputs)
+	}
+	if step.Old != nil && step.New != nil {
+		if diff := oldInputs.Diff(newInputs); diff != nil {
+			for _, k := range diff.Keys() {
+				if diff.Changed(k) {
+					result.DiffKeys = append(result.DiffKeys, string(k))
+				}
+			}
+			sort.Strings(result.DiffKeys)
+		}
+	}
+
+	for _, k := range step.Keys {
+		result.ReplaceKeys = append(result.ReplaceKeys, string(k))
+	}
+	if step.DetailedDiff != nil {
+		result.DetailedDiff = make(map[string]apitype.PropertyDiffDigest)
+		for path, pdiff := range step.DetailedDiff {
+			result.DetailedDiff[path] = apitype.PropertyDiffDigest{
+				Kind:      pdiff.Kind.String(),
+				InputDiff: pdiff.InputDiff,
+			}
+		}
+	}
+	return result
+}
+
+// digestProperties converts a property map into a JSON-friendly form. Unknown values are represented by the same
+// sentinel used when they are sent to providers, and secret values are hidden.
+func digestProperties(props resource.PropertyMap) map[string]interface{} {
+	if props == nil {
+		return nil
+	}
+	return props.MapRepl(nil, func(v resource.PropertyValue) (interface{}, bool) {
+		switch {
+		case v.IsComputed() || v.IsOutput():
+			return plugin.UnknownStringValue, true
+		case v.IsSecret():
+			return "[secret]", true
+		default:
+			return nil, false
+		}
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+)
+
+func TestUpdateDigest(t *testing.T) {
+	rootURN := resource.URN("urn:pulumi:dev::proj::pulumi:pulumi:Stack::proj-dev")
+	bucketURN := resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket")
+
+	events := []engine.Event{
+		{Type: engine.PreludeEvent, Payload: engine.PreludeEventPayload{
+			IsPreview: true,
+			Config:    map[string]string{"aws:region": "us-west-2"},
+		}},
+		{Type: engine.ResourcePreEvent, Payload: engine.ResourcePreEventPayload{
+			Metadata: engine.StepEventMetadata{
+				Op: deploy.OpSame, URN: rootURN, Type: resource.RootStackType, Logical: true,
+			},
+			Planning: true,
+		}},
+		{Type: engine.ResourcePreEvent, Payload: engine.ResourcePreEventPayload{
+			Metadata: engine.StepEventMetadata{
+				Op:      deploy.OpReplace,
+				URN:     bucketURN,
+				Type:    "aws:s3/bucket:Bucket",
+				Logical: true,
+				Keys:    []resource.PropertyKey{"bucket"},
+				Old: &engine.StepEventStateMetadata{Inputs: resource.PropertyMap{
+					"bucket": resource.NewStringProperty("a"),
+					"acl":    resource.NewStringProperty("private"),
+				}},
+				New: &engine.StepEventStateMetadata{Inputs: resource.PropertyMap{
+					"bucket":   resource.NewStringProperty("b"),
+					"acl":      resource.NewStringProperty("private"),
+					"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+					"arn":      resource.MakeComputed(resource.NewStringProperty("")),
+				}},
+				DetailedDiff: map[string]plugin.PropertyDiff{
+					"bucket": {Kind: plugin.DiffUpdateReplace, InputDiff: true},
+				},
+			},
+			Planning: true,
+		}},
+		{Type: engine.ResourceOutputsEvent, Payload: engine.ResourceOutputsEventPayload{
+			Metadata: engine.StepEventMetadata{
+				Op:   deploy.OpSame,
+				URN:  rootURN,
+				Type: resource.RootStackType,
+				New: &engine.StepEventStateMetadata{Outputs: resource.PropertyMap{
+					"url": resource.NewStringProperty("http://example.com"),
+				}},
+			},
+			Planning: true,
+		}},
+		{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{
+			URN: bucketURN, Message: "<{%fg 3%}>be careful<{%reset%}>\n", Severity: diag.Warning,
+		}},
+		{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{Message: "hidden", Severity: diag.Debug}},
+		{Type: engine.SummaryEvent, Payload: engine.SummaryEventPayload{
+			IsPreview:       true,
+			Duration:        2 * time.Second,
+			ResourceChanges: engine.ResourceChanges{deploy.OpSame: 1, deploy.OpReplace: 1},
+		}},
+	}
+
+	digest := newUpdateDigest(apitype.PreviewUpdate)
+	for _, e := range events {
+		addDigestEvent(digest, e, Options{})
+	}
+
+	assert.Equal(t, apitype.UpdateDigestVersionCurrent, digest.Version)
+	assert.True(t, digest.IsPreview)
+	assert.Equal(t, map[string]string{"aws:region": "us-west-2"}, digest.Config)
+	assert.Equal(t, map[string]interface{}{"url": "http://example.com"}, digest.Outputs)
+	assert.Equal(t, map[string]int{"same": 1, "replace": 1}, digest.ChangeSummary)
+
+	// Sames are omitted by default.
+	if assert.Len(t, digest.Steps, 1) {
+		step := digest.Steps[0]
+		assert.Equal(t, "replace", step.Op)
+		assert.Equal(t, string(bucketURN), step.URN)
+		assert.Equal(t, []string{"bucket"}, step.ReplaceKeys)
+		assert.Equal(t, []string{"arn", "bucket", "password"}, step.DiffKeys)
+		assert.Equal(t, "[secret]", step.NewInputs["password"])
+		assert.Equal(t, plugin.UnknownStringValue, step.NewInputs["arn"])
+		assert.Equal(t, map[string]apitype.PropertyDiffDigest{
+			"bucket": {Kind: "update-replace", InputDiff: true},
+		}, step.DetailedDiff)
+	}
+
+	// Debug messages are omitted unless debugging, and colors are removed.
+	if assert.Len(t, digest.Diagnostics, 1) {
+		assert.Equal(t, apitype.DiagnosticDigest{
+			URN: string(bucketURN), Message: "be careful", Severity: "warning",
+		}, digest.Diagnostics[0])
+	}
+}
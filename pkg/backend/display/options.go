@@ -25,5 +25,8 @@ type Options struct {
 	SummaryDiff          bool                // If the diff display should be summarized
 	IsInteractive        bool                // If we should display things interactively
 	DiffDisplay          bool                // true if we should display things as a rich diff
+	JSONDisplay          bool                // true if we should emit a single JSON document instead of a display
+	EventLog             *EventLog           // the log to which to write events as newline-delimited JSON, if any
+	SuppressDisplay      bool                // true if nothing should be displayed, as the caller reports results
 	Debug                bool
 }
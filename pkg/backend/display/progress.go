@@ -1034,6 +1034,8 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				return "reading failed"
 			case deploy.OpRefresh:
 				return "refreshing failed"
+			case deploy.OpImport:
+				return "importing failed"
 			}
 		} else {
 			switch op {
@@ -1058,6 +1060,8 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				return "read for replacement"
 			case deploy.OpRefresh:
 				return "refresh"
+			case deploy.OpImport:
+				return "imported"
 			}
 		}
 
@@ -1095,6 +1099,8 @@ func (display *ProgressDisplay) getPreviewText(step engine.StepEventMetadata) st
 		return "read for replacement"
 	case deploy.OpRefresh:
 		return "refreshing"
+	case deploy.OpImport:
+		return "import"
 	}
 
 	contract.Failf("Unrecognized resource step op: %v", step.Op)
@@ -1120,6 +1126,8 @@ func (display *ProgressDisplay) getPreviewDoneText(step engine.StepEventMetadata
 		return "read"
 	case deploy.OpRefresh:
 		return "refresh"
+	case deploy.OpImport:
+		return "import"
 	}
 
 	contract.Failf("Unrecognized resource step op: %v", step.Op)
@@ -1190,6 +1198,8 @@ func (display *ProgressDisplay) getStepInProgressDescription(step engine.StepEve
 			return "reading for replacement"
 		case deploy.OpRefresh:
 			return "refreshing"
+		case deploy.OpImport:
+			return "importing"
 		}
 
 		contract.Failf("Unrecognized resource step op: %v", op)
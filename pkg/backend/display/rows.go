@@ -27,6 +27,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/engine"
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 )
 
 type Row interface {
@@ -388,7 +389,9 @@ func (data *resourceRowData) getDiffInfo() string {
 	changesBuf := &bytes.Buffer{}
 	if step.Old != nil && step.New != nil {
 		var diff *resource.ObjectDiff
-		if data.diffOutputs {
+		if step.DetailedDiff != nil {
+			diff = engine.TranslateDetailedDiff(step)
+		} else if data.diffOutputs {
 			if step.Old.Outputs != nil && step.New.Outputs != nil {
 				diff = step.Old.Outputs.Diff(step.New.Outputs)
 			}
@@ -421,14 +424,34 @@ func (data *resourceRowData) getDiffInfo() string {
 		if diff != nil {
 			writeString(changesBuf, "diff: ")
 
-			updates := make(resource.PropertyMap)
+			// Properties whose detailed diffs require a replacement are listed as replacements.
+			adds, deletes, updates, replaces := make(resource.PropertyMap), make(resource.PropertyMap),
+				make(resource.PropertyMap), make(resource.PropertyMap)
+			if step.DetailedDiff != nil {
+				for _, k := range plugin.DetailedDiffReplaceKeys(step.DetailedDiff) {
+					replaces[k] = resource.PropertyValue{}
+				}
+			}
+			for k, v := range diff.Adds {
+				if _, isReplace := replaces[k]; !isReplace {
+					adds[k] = v
+				}
+			}
+			for k, v := range diff.Deletes {
+				if _, isReplace := replaces[k]; !isReplace {
+					deletes[k] = v
+				}
+			}
 			for k := range diff.Updates {
-				updates[k] = resource.PropertyValue{}
+				if _, isReplace := replaces[k]; !isReplace {
+					updates[k] = resource.PropertyValue{}
+				}
 			}
 
-			writePropertyKeys(changesBuf, diff.Adds, deploy.OpCreate)
-			writePropertyKeys(changesBuf, diff.Deletes, deploy.OpDelete)
+			writePropertyKeys(changesBuf, adds, deploy.OpCreate)
+			writePropertyKeys(changesBuf, deletes, deploy.OpDelete)
 			writePropertyKeys(changesBuf, updates, deploy.OpUpdate)
+			writePropertyKeys(changesBuf, replaces, deploy.OpReplace)
 		}
 	}
 
@@ -18,11 +18,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"os/user"
-	"path/filepath"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -44,18 +44,23 @@ import (
 	"github.com/pulumi/pulumi/pkg/workspace"
 )
 
-// localBackendURL is fake URL scheme we use to signal we want to use the local backend vs a cloud one.
-const localBackendURLPrefix = "file://"
-
 // Backend extends the base backend interface with specific information about local backends.
 type Backend interface {
 	backend.Backend
-	local() // at the moment, no local specific info, so just use a marker function.
+	local() // a marker function.
+
+	// ForceUnlock removes the lock on the given stack, regardless of which operation holds it, and returns
+	// information about the lock that was removed. This is used to recover from operations that crashed.
+	ForceUnlock(ctx context.Context, stackRef backend.StackReference) (*LockInfo, error)
 }
 
 type localBackend struct {
-	d   diag.Sink
-	url string
+	d      diag.Sink
+	url    string
+	bucket Bucket
+
+	crypterLock sync.Mutex                    // guards crypters.
+	crypters    map[tokens.QName]*lazyCrypter // the crypter for each stack, created on first use.
 }
 
 type localBackendReference struct {
@@ -70,17 +75,26 @@ func (r localBackendReference) Name() tokens.QName {
 	return r.name
 }
 
+// IsLocalBackendURL returns true if the given URL selects a backend that stores its state in a blob store (such as
+// the local filesystem or an S3 bucket) rather than in the Pulumi service.
 func IsLocalBackendURL(url string) bool {
-	return strings.HasPrefix(url, localBackendURLPrefix)
+	return isBucketURL(url)
 }
 
 func New(d diag.Sink, url string) (Backend, error) {
 	if !IsLocalBackendURL(url) {
-		return nil, errors.Errorf("local URL %s has an illegal prefix; expected %s", url, localBackendURLPrefix)
+		return nil, errors.Errorf("local URL %s has an illegal prefix; expected one of %s, %s, or %s",
+			url, fileBucketURLPrefix, s3BucketURLPrefix, memBucketURLPrefix)
+	}
+	bucket, err := openBucket(url)
+	if err != nil {
+		return nil, err
 	}
 	return &localBackend{
-		d:   d,
-		url: url,
+		d:        d,
+		url:      url,
+		bucket:   bucket,
+		crypters: make(map[tokens.QName]*lazyCrypter),
 	}, nil
 }
 
@@ -107,25 +121,6 @@ func (b *localBackend) URL() string {
 	return b.url
 }
 
-func (b *localBackend) Dir() string {
-	path := b.url[len(localBackendURLPrefix):]
-	if path == "~" {
-		user, err := user.Current()
-		contract.AssertNoErrorf(err, "could not determine current user")
-		path = user.HomeDir
-	} else if path == "." {
-		pwd, err := os.Getwd()
-		contract.AssertNoErrorf(err, "could not determine current working directory")
-		path = pwd
-	}
-	return path
-}
-
-func (b *localBackend) StateDir() string {
-	dir := b.Dir()
-	return filepath.Join(dir, workspace.BookkeepingDir)
-}
-
 func (b *localBackend) ParseStackReference(stackRefName string) (backend.StackReference, error) {
 	return localBackendReference{name: tokens.QName(stackRefName)}, nil
 }
@@ -140,11 +135,11 @@ func (b *localBackend) CreateStack(ctx context.Context, stackRef backend.StackRe
 		return nil, errors.New("invalid empty stack name")
 	}
 
-	if _, _, _, err := b.getStack(stackName); err == nil {
+	if _, err := b.getCheckpoint(stackName); err == nil {
 		return nil, &backend.StackAlreadyExistsError{StackName: string(stackName)}
 	}
 
-	tags, err := backend.GetStackTags()
+	tags, err := backend.GetEnvironmentTagsForCurrentStack()
 	if err != nil {
 		return nil, errors.Wrap(err, "getting stack tags")
 	}
@@ -156,8 +151,11 @@ func (b *localBackend) CreateStack(ctx context.Context, stackRef backend.StackRe
 	if err != nil {
 		return nil, err
 	}
+	if err = b.saveStackMetadata(stackName, stackMetadata{Tags: tags}); err != nil {
+		return nil, err
+	}
 
-	stack := newStack(stackRef, file, nil, nil, b)
+	stack := newStack(stackRef, file, nil, b)
 	fmt.Printf("Created stack '%s'\n", stack.Ref())
 
 	return stack, nil
@@ -165,14 +163,16 @@ func (b *localBackend) CreateStack(ctx context.Context, stackRef backend.StackRe
 
 func (b *localBackend) GetStack(ctx context.Context, stackRef backend.StackReference) (backend.Stack, error) {
 	stackName := stackRef.Name()
-	config, snapshot, path, err := b.getStack(stackName)
+	// Only read the checkpoint here; its snapshot is materialized if and when it is needed, so that listing stacks
+	// neither prompts for passphrases nor requires each stack's project to be present.
+	chk, err := b.getCheckpoint(stackName)
 	switch {
 	case os.IsNotExist(errors.Cause(err)):
 		return nil, nil
 	case err != nil:
-		return nil, err
+		return nil, errors.Wrap(err, "failed to load checkpoint")
 	default:
-		return newStack(stackRef, path, config, snapshot, b), nil
+		return newStack(stackRef, b.bucket.URL(b.stackPath(stackName)), chk, b), nil
 	}
 }
 
@@ -191,7 +191,11 @@ func (b *localBackend) ListStacks(
 		}
 		localStack, ok := stack.(*localStack)
 		contract.Assertf(ok, "localBackend GetStack returned non-localStack")
-		results = append(results, newLocalStackSummary(localStack))
+		meta, err := b.getStackMetadata(stackName)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, newLocalStackSummary(localStack, meta.Tags))
 	}
 
 	return results, nil
@@ -199,21 +203,80 @@ func (b *localBackend) ListStacks(
 
 func (b *localBackend) RemoveStack(ctx context.Context, stackRef backend.StackReference, force bool) (bool, error) {
 	stackName := stackRef.Name()
-	_, snapshot, _, err := b.getStack(stackName)
+	chk, err := b.getCheckpoint(stackName)
 	if err != nil {
 		return false, err
 	}
 
 	// Don't remove stacks that still have resources.
-	if !force && snapshot != nil && len(snapshot.Resources) > 0 {
+	if !force && chk.Latest != nil && len(chk.Latest.Resources) > 0 {
 		return true, errors.New("refusing to remove stack because it still contains resources")
 	}
 
 	return false, b.removeStack(stackName)
 }
 
+func (b *localBackend) RenameStack(ctx context.Context, stackRef backend.StackReference,
+	newName tokens.QName) (backend.StackReference, error) {
+
+	stackName := stackRef.Name()
+	if err := backend.ValidateStackProperties(string(newName), nil); err != nil {
+		return nil, err
+	}
+	if newName == stackName {
+		return nil, errors.Errorf("the stack is already named '%s'", newName)
+	}
+	if _, err := b.getCheckpoint(newName); err == nil {
+		return nil, &backend.StackAlreadyExistsError{StackName: string(newName)}
+	}
+
+	lock, err := b.lockStack(stackName, "rename")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(); unlockErr != nil {
+			logging.V(3).Infof("failed to unlock stack %s: %v", stackName, unlockErr)
+		}
+	}()
+
+	if err = b.renameStack(stackName, newName); err != nil {
+		return nil, err
+	}
+	return localBackendReference{name: newName}, nil
+}
+
+func (b *localBackend) GetStackTags(ctx context.Context,
+	stackRef backend.StackReference) (map[apitype.StackTagName]string, error) {
+
+	stackName := stackRef.Name()
+	if _, err := b.getCheckpoint(stackName); err != nil {
+		return nil, err
+	}
+	meta, err := b.getStackMetadata(stackName)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Tags, nil
+}
+
+func (b *localBackend) UpdateStackTags(ctx context.Context, stackRef backend.StackReference,
+	tags map[apitype.StackTagName]string) error {
+
+	stackName := stackRef.Name()
+	if _, err := b.getCheckpoint(stackName); err != nil {
+		return err
+	}
+	meta, err := b.getStackMetadata(stackName)
+	if err != nil {
+		return err
+	}
+	meta.Tags = tags
+	return b.saveStackMetadata(stackName, meta)
+}
+
 func (b *localBackend) GetStackCrypter(stackRef backend.StackReference) (config.Crypter, error) {
-	return symmetricCrypter(stackRef.Name())
+	return b.stackCrypter(stackRef.Name()), nil
 }
 
 func (b *localBackend) GetLatestConfiguration(ctx context.Context,
@@ -279,10 +342,33 @@ func (b *localBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 	stackRef := stack.Ref()
 	stackName := stackRef.Name()
 
-	// Print a banner so it's clear this is a local deployment.
+	// Lock the stack so that concurrent operations don't clobber each other's checkpoints.  Previews don't write
+	// any state, so they needn't take the lock.
+	var lock *stackLock
+	if !opts.DryRun {
+		var err error
+		lock, err = b.lockStack(stackName, string(kind))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if unlockErr := lock.Unlock(); unlockErr != nil {
+				logging.V(3).Infof("failed to unlock stack %s: %v", stackName, unlockErr)
+			}
+		}()
+
+		// Pick up any changes to the tags that are derived from the environment, as the Pulumi Service does.
+		if err = b.updateEnvironmentTags(stackName); err != nil {
+			return nil, errors.Wrap(err, "updating stack tags")
+		}
+	}
+
+	// Print a banner so it's clear this is a local deployment.  When emitting JSON, nothing else may be printed.
 	actionLabel := backend.ActionLabel(kind, opts.DryRun)
-	fmt.Printf(op.Opts.Display.Color.Colorize(
-		colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stackRef)
+	if !op.Opts.Display.JSONDisplay && !op.Opts.Display.SuppressDisplay {
+		fmt.Printf(op.Opts.Display.Color.Colorize(
+			colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stackRef)
+	}
 
 	// Start the update.
 	update, err := b.newUpdate(stackName, op.Proj, op.Root)
@@ -315,10 +401,22 @@ func (b *localBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 		close(eventsDone)
 	}()
 
-	// Create the management machinery.
-	persister := b.newSnapshotPersister(stackName)
+	// Create the management machinery.  If the stack's lock is lost while the update runs, the update is canceled,
+	// and the persister refuses to write any further checkpoints.
+	cancelCtx := scope.Context()
+	if lock != nil {
+		var stopCancel func()
+		cancelCtx, stopCancel = lock.cancelContext(cancelCtx)
+		defer stopCancel()
+	}
+	persister := b.newSnapshotPersister(stackName, lock)
 	manager := backend.NewSnapshotManager(persister, update.GetTarget().Snapshot)
-	engineCtx := &engine.Context{Cancel: scope.Context(), Events: engineEvents, SnapshotManager: manager}
+	engineCtx := &engine.Context{
+		Cancel:          cancelCtx,
+		Events:          engineEvents,
+		SnapshotManager: manager,
+		BackendClient:   backend.NewBackendClient(b),
+	}
 
 	// Perform the update
 	start := time.Now().Unix()
@@ -349,6 +447,12 @@ func (b *localBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 	// Make sure the goroutine writing to displayEvents and events has exited before proceeding.
 	<-eventsDone
 
+	// If the stack's lock was lost, the update was canceled, and another operation may now own the stack's state.
+	lockLost := lock != nil && lock.Lost()
+	if lockLost {
+		updateErr = errors.Errorf("the lock on stack '%s' was lost while the %s was in progress", stackName, kind)
+	}
+
 	// Save update results.
 	result := backend.SucceededResult
 	if updateErr != nil {
@@ -370,7 +474,7 @@ func (b *localBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 
 	var saveErr error
 	var backupErr error
-	if !opts.DryRun {
+	if !opts.DryRun && !lockLost {
 		saveErr = b.addToHistory(stackName, info)
 		backupErr = b.backupStack(stackName)
 	}
@@ -390,11 +494,11 @@ func (b *localBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 	}
 
 	// Make sure to print a link to the stack's checkpoint before exiting.
-	if opts.ShowLink {
+	if opts.ShowLink && !op.Opts.Display.JSONDisplay && !op.Opts.Display.SuppressDisplay {
 		fmt.Printf(
 			op.Opts.Display.Color.Colorize(
 				colors.SpecHeadline+"Permalink: "+
-					colors.Underline+colors.BrightBlue+"file://%s"+colors.Reset+"\n"), stack.(*localStack).Path())
+					colors.Underline+colors.BrightBlue+"%s"+colors.Reset+"\n"), stack.(*localStack).Path())
 	}
 
 	return changes, nil
@@ -444,7 +548,8 @@ func (b *localBackend) ExportDeployment(ctx context.Context,
 	stackRef backend.StackReference) (*apitype.UntypedDeployment, error) {
 
 	stackName := stackRef.Name()
-	_, snap, _, err := b.getStack(stackName)
+	// Secrets are exported with the ciphertext they were stored with, so they need not be decrypted.
+	_, snap, _, err := b.getStack(stackName, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -453,7 +558,12 @@ func (b *localBackend) ExportDeployment(ctx context.Context,
 		snap = deploy.NewSnapshot(deploy.Manifest{}, nil, nil)
 	}
 
-	data, err := json.Marshal(stack.SerializeDeployment(snap))
+	sdep, err := stack.SerializeDeployment(snap, b.stackCrypter(stackName))
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing deployment")
+	}
+
+	data, err := json.Marshal(sdep)
 	if err != nil {
 		return nil, err
 	}
@@ -468,20 +578,34 @@ func (b *localBackend) ImportDeployment(ctx context.Context, stackRef backend.St
 	deployment *apitype.UntypedDeployment) error {
 
 	stackName := stackRef.Name()
-	config, _, _, err := b.getStack(stackName)
+	lock, err := b.lockStack(stackName, "import")
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if unlockErr := lock.Unlock(); unlockErr != nil {
+			logging.V(3).Infof("failed to unlock stack %s: %v", stackName, unlockErr)
+		}
+	}()
 
-	snap, err := stack.DeserializeUntypedDeployment(deployment)
+	chk, err := b.getCheckpoint(stackName)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.saveStack(stackName, config, snap)
+	snap, err := stack.DeserializeUntypedDeployment(deployment, b.stackCrypter(stackName))
+	if err != nil {
+		return err
+	}
+
+	_, err = b.saveStack(stackName, chk.Config, snap)
 	return err
 }
 
+func (b *localBackend) ForceUnlock(ctx context.Context, stackRef backend.StackReference) (*LockInfo, error) {
+	return b.forceUnlockStack(stackRef.Name())
+}
+
 func (b *localBackend) Logout() error {
 	return workspace.DeleteAccessToken(b.url)
 }
@@ -494,33 +618,37 @@ func (b *localBackend) CurrentUser() (string, error) {
 	return user.Username, nil
 }
 
+// updateEnvironmentTags merges the tags derived from the environment into the given stack's tags.
+func (b *localBackend) updateEnvironmentTags(stackName tokens.QName) error {
+	meta, err := b.getStackMetadata(stackName)
+	if err != nil {
+		return err
+	}
+	if meta.Tags, err = backend.MergeEnvironmentTags(meta.Tags); err != nil {
+		return err
+	}
+	return b.saveStackMetadata(stackName, meta)
+}
+
 func (b *localBackend) getLocalStacks() ([]tokens.QName, error) {
 	var stacks []tokens.QName
 
 	// Read the stack directory.
-	path := b.stackPath("")
-
-	files, err := ioutil.ReadDir(path)
-	if err != nil && !os.IsNotExist(err) {
+	files, err := b.bucket.ListFiles(b.stackPath(""))
+	if err != nil {
 		return nil, errors.Errorf("could not read stacks: %v", err)
 	}
 
-	for _, file := range files {
-		// Ignore directories.
-		if file.IsDir() {
-			continue
-		}
-
+	for _, stackfn := range files {
 		// Skip files without valid extensions (e.g., *.bak files).
-		stackfn := file.Name()
-		ext := filepath.Ext(stackfn)
+		ext := path.Ext(stackfn)
 		if _, has := encoding.Marshalers[ext]; !has {
 			continue
 		}
 
 		// Read in this stack's information.
 		name := tokens.QName(stackfn[:len(stackfn)-len(ext)])
-		_, _, _, err := b.getStack(name)
+		_, err := b.getCheckpoint(name)
 		if err != nil {
 			logging.V(5).Infof("error reading stack: %v (%v) skipping", name, err)
 			continue // failure reading the stack information.
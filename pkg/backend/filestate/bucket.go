@@ -0,0 +1,440 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/util/contract"
+)
+
+const (
+	// fileBucketURLPrefix selects a bucket that stores blobs in a directory on the local filesystem.
+	fileBucketURLPrefix = "file://"
+	// s3BucketURLPrefix selects a bucket that stores blobs in an S3 (or S3-compatible) bucket.
+	s3BucketURLPrefix = "s3://"
+	// memBucketURLPrefix selects a bucket that stores blobs in memory. This is only useful for testing.
+	memBucketURLPrefix = "mem://"
+)
+
+// Bucket is a simple blob store in which the local backend keeps its state. Blobs are identified by slash-separated
+// keys, which are treated as paths relative to the root of the bucket.
+type Bucket interface {
+	// ReadFile returns the contents of the blob with the given key. If the blob does not exist, the returned error
+	// satisfies os.IsNotExist.
+	ReadFile(key string) ([]byte, error)
+	// WriteFile creates or overwrites the blob with the given key.
+	WriteFile(key string, data []byte) error
+	// CreateFile creates the blob with the given key, failing if it already exists. If the blob exists, the returned
+	// error satisfies os.IsExist. Implementations document whether the check and the creation are atomic.
+	CreateFile(key string, data []byte) error
+	// DeleteFile deletes the blob with the given key. It is not an error to delete a blob that does not exist.
+	DeleteFile(key string) error
+	// ListFiles returns the names, in sorted order, of the blobs that are immediately contained within the given
+	// directory. Blobs in nested directories are not returned. A directory that does not exist is simply empty.
+	ListFiles(dir string) ([]string, error)
+	// RemoveAll deletes every blob within the given directory, including those in nested directories.
+	RemoveAll(dir string) error
+	// URL returns a URL that identifies the blob with the given key, suitable for display.
+	URL(key string) string
+}
+
+// isBucketURL returns true if the given URL selects one of the supported bucket implementations.
+func isBucketURL(url string) bool {
+	return strings.HasPrefix(url, fileBucketURLPrefix) ||
+		strings.HasPrefix(url, s3BucketURLPrefix) ||
+		strings.HasPrefix(url, memBucketURLPrefix)
+}
+
+// openBucket opens the bucket selected by the given URL.
+func openBucket(url string) (Bucket, error) {
+	switch {
+	case strings.HasPrefix(url, fileBucketURLPrefix):
+		return newFileBucket(url[len(fileBucketURLPrefix):])
+	case strings.HasPrefix(url, s3BucketURLPrefix):
+		return newS3Bucket(url)
+	case strings.HasPrefix(url, memBucketURLPrefix):
+		return newMemBucket(url), nil
+	default:
+		return nil, errors.Errorf("unsupported state URL %s; expected one of %s, %s, or %s",
+			url, fileBucketURLPrefix, s3BucketURLPrefix, memBucketURLPrefix)
+	}
+}
+
+// fileBucket is a Bucket that stores blobs as files beneath a root directory on the local filesystem.
+type fileBucket struct {
+	root string
+}
+
+// newFileBucket creates a bucket rooted at the given directory. The special paths "~" and "." refer to the current
+// user's home directory and the current working directory, respectively.
+func newFileBucket(root string) (Bucket, error) {
+	if root == "~" {
+		user, err := user.Current()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine current user")
+		}
+		root = user.HomeDir
+	} else if root == "." {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine current working directory")
+		}
+		root = pwd
+	}
+	return &fileBucket{root: root}, nil
+}
+
+func (b *fileBucket) path(key string) string {
+	return filepath.Join(b.root, filepath.FromSlash(key))
+}
+
+func (b *fileBucket) ReadFile(key string) ([]byte, error) {
+	return ioutil.ReadFile(b.path(key))
+}
+
+func (b *fileBucket) WriteFile(key string, data []byte) error {
+	file := b.path(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0600)
+}
+
+// CreateFile creates the file exclusively (O_CREATE|O_EXCL), so concurrent creators are guaranteed that at most one
+// of them succeeds.
+func (b *fileBucket) CreateFile(key string, data []byte) error {
+	file := b.path(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Don't leave a partially written file behind; it would block every subsequent creation.
+		contract.IgnoreError(os.Remove(file))
+	}
+	return err
+}
+
+func (b *fileBucket) DeleteFile(key string) error {
+	if err := os.Remove(b.path(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (b *fileBucket) ListFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(b.path(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// ioutil.ReadDir returns its results sorted by name, so we need not sort them again.
+	var names []string
+	for _, info := range infos {
+		if !info.IsDir() {
+			names = append(names, info.Name())
+		}
+	}
+	return names, nil
+}
+
+func (b *fileBucket) RemoveAll(dir string) error {
+	return os.RemoveAll(b.path(dir))
+}
+
+func (b *fileBucket) URL(key string) string {
+	return fileBucketURLPrefix + b.path(key)
+}
+
+// memBuckets holds the contents of every in-memory bucket, keyed by URL, so that backends created for the same URL
+// share their state for the lifetime of the process.
+var memBuckets = struct {
+	sync.Mutex
+	m map[string]*memBucket
+}{m: make(map[string]*memBucket)}
+
+// memBucket is a Bucket that stores blobs in memory.
+type memBucket struct {
+	url   string
+	lock  sync.Mutex
+	blobs map[string][]byte
+}
+
+// newMemBucket returns the in-memory bucket for the given URL, creating it if necessary.
+func newMemBucket(url string) Bucket {
+	memBuckets.Lock()
+	defer memBuckets.Unlock()
+
+	b, ok := memBuckets.m[url]
+	if !ok {
+		b = &memBucket{url: url, blobs: make(map[string][]byte)}
+		memBuckets.m[url] = b
+	}
+	return b
+}
+
+func (b *memBucket) ReadFile(key string) ([]byte, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	data, ok := b.blobs[path.Clean(key)]
+	if !ok {
+		return nil, &os.PathError{Op: "read", Path: b.URL(key), Err: os.ErrNotExist}
+	}
+	return append([]byte(nil), data...), nil
+}
+
+func (b *memBucket) WriteFile(key string, data []byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.blobs[path.Clean(key)] = append([]byte(nil), data...)
+	return nil
+}
+
+// CreateFile checks for the blob and creates it under the bucket's lock, so it is atomic.
+func (b *memBucket) CreateFile(key string, data []byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.blobs[path.Clean(key)]; ok {
+		return &os.PathError{Op: "create", Path: b.URL(key), Err: os.ErrExist}
+	}
+	b.blobs[path.Clean(key)] = append([]byte(nil), data...)
+	return nil
+}
+
+func (b *memBucket) DeleteFile(key string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.blobs, path.Clean(key))
+	return nil
+}
+
+func (b *memBucket) ListFiles(dir string) ([]string, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	prefix := path.Clean(dir) + "/"
+	var names []string
+	for key := range b.blobs {
+		if name := strings.TrimPrefix(key, prefix); name != key && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (b *memBucket) RemoveAll(dir string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	prefix := path.Clean(dir) + "/"
+	for key := range b.blobs {
+		if strings.HasPrefix(key, prefix) {
+			delete(b.blobs, key)
+		}
+	}
+	return nil
+}
+
+func (b *memBucket) URL(key string) string {
+	return strings.TrimSuffix(b.url, "/") + "/" + path.Clean(key)
+}
+
+// s3Bucket is a Bucket that stores blobs as objects in an S3 bucket, optionally beneath a key prefix.
+type s3Bucket struct {
+	svc    *s3.S3
+	bucket string
+	prefix string
+}
+
+// newS3Bucket creates a bucket from a URL of the form s3://bucket[/prefix][?region=REGION][&endpoint=ENDPOINT]. The
+// endpoint parameter allows the use of S3-compatible services, such as MinIO; it implies path-style addressing.
+// Credentials are obtained in the usual way for the AWS SDK, e.g. from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+func newS3Bucket(rawurl string) (Bucket, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing state URL %s", rawurl)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("state URL %s is missing a bucket name", rawurl)
+	}
+
+	cfg := &aws.Config{}
+	query := u.Query()
+	if region := query.Get("region"); region != "" {
+		cfg.Region = aws.String(region)
+	}
+	if endpoint := query.Get("endpoint"); endpoint != "" {
+		cfg.Endpoint = aws.String(endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating AWS session")
+	}
+
+	return &s3Bucket{
+		svc:    s3.New(sess),
+		bucket: u.Host,
+		prefix: strings.Trim(u.Path, "/"),
+	}, nil
+}
+
+func (b *s3Bucket) key(key string) string {
+	return path.Join(b.prefix, key)
+}
+
+func (b *s3Bucket) ReadFile(key string) ([]byte, error) {
+	out, err := b.svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(key)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &os.PathError{Op: "read", Path: b.URL(key), Err: os.ErrNotExist}
+		}
+		return nil, err
+	}
+	defer contract.IgnoreClose(out.Body)
+
+	return ioutil.ReadAll(out.Body)
+}
+
+func (b *s3Bucket) WriteFile(key string, data []byte) error {
+	_, err := b.svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(key)),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// CreateFile checks for an existing object before writing the new one. S3 has no conditional put, so the check and
+// the write are not atomic: two processes that create the same key at the same moment may both succeed, and the last
+// write wins. Callers must not rely on CreateFile for mutual exclusion in an S3 bucket.
+func (b *s3Bucket) CreateFile(key string, data []byte) error {
+	_, err := b.svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(key)),
+	})
+	if err == nil {
+		return &os.PathError{Op: "create", Path: b.URL(key), Err: os.ErrExist}
+	}
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "NotFound" {
+		return err
+	}
+	return b.WriteFile(key, data)
+}
+
+func (b *s3Bucket) DeleteFile(key string) error {
+	_, err := b.svc.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(key)),
+	})
+	return err
+}
+
+// listKeys returns the full keys of all objects beneath the given directory. If recursive is false, only the
+// objects immediately contained within the directory are returned.
+func (b *s3Bucket) listKeys(dir string, recursive bool) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(b.key(dir) + "/"),
+	}
+	if !recursive {
+		input.Delimiter = aws.String("/")
+	}
+
+	var keys []string
+	err := b.svc.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.StringValue(obj.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (b *s3Bucket) ListFiles(dir string) ([]string, error) {
+	keys, err := b.listKeys(dir, false)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = path.Base(key)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (b *s3Bucket) RemoveAll(dir string) error {
+	keys, err := b.listKeys(dir, true)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if _, err = b.svc.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(b.bucket),
+			Key:    aws.String(key),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *s3Bucket) URL(key string) string {
+	return s3BucketURLPrefix + path.Join(b.bucket, b.key(key))
+}
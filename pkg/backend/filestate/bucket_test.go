@@ -0,0 +1,164 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+// testBucketURL is the environment variable that may be set to the URL of a bucket (such as an S3 bucket hosted by a
+// local MinIO server) against which the bucket tests should also be run.
+const testBucketURL = "PULUMI_TEST_BUCKET_URL"
+
+// testBuckets returns a set of empty buckets against which to run tests, keyed by URL.
+func testBuckets(t *testing.T) map[string]Bucket {
+	dir, err := ioutil.TempDir("", "filestate")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	urls := []string{
+		fileBucketURLPrefix + dir,
+		fmt.Sprintf("%s%s-%d", memBucketURLPrefix, t.Name(), time.Now().UnixNano()),
+	}
+	if url := os.Getenv(testBucketURL); url != "" {
+		urls = append(urls, url)
+	}
+
+	buckets := make(map[string]Bucket)
+	for _, url := range urls {
+		bucket, err := openBucket(url)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		buckets[url] = bucket
+	}
+	return buckets
+}
+
+func TestBucket(t *testing.T) {
+	for url, bucket := range testBuckets(t) {
+		t.Run(url, func(t *testing.T) {
+			// Missing blobs must be reported as such.
+			_, err := bucket.ReadFile("dir/a.json")
+			assert.True(t, os.IsNotExist(err))
+
+			// Listing a missing directory simply returns nothing.
+			names, err := bucket.ListFiles("dir")
+			assert.NoError(t, err)
+			assert.Empty(t, names)
+
+			// Write some blobs and read them back.
+			assert.NoError(t, bucket.WriteFile("dir/b.json", []byte("b")))
+			assert.NoError(t, bucket.WriteFile("dir/a.json", []byte("a")))
+			assert.NoError(t, bucket.WriteFile("dir/nested/c.json", []byte("c")))
+			assert.NoError(t, bucket.WriteFile("other/d.json", []byte("d")))
+
+			data, err := bucket.ReadFile("dir/a.json")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("a"), data)
+
+			// Listing must not include nested blobs, and must be sorted.
+			names, err = bucket.ListFiles("dir")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a.json", "b.json"}, names)
+
+			// Overwrite and delete.
+			assert.NoError(t, bucket.WriteFile("dir/a.json", []byte("aa")))
+			data, err = bucket.ReadFile("dir/a.json")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("aa"), data)
+
+			assert.NoError(t, bucket.DeleteFile("dir/b.json"))
+			assert.NoError(t, bucket.DeleteFile("dir/b.json"))
+			_, err = bucket.ReadFile("dir/b.json")
+			assert.True(t, os.IsNotExist(err))
+
+			// CreateFile refuses to overwrite an existing blob.
+			assert.NoError(t, bucket.CreateFile("dir/e.json", []byte("e")))
+			err = bucket.CreateFile("dir/e.json", []byte("ee"))
+			assert.True(t, os.IsExist(err))
+			data, err = bucket.ReadFile("dir/e.json")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("e"), data)
+
+			// RemoveAll removes nested blobs, but nothing outside of the directory.
+			assert.NoError(t, bucket.RemoveAll("dir"))
+			_, err = bucket.ReadFile("dir/nested/c.json")
+			assert.True(t, os.IsNotExist(err))
+			data, err = bucket.ReadFile("other/d.json")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("d"), data)
+		})
+	}
+}
+
+func TestBackendState(t *testing.T) {
+	for url := range testBuckets(t) {
+		t.Run(url, func(t *testing.T) {
+			be, err := New(nil, url)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			b := be.(*localBackend)
+
+			// Save a stack and make sure it is listed.
+			name := tokens.QName("dev")
+			_, err = b.saveStack(name, nil, nil)
+			assert.NoError(t, err)
+			stacks, err := b.getLocalStacks()
+			assert.NoError(t, err)
+			assert.Equal(t, []tokens.QName{name}, stacks)
+
+			// Saving again must leave a backup behind that is not listed as a stack.
+			_, err = b.saveStack(name, nil, nil)
+			assert.NoError(t, err)
+			_, err = b.bucket.ReadFile(b.stackPath(name) + ".bak")
+			assert.NoError(t, err)
+			stacks, err = b.getLocalStacks()
+			assert.NoError(t, err)
+			assert.Equal(t, []tokens.QName{name}, stacks)
+
+			// History is returned newest first.
+			for _, message := range []string{"first", "second"} {
+				assert.NoError(t, b.addToHistory(name, backend.UpdateInfo{Message: message}))
+			}
+			history, err := b.getHistory(name)
+			assert.NoError(t, err)
+			if assert.Len(t, history, 2) {
+				assert.Equal(t, "second", history[0].Message)
+				assert.Equal(t, "first", history[1].Message)
+			}
+
+			// Removing the stack removes its history too.
+			assert.NoError(t, b.removeStack(name))
+			stacks, err = b.getLocalStacks()
+			assert.NoError(t, err)
+			assert.Empty(t, stacks)
+			history, err = b.getHistory(name)
+			assert.NoError(t, err)
+			assert.Empty(t, history)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -48,6 +49,70 @@ func defaultCrypter(stackName tokens.QName, cfg config.Map) (config.Crypter, err
 	return symmetricCrypter(stackName)
 }
 
+// lazyCrypter is a crypter that defers creating the stack's symmetric crypter, and hence prompting for its
+// passphrase, until a value actually needs to be encrypted or decrypted. This lets checkpoints that do not contain
+// any secret values be read and written without a passphrase.
+type lazyCrypter struct {
+	stackName tokens.QName
+	lock      sync.Mutex
+	crypter   config.Crypter
+}
+
+func newLazyCrypter(stackName tokens.QName) *lazyCrypter {
+	return &lazyCrypter{stackName: stackName}
+}
+
+// stackCrypter returns the crypter for the given stack. A single crypter is shared by every operation on a stack so
+// that its passphrase is read and its key derived at most once, rather than each time a checkpoint is written.
+func (b *localBackend) stackCrypter(stackName tokens.QName) *lazyCrypter {
+	b.crypterLock.Lock()
+	defer b.crypterLock.Unlock()
+
+	crypter, ok := b.crypters[stackName]
+	if !ok {
+		crypter = newLazyCrypter(stackName)
+		b.crypters[stackName] = crypter
+	}
+	return crypter
+}
+
+// forgetStackCrypter discards the crypter for the given stack, e.g. because the stack has been removed.
+func (b *localBackend) forgetStackCrypter(stackName tokens.QName) {
+	b.crypterLock.Lock()
+	defer b.crypterLock.Unlock()
+	delete(b.crypters, stackName)
+}
+
+func (c *lazyCrypter) getCrypter() (config.Crypter, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.crypter == nil {
+		crypter, err := symmetricCrypter(c.stackName)
+		if err != nil {
+			return nil, err
+		}
+		c.crypter = crypter
+	}
+	return c.crypter, nil
+}
+
+func (c *lazyCrypter) EncryptValue(plaintext string) (string, error) {
+	crypter, err := c.getCrypter()
+	if err != nil {
+		return "", err
+	}
+	return crypter.EncryptValue(plaintext)
+}
+
+func (c *lazyCrypter) DecryptValue(ciphertext string) (string, error) {
+	crypter, err := c.getCrypter()
+	if err != nil {
+		return "", err
+	}
+	return crypter.DecryptValue(ciphertext)
+}
+
 // symmetricCrypter gets the right value encrypter/decrypter for this project.
 func symmetricCrypter(stackName tokens.QName) (config.Crypter, error) {
 	contract.Assertf(stackName != "", "stackName", "!= \"\"")
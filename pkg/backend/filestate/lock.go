@@ -0,0 +1,316 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cancel"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+// lockLeaseDuration is the length of time for which a lock is held without being renewed. The holder of a lock
+// renews it periodically for as long as its operation runs, so a lock whose lease has expired was left behind by an
+// operation that crashed or was killed, and is considered stale.
+var lockLeaseDuration = 5 * time.Minute
+
+// LockInfo describes the holder of a stack's lock.
+type LockInfo struct {
+	ID        string    `json:"id"`        // a unique ID for this acquisition of the lock.
+	User      string    `json:"user"`      // the name of the user that holds the lock.
+	Host      string    `json:"host"`      // the name of the machine on which the lock is held.
+	PID       int       `json:"pid"`       // the ID of the process that holds the lock.
+	Operation string    `json:"operation"` // the operation being performed under the lock (e.g., "update").
+	StartTime time.Time `json:"startTime"` // the time at which the lock was acquired.
+	Expires   time.Time `json:"expires"`   // the time at which the lock's lease expires unless it is renewed.
+}
+
+// Stale returns true if the lock's lease has expired.
+func (l *LockInfo) Stale() bool {
+	return time.Now().After(l.Expires)
+}
+
+// StackLockedError is returned when an operation cannot proceed because another operation holds the stack's lock.
+type StackLockedError struct {
+	StackName tokens.QName
+	Lock      LockInfo
+}
+
+func (e StackLockedError) Error() string {
+	return fmt.Sprintf("the stack '%s' is locked by %s@%s (pid %d), which started a %s at %s; "+
+		"if you are sure that no other operation is in progress, run `pulumi cancel --force-unlock` to remove the lock",
+		e.StackName, e.Lock.User, e.Lock.Host, e.Lock.PID, e.Lock.Operation, e.Lock.StartTime.Format(time.RFC1123))
+}
+
+// stackLock is a lock held by this process on a stack. The lock's lease is renewed in the background until the lock
+// is released. If the lock is found to have been removed or taken over by another process, it is lost: renewal stops,
+// and the operation performed under it must be canceled and must not write the stack's checkpoint any further.
+type stackLock struct {
+	b       *localBackend
+	name    tokens.QName
+	info    LockInfo
+	done    chan bool
+	stopped chan bool
+	lost    chan bool
+}
+
+func (b *localBackend) lockPath(stack tokens.QName) string {
+	contract.Require(stack != "", "stack")
+	return path.Join(workspace.BookkeepingDir, workspace.LockDir, string(stack)+".json")
+}
+
+// getStackLock returns information about the current holder of the given stack's lock, or nil if it is not locked.
+func (b *localBackend) getStackLock(name tokens.QName) (*LockInfo, error) {
+	byts, err := b.bucket.ReadFile(b.lockPath(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var info LockInfo
+	if err = json.Unmarshal(byts, &info); err != nil {
+		return nil, errors.Wrapf(err, "reading lock for stack '%s'", name)
+	}
+	return &info, nil
+}
+
+func (b *localBackend) writeStackLock(name tokens.QName, info LockInfo) error {
+	byts, err := json.MarshalIndent(&info, "", "    ")
+	if err != nil {
+		return err
+	}
+	return b.bucket.WriteFile(b.lockPath(name), byts)
+}
+
+// createStackLock creates the given stack's lock, failing with an error that satisfies os.IsExist if it is already
+// locked.
+func (b *localBackend) createStackLock(name tokens.QName, info LockInfo) error {
+	byts, err := json.MarshalIndent(&info, "", "    ")
+	if err != nil {
+		return err
+	}
+	return b.bucket.CreateFile(b.lockPath(name), byts)
+}
+
+// removeStackLock removes the given stack's lock if it is still the lock with the given ID. It returns false if the
+// lock was already removed or has been replaced.
+func (b *localBackend) removeStackLock(name tokens.QName, id string) (bool, error) {
+	current, err := b.getStackLock(name)
+	if err != nil {
+		return false, err
+	}
+	if current == nil || current.ID != id {
+		return false, nil
+	}
+	return true, b.bucket.DeleteFile(b.lockPath(name))
+}
+
+// lockStack acquires the lock for the given stack on behalf of the given operation. If another operation holds the
+// lock, a StackLockedError is returned. A stale lock is taken over, with a warning.
+//
+// The lock is created with Bucket.CreateFile, so acquisition is exclusive wherever the bucket's CreateFile is atomic,
+// as it is for local directories. S3 offers no conditional put, so two operations that start at the same moment on a
+// stack kept in S3 may both acquire its lock; in that case, each one's lease renewal finds the other's lock, and the
+// operation that lost is canceled.
+func (b *localBackend) lockStack(name tokens.QName, operation string) (*stackLock, error) {
+	id, err := resource.NewUniqueHex("", 16, -1)
+	if err != nil {
+		return nil, err
+	}
+	username := "unknown"
+	if u, userErr := user.Current(); userErr == nil {
+		username = u.Username
+	}
+	host, err := os.Hostname()
+	contract.IgnoreError(err)
+
+	now := time.Now()
+	info := LockInfo{
+		ID:        id,
+		User:      username,
+		Host:      host,
+		PID:       os.Getpid(),
+		Operation: operation,
+		StartTime: now,
+		Expires:   now.Add(lockLeaseDuration),
+	}
+
+	// Try to create the lock. If it already exists, we may take it over only if it is stale; in that case, it is
+	// removed (unless it has changed in the meantime) and we try to create ours once more. If another operation
+	// takes over the same stale lock concurrently, at most one of the two creations succeeds.
+	err = b.createStackLock(name, info)
+	if os.IsExist(err) {
+		current, readErr := b.getStackLock(name)
+		if readErr != nil {
+			return nil, errors.Wrapf(readErr, "locking stack '%s'", name)
+		}
+		if current != nil {
+			if !current.Stale() {
+				return nil, StackLockedError{StackName: name, Lock: *current}
+			}
+			b.d.Warningf(diag.Message("", "taking over a stale lock on stack '%s' held by %s@%s (pid %d) since %s"),
+				name, current.User, current.Host, current.PID, current.StartTime.Format(time.RFC1123))
+			if _, err = b.removeStackLock(name, current.ID); err != nil {
+				return nil, errors.Wrapf(err, "locking stack '%s'", name)
+			}
+		}
+
+		err = b.createStackLock(name, info)
+		if os.IsExist(err) {
+			current, readErr = b.getStackLock(name)
+			if readErr != nil {
+				return nil, errors.Wrapf(readErr, "locking stack '%s'", name)
+			}
+			if current == nil {
+				return nil, errors.Errorf("locking stack '%s': the lock is being acquired by another process", name)
+			}
+			return nil, StackLockedError{StackName: name, Lock: *current}
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "locking stack '%s'", name)
+	}
+
+	logging.V(7).Infof("Locked stack %s for %s (lock=%s)", name, operation, id)
+	l := &stackLock{b: b, name: name, info: info,
+		done: make(chan bool), stopped: make(chan bool), lost: make(chan bool)}
+	go l.renew()
+	return l, nil
+}
+
+// renew periodically extends the lock's lease until the lock is released, or until the lock is found to have been
+// removed or taken over by another process.
+func (l *stackLock) renew() {
+	defer close(l.stopped)
+
+	ticker := time.NewTicker(lockLeaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			if !l.extend() {
+				close(l.lost)
+				return
+			}
+		}
+	}
+}
+
+// extend extends the lock's lease, returning false if the lock is no longer held by this process and so must not be
+// renewed any further. The lock is re-read first, so that a lock removed by a force-unlock, or since acquired by
+// another operation, is never overwritten.
+func (l *stackLock) extend() bool {
+	current, err := l.b.getStackLock(l.name)
+	if err != nil {
+		logging.V(3).Infof("failed to read lock on stack %s while renewing it: %v", l.name, err)
+		return true
+	}
+	if current == nil || current.ID != l.info.ID {
+		l.b.d.Errorf(diag.Message("", "the lock on stack '%s' was removed or taken over by another process; "+
+			"canceling the operation"), l.name)
+		return false
+	}
+
+	l.info.Expires = time.Now().Add(lockLeaseDuration)
+	if err := l.b.writeStackLock(l.name, l.info); err != nil {
+		logging.V(3).Infof("failed to renew lock on stack %s: %v", l.name, err)
+	}
+	return true
+}
+
+// Lost returns true if the lock has been removed or taken over by another process while it was held.
+func (l *stackLock) Lost() bool {
+	select {
+	case <-l.lost:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelContext returns a cancellation context that delivers the cancellation and termination requests of the given
+// context, and that is also canceled if the lock is lost. The returned function must be called once the context is no
+// longer needed.
+func (l *stackLock) cancelContext(parent *cancel.Context) (*cancel.Context, func()) {
+	ctx, source := cancel.NewContext(context.Background())
+	stop := make(chan bool)
+	go func() {
+		canceled, lost := parent.Canceled(), l.lost
+		for {
+			select {
+			case <-canceled:
+				source.Cancel()
+				canceled = nil
+			case <-lost:
+				source.Cancel()
+				lost = nil
+			case <-parent.Terminated():
+				source.Terminate()
+				return
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return ctx, func() { close(stop) }
+}
+
+// Unlock releases the lock. If the lock has since been taken over or removed by another process, it is left alone.
+func (l *stackLock) Unlock() error {
+	close(l.done)
+	<-l.stopped
+
+	removed, err := l.b.removeStackLock(l.name, l.info.ID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		logging.V(3).Infof("lock on stack %s was removed or taken over while it was held", l.name)
+	}
+	return nil
+}
+
+// forceUnlockStack removes the lock on the given stack regardless of which operation holds it, returning information
+// about the removed lock.
+func (b *localBackend) forceUnlockStack(name tokens.QName) (*LockInfo, error) {
+	current, err := b.getStackLock(name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, errors.Errorf("the stack '%s' is not locked", name)
+	}
+	if err = b.bucket.DeleteFile(b.lockPath(name)); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cancel"
+)
+
+func newTestBackend(t *testing.T) *localBackend {
+	sink := diag.DefaultSink(ioutil.Discard, ioutil.Discard, diag.FormatOptions{Color: colors.Never})
+	be, err := New(sink, fmt.Sprintf("%s%s-%d", memBucketURLPrefix, t.Name(), time.Now().UnixNano()))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return be.(*localBackend)
+}
+
+func TestStackLock(t *testing.T) {
+	b := newTestBackend(t)
+	name := tokens.QName("dev")
+
+	lock, err := b.lockStack(name, "update")
+	assert.NoError(t, err)
+
+	// A second operation must fail with an error that names the current holder.
+	_, err = b.lockStack(name, "destroy")
+	if assert.Error(t, err) {
+		locked, ok := err.(StackLockedError)
+		if assert.True(t, ok) {
+			assert.Equal(t, name, locked.StackName)
+			assert.Equal(t, "update", locked.Lock.Operation)
+			assert.Equal(t, os.Getpid(), locked.Lock.PID)
+		}
+		assert.Contains(t, err.Error(), "--force-unlock")
+	}
+
+	// Once the lock is released, it may be acquired again.
+	assert.NoError(t, lock.Unlock())
+	info, err := b.getStackLock(name)
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	lock, err = b.lockStack(name, "destroy")
+	assert.NoError(t, err)
+	assert.NoError(t, lock.Unlock())
+}
+
+func TestStaleStackLock(t *testing.T) {
+	b := newTestBackend(t)
+	name := tokens.QName("dev")
+
+	// A lock whose lease has expired is taken over.
+	stale := LockInfo{
+		ID:        "stale",
+		Operation: "update",
+		StartTime: time.Now().Add(-time.Hour),
+		Expires:   time.Now().Add(-time.Minute),
+	}
+	assert.True(t, stale.Stale())
+	assert.NoError(t, b.writeStackLock(name, stale))
+
+	lock, err := b.lockStack(name, "refresh")
+	assert.NoError(t, err)
+	info, err := b.getStackLock(name)
+	assert.NoError(t, err)
+	if assert.NotNil(t, info) {
+		assert.Equal(t, "refresh", info.Operation)
+		assert.False(t, info.Stale())
+	}
+
+	// If the lock is forcibly removed while it is held, releasing it must not remove a newer lock.
+	removed, err := b.ForceUnlock(context.Background(), localBackendReference{name: name})
+	assert.NoError(t, err)
+	assert.Equal(t, "refresh", removed.Operation)
+
+	newer, err := b.lockStack(name, "update")
+	assert.NoError(t, err)
+	assert.NoError(t, lock.Unlock())
+	info, err = b.getStackLock(name)
+	assert.NoError(t, err)
+	if assert.NotNil(t, info) {
+		assert.Equal(t, "update", info.Operation)
+	}
+	assert.NoError(t, newer.Unlock())
+
+	// Force-unlocking a stack that isn't locked is an error.
+	_, err = b.ForceUnlock(context.Background(), localBackendReference{name: name})
+	assert.Error(t, err)
+}
+
+func TestStackLockRenewal(t *testing.T) {
+	b := newTestBackend(t)
+	name := tokens.QName("dev")
+
+	lock, err := b.lockStack(name, "update")
+	assert.NoError(t, err)
+
+	// While the lock is held, renewing it extends its lease.
+	before, err := b.getStackLock(name)
+	assert.NoError(t, err)
+	assert.True(t, lock.extend())
+	after, err := b.getStackLock(name)
+	assert.NoError(t, err)
+	if assert.NotNil(t, before) && assert.NotNil(t, after) {
+		assert.Equal(t, before.ID, after.ID)
+		assert.False(t, after.Expires.Before(before.Expires))
+	}
+
+	// Once the lock has been forcibly removed and acquired by another operation, renewing it must stop without
+	// overwriting the new holder's lock.
+	_, err = b.forceUnlockStack(name)
+	assert.NoError(t, err)
+	newer, err := b.lockStack(name, "destroy")
+	assert.NoError(t, err)
+	assert.False(t, lock.extend())
+	info, err := b.getStackLock(name)
+	assert.NoError(t, err)
+	if assert.NotNil(t, info) {
+		assert.Equal(t, newer.info.ID, info.ID)
+		assert.Equal(t, "destroy", info.Operation)
+		assert.Equal(t, newer.info.Expires.Unix(), info.Expires.Unix())
+	}
+
+	assert.NoError(t, lock.Unlock())
+	assert.NoError(t, newer.Unlock())
+
+	// A lock that was removed outright is not recreated by a renewal either.
+	lock, err = b.lockStack(name, "update")
+	assert.NoError(t, err)
+	_, err = b.forceUnlockStack(name)
+	assert.NoError(t, err)
+	assert.False(t, lock.extend())
+	info, err = b.getStackLock(name)
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+	assert.NoError(t, lock.Unlock())
+}
+
+func TestLostStackLock(t *testing.T) {
+	b := newTestBackend(t)
+	name := tokens.QName("dev")
+
+	lock, err := b.lockStack(name, "update")
+	assert.NoError(t, err)
+	assert.False(t, lock.Lost())
+
+	parent, _ := cancel.NewContext(context.Background())
+	ctx, stop := lock.cancelContext(parent)
+	defer stop()
+	persister := b.newSnapshotPersister(name, lock)
+
+	// Once the lock is lost, the operation is canceled and no further checkpoints are written.
+	close(lock.lost)
+	assert.True(t, lock.Lost())
+	select {
+	case <-ctx.Canceled():
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the operation to be canceled when its lock was lost")
+	}
+	assert.Error(t, persister.Save(nil))
+	assert.NoError(t, lock.Unlock())
+}
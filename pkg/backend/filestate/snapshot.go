@@ -17,6 +17,9 @@ package filestate
 import (
 	"os"
 
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/tokens"
 )
@@ -26,6 +29,7 @@ import (
 type localSnapshotPersister struct {
 	name    tokens.QName
 	backend *localBackend
+	lock    *stackLock // the lock held on the stack, if any.
 }
 
 func (sm *localSnapshotPersister) Invalidate() error {
@@ -33,16 +37,25 @@ func (sm *localSnapshotPersister) Invalidate() error {
 }
 
 func (sm *localSnapshotPersister) Save(snapshot *deploy.Snapshot) error {
-	config, _, _, err := sm.backend.getStack(sm.name)
-	if err != nil && !os.IsNotExist(err) {
+	// Once the stack's lock has been lost, another operation may be writing the checkpoint; don't clobber it.
+	if sm.lock != nil && sm.lock.Lost() {
+		return errors.Errorf("the lock on stack '%s' was lost; not writing its checkpoint", sm.name)
+	}
+
+	var cfg config.Map
+	chk, err := sm.backend.getCheckpoint(sm.name)
+	switch {
+	case err == nil:
+		cfg = chk.Config
+	case !os.IsNotExist(err):
 		return err
 	}
 
-	_, err = sm.backend.saveStack(sm.name, config, snapshot)
+	_, err = sm.backend.saveStack(sm.name, cfg, snapshot)
 	return err
 
 }
 
-func (b *localBackend) newSnapshotPersister(stackName tokens.QName) *localSnapshotPersister {
-	return &localSnapshotPersister{name: stackName, backend: b}
+func (b *localBackend) newSnapshotPersister(stackName tokens.QName, lock *stackLock) *localSnapshotPersister {
+	return &localSnapshotPersister{name: stackName, backend: b, lock: lock}
 }
@@ -29,34 +29,56 @@ import (
 // Stack is a local stack.  This simply adds some local-specific properties atop the standard backend stack interface.
 type Stack interface {
 	backend.Stack
-	Path() string // a path to the stack's checkpoint file on disk.
+	Path() string // a URL that identifies the stack's checkpoint file.
 }
 
 // localStack is a local stack descriptor.
 type localStack struct {
-	ref      backend.StackReference // the stack's reference (qualified name).
-	path     string                 // a path to the stack's checkpoint file on disk.
-	config   config.Map             // the stack's config bag.
-	snapshot *deploy.Snapshot       // a snapshot representing the latest deployment state.
-	b        *localBackend          // a pointer to the backend this stack belongs to.
+	ref        backend.StackReference // the stack's reference (qualified name).
+	path       string                 // a URL that identifies the stack's checkpoint file.
+	checkpoint *apitype.CheckpointV2  // the stack's checkpoint, or nil if it has none.
+	snapshot   **deploy.Snapshot      // a snapshot representing the latest deployment state (allocated on first use)
+	b          *localBackend          // a pointer to the backend this stack belongs to.
 }
 
-func newStack(ref backend.StackReference, path string, config config.Map,
-	snapshot *deploy.Snapshot, b *localBackend) Stack {
+func newStack(ref backend.StackReference, path string, checkpoint *apitype.CheckpointV2, b *localBackend) Stack {
 	return &localStack{
-		ref:      ref,
-		path:     path,
-		config:   config,
-		snapshot: snapshot,
-		b:        b,
+		ref:        ref,
+		path:       path,
+		checkpoint: checkpoint,
+		b:          b,
 	}
 }
 
-func (s *localStack) Ref() backend.StackReference                            { return s.ref }
-func (s *localStack) Config() config.Map                                     { return s.config }
-func (s *localStack) Snapshot(ctx context.Context) (*deploy.Snapshot, error) { return s.snapshot, nil }
-func (s *localStack) Backend() backend.Backend                               { return s.b }
-func (s *localStack) Path() string                                           { return s.path }
+func (s *localStack) Ref() backend.StackReference { return s.ref }
+func (s *localStack) Backend() backend.Backend    { return s.b }
+func (s *localStack) Path() string                { return s.path }
+
+func (s *localStack) Config() config.Map {
+	if s.checkpoint == nil {
+		return nil
+	}
+	return s.checkpoint.Config
+}
+
+// Snapshot returns the stack's latest deployment state.  Its secret values are left encrypted; they are decrypted with
+// the stack's crypter only when their plaintext is needed, e.g. by stack.DecryptSnapshot.
+func (s *localStack) Snapshot(ctx context.Context) (*deploy.Snapshot, error) {
+	if s.snapshot != nil {
+		return *s.snapshot, nil
+	}
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+
+	snap, err := checkpointSnapshot(s.path, s.checkpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.snapshot = &snap
+	return *s.snapshot, nil
+}
 
 func (s *localStack) Remove(ctx context.Context, force bool) (bool, error) {
 	return backend.RemoveStack(ctx, s, force)
@@ -90,12 +112,18 @@ func (s *localStack) ImportDeployment(ctx context.Context, deployment *apitype.U
 	return backend.ImportStackDeployment(ctx, s, deployment)
 }
 
+// localStackSummary is a summary of a local stack.  Its details are read straight from the stack's checkpoint, so that
+// summarizing a stack never requires its secrets to be decrypted.
 type localStackSummary struct {
-	s *localStack
+	s    *localStack
+	tags map[apitype.StackTagName]string
 }
 
-func newLocalStackSummary(s *localStack) localStackSummary {
-	return localStackSummary{s}
+func newLocalStackSummary(s *localStack, tags map[apitype.StackTagName]string) localStackSummary {
+	if tags == nil {
+		tags = make(map[apitype.StackTagName]string)
+	}
+	return localStackSummary{s: s, tags: tags}
 }
 
 func (lss localStackSummary) Name() backend.StackReference {
@@ -103,9 +131,9 @@ func (lss localStackSummary) Name() backend.StackReference {
 }
 
 func (lss localStackSummary) LastUpdate() *time.Time {
-	snap := lss.s.snapshot
-	if snap != nil {
-		if t := snap.Manifest.Time; !t.IsZero() {
+	chk := lss.s.checkpoint
+	if chk != nil && chk.Latest != nil {
+		if t := chk.Latest.Manifest.Time; !t.IsZero() {
 			return &t
 		}
 	}
@@ -113,10 +141,14 @@ func (lss localStackSummary) LastUpdate() *time.Time {
 }
 
 func (lss localStackSummary) ResourceCount() *int {
-	snap := lss.s.snapshot
-	if snap != nil {
-		count := len(snap.Resources)
+	chk := lss.s.checkpoint
+	if chk != nil && chk.Latest != nil {
+		count := len(chk.Latest.Resources)
 		return &count
 	}
 	return nil
 }
+
+func (lss localStackSummary) Tags() map[apitype.StackTagName]string {
+	return lss.tags
+}
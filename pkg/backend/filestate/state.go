@@ -17,10 +17,8 @@ package filestate
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -29,13 +27,14 @@ import (
 	"github.com/pulumi/pulumi/pkg/apitype"
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/encoding"
+	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/resource/stack"
 	"github.com/pulumi/pulumi/pkg/tokens"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
 	"github.com/pulumi/pulumi/pkg/util/contract"
-	"github.com/pulumi/pulumi/pkg/util/fsutil"
 	"github.com/pulumi/pulumi/pkg/util/logging"
 	"github.com/pulumi/pulumi/pkg/workspace"
 )
@@ -94,7 +93,8 @@ func (b *localBackend) getTarget(stackName tokens.QName) (*deploy.Target, error)
 	if err != nil {
 		return nil, err
 	}
-	_, snapshot, _, err := b.getStack(stackName)
+	// Operations need the plaintext of the snapshot's secrets, e.g. to diff resources' inputs.
+	_, snapshot, _, err := b.getStack(stackName, b.stackCrypter(stackName))
 	if err != nil {
 		return nil, err
 	}
@@ -106,39 +106,51 @@ func (b *localBackend) getTarget(stackName tokens.QName) (*deploy.Target, error)
 	}, nil
 }
 
-func (b *localBackend) getStack(name tokens.QName) (config.Map, *deploy.Snapshot, string, error) {
+// getStack loads the given stack's config and snapshot.  Secret values in the snapshot are decrypted using dec, or are
+// left encrypted if it is nil, so that callers that do not need their plaintext never prompt for a passphrase.
+func (b *localBackend) getStack(name tokens.QName,
+	dec config.Decrypter) (config.Map, *deploy.Snapshot, string, error) {
 	if name == "" {
 		return nil, nil, "", errors.New("invalid empty stack name")
 	}
 
-	file := b.stackPath(name)
+	file := b.bucket.URL(b.stackPath(name))
 
 	chk, err := b.getCheckpoint(name)
 	if err != nil {
 		return nil, nil, file, errors.Wrap(err, "failed to load checkpoint")
 	}
 
+	snapshot, err := checkpointSnapshot(file, chk, dec)
+	if err != nil {
+		return nil, nil, file, err
+	}
+	return chk.Config, snapshot, file, nil
+}
+
+// checkpointSnapshot materializes the snapshot in the given checkpoint, which was loaded from file, decrypting its
+// secret values using dec unless it is nil.
+func checkpointSnapshot(file string, chk *apitype.CheckpointV2, dec config.Decrypter) (*deploy.Snapshot, error) {
 	// Materialize an actual snapshot object.
-	snapshot, err := stack.DeserializeCheckpoint(chk)
+	snapshot, err := stack.DeserializeCheckpoint(chk, dec)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, err
 	}
 
 	// Ensure the snapshot passes verification before returning it, to catch bugs early.
 	if !DisableIntegrityChecking {
 		if verifyerr := snapshot.VerifyIntegrity(); verifyerr != nil {
-			return nil, nil, file,
-				errors.Wrapf(verifyerr, "%s: snapshot integrity failure; refusing to use it", file)
+			return nil, errors.Wrapf(verifyerr, "%s: snapshot integrity failure; refusing to use it", file)
 		}
 	}
 
-	return chk.Config, snapshot, file, nil
+	return snapshot, nil
 }
 
 // GetCheckpoint loads a checkpoint file for the given stack in this project, from the current project workspace.
 func (b *localBackend) getCheckpoint(stackName tokens.QName) (*apitype.CheckpointV2, error) {
 	chkpath := b.stackPath(stackName)
-	bytes, err := ioutil.ReadFile(chkpath)
+	bytes, err := b.bucket.ReadFile(chkpath)
 	if err != nil {
 		return nil, err
 	}
@@ -147,32 +159,30 @@ func (b *localBackend) getCheckpoint(stackName tokens.QName) (*apitype.Checkpoin
 }
 
 func (b *localBackend) saveStack(name tokens.QName,
-	config map[config.Key]config.Value, snap *deploy.Snapshot) (string, error) {
+	cfg map[config.Key]config.Value, snap *deploy.Snapshot) (string, error) {
 	// Make a serializable stack and then use the encoder to encode it.
 	file := b.stackPath(name)
 	m, ext := encoding.Detect(file)
 	if m == nil {
 		return "", errors.Errorf("resource serialization failed; illegal markup extension: '%v'", ext)
 	}
-	if filepath.Ext(file) == "" {
+	if path.Ext(file) == "" {
 		file = file + ext
 	}
-	chk := stack.SerializeCheckpoint(name, config, snap)
+	chk, err := stack.SerializeCheckpoint(name, cfg, snap, b.stackCrypter(name))
+	if err != nil {
+		return "", errors.Wrap(err, "serializing checkpoint")
+	}
 	byts, err := m.Marshal(chk)
 	if err != nil {
 		return "", errors.Wrap(err, "An IO error occurred during the current operation")
 	}
 
 	// Back up the existing file if it already exists.
-	bck := backupTarget(file)
-
-	// Ensure the directory exists.
-	if err = os.MkdirAll(filepath.Dir(file), 0700); err != nil {
-		return "", errors.Wrap(err, "An IO error occurred during the current operation")
-	}
+	bck := backupTarget(b.bucket, file)
 
 	// And now write out the new snapshot file, overwriting that location.
-	if err = ioutil.WriteFile(file, byts, 0600); err != nil {
+	if err = b.bucket.WriteFile(file, byts); err != nil {
 		return "", errors.Wrap(err, "An IO error occurred during the current operation")
 	}
 
@@ -180,7 +190,7 @@ func (b *localBackend) saveStack(name tokens.QName,
 
 	// And if we are retaining historical checkpoint information, write it out again
 	if cmdutil.IsTruthy(os.Getenv("PULUMI_RETAIN_CHECKPOINTS")) {
-		if err = ioutil.WriteFile(fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), byts, 0600); err != nil {
+		if err = b.bucket.WriteFile(fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), byts); err != nil {
 			return "", errors.Wrap(err, "An IO error occurred during the current operation")
 		}
 	}
@@ -192,11 +202,11 @@ func (b *localBackend) saveStack(name tokens.QName,
 		if verifyerr := snap.VerifyIntegrity(); verifyerr != nil {
 			return "", errors.Wrapf(verifyerr,
 				"%s: snapshot integrity failure; it was already written, but is invalid (backup available at %s)",
-				file, bck)
+				b.bucket.URL(file), b.bucket.URL(bck))
 		}
 	}
 
-	return file, nil
+	return b.bucket.URL(file), nil
 }
 
 // removeStack removes information about a stack from the current workspace.
@@ -205,18 +215,164 @@ func (b *localBackend) removeStack(name tokens.QName) error {
 
 	// Just make a backup of the file and don't write out anything new.
 	file := b.stackPath(name)
-	backupTarget(file)
+	backupTarget(b.bucket, file)
+
+	if err := b.bucket.DeleteFile(b.metadataPath(name)); err != nil {
+		return err
+	}
+	b.forgetStackCrypter(name)
 
 	historyDir := b.historyDirectory(name)
-	return os.RemoveAll(historyDir)
+	return b.bucket.RemoveAll(historyDir)
+}
+
+// renameStack moves a stack's checkpoint, history, and backups so that they belong to the stack newName, rewriting
+// the stack's URNs to match. The old stack's checkpoint file is backed up, as it is by removeStack.
+func (b *localBackend) renameStack(oldName, newName tokens.QName) error {
+	contract.Require(oldName != "", "oldName")
+	contract.Require(newName != "", "newName")
+
+	chk, err := b.getCheckpoint(oldName)
+	if err != nil {
+		return errors.Wrap(err, "failed to load checkpoint")
+	}
+	chk.Stack = newName
+	if chk.Latest != nil {
+		if err = renameDeployment(chk.Latest, oldName, newName); err != nil {
+			return err
+		}
+	}
+
+	// Write the checkpoint out without deserializing it, so that secret values need not be decrypted.
+	file := b.stackPath(newName)
+	m, ext := encoding.Detect(file)
+	if m == nil {
+		return errors.Errorf("resource serialization failed; illegal markup extension: '%v'", ext)
+	}
+	raw, err := json.Marshal(chk)
+	if err != nil {
+		return errors.Wrap(err, "serializing checkpoint")
+	}
+	byts, err := m.Marshal(&apitype.VersionedCheckpoint{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Checkpoint: json.RawMessage(raw),
+	})
+	if err != nil {
+		return errors.Wrap(err, "An IO error occurred during the current operation")
+	}
+	if err = b.bucket.WriteFile(file, byts); err != nil {
+		return errors.Wrap(err, "An IO error occurred during the current operation")
+	}
+
+	// History and backup files are named after their stack, so rename them as they are copied.
+	if err = b.copyStackFiles(b.historyDirectory(oldName), b.historyDirectory(newName), oldName, newName); err != nil {
+		return errors.Wrap(err, "moving history")
+	}
+	if err = b.copyStackFiles(b.backupDirectory(oldName), b.backupDirectory(newName), oldName, newName); err != nil {
+		return errors.Wrap(err, "moving backups")
+	}
+
+	meta, err := b.getStackMetadata(oldName)
+	if err != nil {
+		return err
+	}
+	if err = b.saveStackMetadata(newName, meta); err != nil {
+		return err
+	}
+
+	logging.V(7).Infof("Renamed stack %s to %s", oldName, newName)
+	b.forgetStackCrypter(oldName)
+	b.forgetStackCrypter(newName)
+
+	backupTarget(b.bucket, b.stackPath(oldName))
+	if err = b.bucket.DeleteFile(b.metadataPath(oldName)); err != nil {
+		return err
+	}
+	if err = b.bucket.RemoveAll(b.historyDirectory(oldName)); err != nil {
+		return err
+	}
+	return b.bucket.RemoveAll(b.backupDirectory(oldName))
+}
+
+// copyStackFiles copies every file in the directory src to the directory dest, replacing the oldName prefix of each
+// file's name with newName.
+func (b *localBackend) copyStackFiles(src, dest string, oldName, newName tokens.QName) error {
+	files, err := b.bucket.ListFiles(src)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		byts, readErr := b.bucket.ReadFile(path.Join(src, f))
+		if readErr != nil {
+			return readErr
+		}
+		if strings.HasPrefix(f, string(oldName)) {
+			f = string(newName) + strings.TrimPrefix(f, string(oldName))
+		}
+		if err = b.bucket.WriteFile(path.Join(dest, f), byts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameDeployment rewrites every URN in the given deployment, and every reference to one, so that the resources
+// belong to the stack newName. The root stack resource, whose name is derived from the stack's name, is renamed too.
+func renameDeployment(dep *apitype.DeploymentV2, oldName, newName tokens.QName) error {
+	fix := func(urn resource.URN) resource.URN {
+		if urn == "" || urn.Stack() != oldName {
+			return urn
+		}
+		name := urn.Name()
+		if urn.Type() == resource.RootStackType && string(name) == string(urn.Project())+"-"+string(oldName) {
+			name = tokens.QName(string(urn.Project()) + "-" + string(newName))
+		}
+		return resource.NewURN(newName, urn.Project(), "", urn.QualifiedType(), name)
+	}
+	fixResource := func(res *apitype.ResourceV2) error {
+		res.URN = fix(res.URN)
+		res.Parent = fix(res.Parent)
+		for i, d := range res.Dependencies {
+			res.Dependencies[i] = fix(d)
+		}
+		if res.Provider != "" {
+			ref, err := providers.ParseReference(res.Provider)
+			if err != nil {
+				return errors.Wrapf(err, "parsing provider reference for %s", res.URN)
+			}
+			newRef, err := providers.NewReference(fix(ref.URN()), ref.ID())
+			if err != nil {
+				return err
+			}
+			res.Provider = newRef.String()
+		}
+		return nil
+	}
+
+	for i := range dep.Resources {
+		if err := fixResource(&dep.Resources[i]); err != nil {
+			return err
+		}
+	}
+	for i := range dep.PendingOperations {
+		if err := fixResource(&dep.PendingOperations[i].Resource); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// backupTarget makes a backup of an existing file, in preparation for writing a new one.  Instead of a copy, it
-// simply renames the file, which is simpler, more efficient, etc.
-func backupTarget(file string) string {
+// backupTarget makes a backup of an existing file, in preparation for writing a new one.  Buckets don't support
+// renaming, so the file is copied to its backup location and then deleted.
+func backupTarget(bucket Bucket, file string) string {
 	contract.Require(file != "", "file")
 	bck := file + ".bak"
-	err := os.Rename(file, bck)
+	byts, err := bucket.ReadFile(file)
+	if err == nil {
+		if err = bucket.WriteFile(bck, byts); err == nil {
+			err = bucket.DeleteFile(file)
+		}
+	}
 	contract.IgnoreError(err) // ignore errors.
 	// IDEA: consider multiple backups (.bak.bak.bak...etc).
 	return bck
@@ -233,7 +389,7 @@ func (b *localBackend) backupStack(name tokens.QName) error {
 
 	// Read the current checkpoint file. (Assuming it aleady exists.)
 	stackPath := b.stackPath(name)
-	byts, err := ioutil.ReadFile(stackPath)
+	byts, err := b.bucket.ReadFile(stackPath)
 	if err != nil {
 		return err
 	}
@@ -241,36 +397,68 @@ func (b *localBackend) backupStack(name tokens.QName) error {
 	// Get the backup directory.
 	backupDir := b.backupDirectory(name)
 
-	// Ensure the backup directory exists.
-	if err = os.MkdirAll(backupDir, 0700); err != nil {
-		return err
-	}
-
 	// Write out the new backup checkpoint file.
-	stackFile := filepath.Base(stackPath)
-	ext := filepath.Ext(stackFile)
+	stackFile := path.Base(stackPath)
+	ext := path.Ext(stackFile)
 	base := strings.TrimSuffix(stackFile, ext)
 	backupFile := fmt.Sprintf("%s.%v%s", base, time.Now().UnixNano(), ext)
-	return ioutil.WriteFile(filepath.Join(backupDir, backupFile), byts, 0600)
+	return b.bucket.WriteFile(path.Join(backupDir, backupFile), byts)
 }
 
+// stackPath returns the bucket key of the given stack's checkpoint file, or of the directory that contains all
+// checkpoint files if stack is empty.
 func (b *localBackend) stackPath(stack tokens.QName) string {
-	path := filepath.Join(b.StateDir(), workspace.StackDir)
+	dir := path.Join(workspace.BookkeepingDir, workspace.StackDir)
 	if stack != "" {
-		path = filepath.Join(path, fsutil.QnamePath(stack)+".json")
+		return path.Join(dir, string(stack)+".json")
 	}
 
-	return path
+	return dir
 }
 
 func (b *localBackend) historyDirectory(stack tokens.QName) string {
 	contract.Require(stack != "", "stack")
-	return filepath.Join(b.StateDir(), workspace.HistoryDir, fsutil.QnamePath(stack))
+	return path.Join(workspace.BookkeepingDir, workspace.HistoryDir, string(stack))
 }
 
 func (b *localBackend) backupDirectory(stack tokens.QName) string {
 	contract.Require(stack != "", "stack")
-	return filepath.Join(b.StateDir(), workspace.BackupDir, fsutil.QnamePath(stack))
+	return path.Join(workspace.BookkeepingDir, workspace.BackupDir, string(stack))
+}
+
+// metadataPath returns the bucket key of the given stack's metadata file.
+func (b *localBackend) metadataPath(stack tokens.QName) string {
+	contract.Require(stack != "", "stack")
+	return path.Join(workspace.BookkeepingDir, workspace.MetadataDir, string(stack)+".json")
+}
+
+// stackMetadata holds the information about a stack that is not part of its checkpoint.
+type stackMetadata struct {
+	Tags map[apitype.StackTagName]string `json:"tags,omitempty"` // the stack's tags.
+}
+
+// getStackMetadata returns the metadata for the given stack. A stack without a metadata file has empty metadata.
+func (b *localBackend) getStackMetadata(name tokens.QName) (stackMetadata, error) {
+	var meta stackMetadata
+	byts, err := b.bucket.ReadFile(b.metadataPath(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return meta, nil
+		}
+		return meta, err
+	}
+	if err = json.Unmarshal(byts, &meta); err != nil {
+		return meta, errors.Wrapf(err, "reading metadata for stack '%s'", name)
+	}
+	return meta, nil
+}
+
+func (b *localBackend) saveStackMetadata(name tokens.QName, meta stackMetadata) error {
+	byts, err := json.MarshalIndent(&meta, "", "    ")
+	if err != nil {
+		return err
+	}
+	return b.bucket.WriteFile(b.metadataPath(name), byts)
 }
 
 // getHistory returns locally stored update history. The first element of the result will be
@@ -278,23 +466,19 @@ func (b *localBackend) backupDirectory(stack tokens.QName) string {
 func (b *localBackend) getHistory(name tokens.QName) ([]backend.UpdateInfo, error) {
 	contract.Require(name != "", "name")
 
+	// History doesn't exist until a stack has been updated, in which case the directory is simply empty.
 	dir := b.historyDirectory(name)
-	allFiles, err := ioutil.ReadDir(dir)
+	allFiles, err := b.bucket.ListFiles(dir)
 	if err != nil {
-		// History doesn't exist until a stack has been updated.
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
 		return nil, err
 	}
 
 	var updates []backend.UpdateInfo
 
-	// os.ReadDir returns the array sorted by file name, but because of how we name files, older updates come before
+	// ListFiles returns the array sorted by file name, but because of how we name files, older updates come before
 	// newer ones. Loop backwards so we added the newest updates to the array we will return first.
 	for i := len(allFiles) - 1; i >= 0; i-- {
-		file := allFiles[i]
-		filepath := path.Join(dir, file.Name())
+		filepath := path.Join(dir, allFiles[i])
 
 		// Open all of the history files, ignoring the checkpoints.
 		if !strings.HasSuffix(filepath, ".history.json") {
@@ -302,11 +486,11 @@ func (b *localBackend) getHistory(name tokens.QName) ([]backend.UpdateInfo, erro
 		}
 
 		var update backend.UpdateInfo
-		b, err := ioutil.ReadFile(filepath)
+		byts, err := b.bucket.ReadFile(filepath)
 		if err != nil {
 			return nil, errors.Wrapf(err, "reading history file %s", filepath)
 		}
-		err = json.Unmarshal(b, &update)
+		err = json.Unmarshal(byts, &update)
 		if err != nil {
 			return nil, errors.Wrapf(err, "reading history file %s", filepath)
 		}
@@ -322,9 +506,6 @@ func (b *localBackend) addToHistory(name tokens.QName, update backend.UpdateInfo
 	contract.Require(name != "", "name")
 
 	dir := b.historyDirectory(name)
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return err
-	}
 
 	// Prefix for the update and checkpoint files.
 	pathPrefix := path.Join(dir, fmt.Sprintf("%s-%d", name, time.Now().UnixNano()))
@@ -336,16 +517,16 @@ func (b *localBackend) addToHistory(name tokens.QName, update backend.UpdateInfo
 	}
 
 	historyFile := fmt.Sprintf("%s.history.json", pathPrefix)
-	if err = ioutil.WriteFile(historyFile, byts, os.ModePerm); err != nil {
+	if err = b.bucket.WriteFile(historyFile, byts); err != nil {
 		return err
 	}
 
 	// Make a copy of the checkpoint file. (Assuming it aleady exists.)
-	byts, err = ioutil.ReadFile(b.stackPath(name))
+	byts, err = b.bucket.ReadFile(b.stackPath(name))
 	if err != nil {
 		return err
 	}
 
 	checkpointFile := fmt.Sprintf("%s.checkpoint.json", pathPrefix)
-	return ioutil.WriteFile(checkpointFile, byts, os.ModePerm)
+	return b.bucket.WriteFile(checkpointFile, byts)
 }
@@ -0,0 +1,156 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+func TestRenameStack(t *testing.T) {
+	b := newTestBackend(t)
+	oldName, newName := tokens.QName("dev"), tokens.QName("prod")
+
+	urn := func(stack tokens.QName, typ tokens.Type, name string) resource.URN {
+		return resource.NewURN(stack, "proj", "", typ, tokens.QName(name))
+	}
+	root := urn(oldName, resource.RootStackType, "proj-dev")
+	prov := urn(oldName, "pulumi:providers:pkgA", "default")
+	provRef, err := providers.NewReference(prov, "provid")
+	assert.NoError(t, err)
+	a := urn(oldName, "pkgA:m:typA", "a")
+
+	chk, err := json.Marshal(apitype.CheckpointV2{
+		Stack: oldName,
+		Latest: &apitype.DeploymentV2{
+			Resources: []apitype.ResourceV2{
+				{URN: root, Type: resource.RootStackType, Custom: false},
+				{URN: prov, Type: "pulumi:providers:pkgA", Custom: true, ID: "provid", Parent: root},
+				{URN: a, Type: "pkgA:m:typA", Custom: true, ID: "a", Parent: root,
+					Dependencies: []resource.URN{prov}, Provider: provRef.String()},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	byts, err := json.Marshal(apitype.VersionedCheckpoint{
+		Version:    apitype.DeploymentSchemaVersionCurrent,
+		Checkpoint: json.RawMessage(chk),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, b.bucket.WriteFile(b.stackPath(oldName), byts))
+	assert.NoError(t, b.addToHistory(oldName, backend.UpdateInfo{Kind: apitype.UpdateUpdate}))
+
+	ref, err := b.RenameStack(context.Background(), localBackendReference{name: oldName}, newName)
+	assert.NoError(t, err)
+	assert.Equal(t, newName, ref.Name())
+
+	// The old stack is gone, along with its history.
+	_, err = b.getCheckpoint(oldName)
+	assert.True(t, os.IsNotExist(err))
+	history, err := b.getHistory(oldName)
+	assert.NoError(t, err)
+	assert.Len(t, history, 0)
+
+	// The new stack's URNs and references all refer to the new stack.
+	_, snap, _, err := b.getStack(newName, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, snap.Resources, 3) {
+		newRoot := urn(newName, resource.RootStackType, "proj-prod")
+		newProv := urn(newName, "pulumi:providers:pkgA", "default")
+		assert.Equal(t, newRoot, snap.Resources[0].URN)
+		assert.Equal(t, newProv, snap.Resources[1].URN)
+		assert.Equal(t, newRoot, snap.Resources[1].Parent)
+
+		res := snap.Resources[2]
+		assert.Equal(t, urn(newName, "pkgA:m:typA", "a"), res.URN)
+		assert.Equal(t, newRoot, res.Parent)
+		assert.Equal(t, []resource.URN{newProv}, res.Dependencies)
+		newRef, err := providers.ParseReference(res.Provider)
+		assert.NoError(t, err)
+		assert.Equal(t, newProv, newRef.URN())
+		assert.Equal(t, resource.ID("provid"), newRef.ID())
+	}
+	history, err = b.getHistory(newName)
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+
+	// A stack can't be renamed to the name of an existing stack.
+	assert.NoError(t, b.bucket.WriteFile(b.stackPath(oldName), byts))
+	_, err = b.RenameStack(context.Background(), localBackendReference{name: oldName}, newName)
+	assert.Error(t, err)
+}
+
+func TestStackTags(t *testing.T) {
+	b := newTestBackend(t)
+	ctx := context.Background()
+	ref := localBackendReference{name: "dev"}
+
+	// Tags can't be set on a stack that doesn't exist.
+	assert.Error(t, b.UpdateStackTags(ctx, ref, map[apitype.StackTagName]string{"env": "dev"}))
+
+	_, err := b.saveStack(ref.name, nil, nil)
+	assert.NoError(t, err)
+	tags, err := b.GetStackTags(ctx, ref)
+	assert.NoError(t, err)
+	assert.Len(t, tags, 0)
+
+	assert.NoError(t, b.UpdateStackTags(ctx, ref, map[apitype.StackTagName]string{"env": "dev", "team": "infra"}))
+	tags, err = b.GetStackTags(ctx, ref)
+	assert.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"env": "dev", "team": "infra"}, tags)
+
+	// Tags follow the stack when it is renamed, and are removed along with it.
+	newRef, err := b.RenameStack(ctx, ref, "prod")
+	assert.NoError(t, err)
+	tags, err = b.GetStackTags(ctx, newRef)
+	assert.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"env": "dev", "team": "infra"}, tags)
+
+	// Tags are returned along with the stack's summary, so that listing stacks by tag needs no further lookups.
+	summaries, err := b.ListStacks(ctx, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, summaries, 1) {
+		assert.Equal(t, map[apitype.StackTagName]string{"env": "dev", "team": "infra"}, summaries[0].Tags())
+	}
+
+	_, err = b.RemoveStack(ctx, newRef, false)
+	assert.NoError(t, err)
+	meta, err := b.getStackMetadata(newRef.Name())
+	assert.NoError(t, err)
+	assert.Len(t, meta.Tags, 0)
+}
+
+func TestStackCrypterIsShared(t *testing.T) {
+	b := newTestBackend(t)
+
+	// Every operation on a stack shares its crypter, so that its passphrase is read at most once.
+	dev := b.stackCrypter("dev")
+	assert.True(t, dev == b.stackCrypter("dev"))
+	assert.False(t, dev == b.stackCrypter("prod"))
+
+	// Forgetting a stack's crypter, as removing the stack does, causes a new one to be created.
+	b.forgetStackCrypter("dev")
+	assert.False(t, dev == b.stackCrypter("dev"))
+}
@@ -509,7 +509,7 @@ func (b *cloudBackend) CreateStack(ctx context.Context, stackRef backend.StackRe
 		return nil, err
 	}
 
-	tags, err := backend.GetStackTags()
+	tags, err := backend.GetEnvironmentTagsForCurrentStack()
 	if err != nil {
 		return nil, errors.Wrap(err, "error determining initial tags")
 	}
@@ -558,6 +558,49 @@ func (b *cloudBackend) RemoveStack(ctx context.Context, stackRef backend.StackRe
 	return b.client.DeleteStack(ctx, stack, force)
 }
 
+func (b *cloudBackend) RenameStack(ctx context.Context, stackRef backend.StackReference,
+	newName tokens.QName) (backend.StackReference, error) {
+
+	if err := backend.ValidateStackProperties(string(newName), nil); err != nil {
+		return nil, err
+	}
+	stack, err := b.getCloudStackIdentifier(stackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = b.client.RenameStack(ctx, stack, string(newName)); err != nil {
+		return nil, err
+	}
+	return cloudBackendReference{name: newName, owner: stack.Owner, b: b}, nil
+}
+
+func (b *cloudBackend) GetStackTags(ctx context.Context,
+	stackRef backend.StackReference) (map[apitype.StackTagName]string, error) {
+
+	stack, err := b.getCloudStackIdentifier(stackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	apistack, err := b.client.GetStack(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+	return apistack.Tags, nil
+}
+
+func (b *cloudBackend) UpdateStackTags(ctx context.Context, stackRef backend.StackReference,
+	tags map[apitype.StackTagName]string) error {
+
+	stack, err := b.getCloudStackIdentifier(stackRef)
+	if err != nil {
+		return err
+	}
+
+	return b.client.UpdateStackTags(ctx, stack, tags)
+}
+
 // cloudCrypter is an encrypter/decrypter that uses the Pulumi cloud to encrypt/decrypt a stack's secrets.
 type cloudCrypter struct {
 	backend *cloudBackend
@@ -678,8 +721,12 @@ func (b *cloudBackend) createAndStartUpdate(
 	}
 
 	// Start the update. We use this opportunity to pass new tags to the service, to pick up any
-	// metadata changes.
-	tags, err := backend.GetStackTags()
+	// metadata changes. The service replaces the stack's tags, so include any that were set by the user.
+	apistack, err := b.client.GetStack(ctx, stack)
+	if err != nil {
+		return client.UpdateIdentifier{}, 0, "", err
+	}
+	tags, err := backend.MergeEnvironmentTags(apistack.Tags)
 	if err != nil {
 		return client.UpdateIdentifier{}, 0, "", errors.Wrap(err, "getting stack tags")
 	}
@@ -698,10 +745,12 @@ func (b *cloudBackend) createAndStartUpdate(
 // apply actually performs the provided type of update on a stack hosted in the Pulumi Cloud.
 func (b *cloudBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack backend.Stack,
 	op backend.UpdateOperation, opts backend.ApplierOptions, events chan<- engine.Event) (engine.ResourceChanges, error) {
-	// Print a banner so it's clear this is going to the cloud.
+	// Print a banner so it's clear this is going to the cloud.  When emitting JSON, nothing else may be printed.
 	actionLabel := backend.ActionLabel(kind, opts.DryRun)
-	fmt.Printf(op.Opts.Display.Color.Colorize(
-		colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stack.Ref())
+	if !op.Opts.Display.JSONDisplay && !op.Opts.Display.SuppressDisplay {
+		fmt.Printf(op.Opts.Display.Color.Colorize(
+			colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stack.Ref())
+	}
 
 	// Create an update object to persist results.
 	update, version, token, err := b.createAndStartUpdate(ctx, kind, stack.Ref(), op, opts.DryRun)
@@ -709,7 +758,7 @@ func (b *cloudBackend) apply(ctx context.Context, kind apitype.UpdateKind, stack
 		return nil, err
 	}
 
-	if opts.ShowLink {
+	if opts.ShowLink && !op.Opts.Display.JSONDisplay && !op.Opts.Display.SuppressDisplay {
 		// Print a URL at the end of the update pointing to the Pulumi Service.
 		var link string
 		base := b.cloudConsoleStackPath(update.StackIdentifier)
@@ -796,7 +845,12 @@ func (b *cloudBackend) runEngineAction(
 
 	// Depending on the action, kick off the relevant engine activity.  Note that we don't immediately check and
 	// return error conditions, because we will do so below after waiting for the display channels to close.
-	engineCtx := &engine.Context{Cancel: scope.Context(), Events: engineEvents, SnapshotManager: manager}
+	engineCtx := &engine.Context{
+		Cancel:          scope.Context(),
+		Events:          engineEvents,
+		SnapshotManager: manager,
+		BackendClient:   backend.NewBackendClient(b),
+	}
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		engineCtx.ParentSpan = parentSpan.Context()
 	}
@@ -924,9 +978,12 @@ func convertConfig(apiConfig map[string]apitype.ConfigValue) (config.Map, error)
 		if err != nil {
 			return nil, err
 		}
-		if rawV.Secret {
+		switch {
+		case rawV.Object:
+			c[k] = config.NewObjectValue(rawV.String)
+		case rawV.Secret:
 			c[k] = config.NewSecureValue(rawV.String)
-		} else {
+		default:
 			c[k] = config.NewValue(rawV.String)
 		}
 	}
@@ -193,9 +193,12 @@ func (pc *Client) GetLatestConfiguration(ctx context.Context, stackID StackIdent
 		if err != nil {
 			return nil, err
 		}
-		if v.Secret {
+		switch {
+		case v.Object:
+			cfg[newKey] = config.NewObjectValue(v.String)
+		case v.Secret:
 			cfg[newKey] = config.NewSecureValue(v.String)
-		} else {
+		default:
 			cfg[newKey] = config.NewValue(v.String)
 		}
 	}
@@ -255,6 +258,18 @@ func (pc *Client) DeleteStack(ctx context.Context, stack StackIdentifier, force
 	return false, pc.restCall(ctx, "DELETE", path, nil, nil, nil)
 }
 
+// RenameStack renames the indicated stack to newName.
+func (pc *Client) RenameStack(ctx context.Context, stack StackIdentifier, newName string) error {
+	req := apitype.StackRenameRequest{NewName: newName}
+	return pc.restCall(ctx, "POST", getStackPath(stack, "rename"), nil, &req, nil)
+}
+
+// UpdateStackTags replaces the tags of the indicated stack with the given set.
+func (pc *Client) UpdateStackTags(
+	ctx context.Context, stack StackIdentifier, tags map[apitype.StackTagName]string) error {
+	return pc.restCall(ctx, "PATCH", getStackPath(stack, "tags"), nil, tags, nil)
+}
+
 // EncryptValue encrypts a plaintext value in the context of the indicated stack.
 func (pc *Client) EncryptValue(ctx context.Context, stack StackIdentifier, plaintext []byte) ([]byte, error) {
 	req := apitype.EncryptValueRequest{Plaintext: plaintext}
@@ -347,6 +362,7 @@ func (pc *Client) CreateUpdate(
 		wireConfig[k.String()] = apitype.ConfigValue{
 			String: v,
 			Secret: cv.Secure(),
+			Object: cv.Object(),
 		}
 	}
 
@@ -17,6 +17,8 @@ package httpstate
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/httpstate/client"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
@@ -45,7 +47,11 @@ func (persister *cloudSnapshotPersister) Save(snapshot *deploy.Snapshot) error {
 	if err != nil {
 		return err
 	}
-	deployment := stack.SerializeDeployment(snapshot)
+	crypter := &cloudCrypter{backend: persister.backend, stack: persister.update.StackIdentifier}
+	deployment, err := stack.SerializeDeployment(snapshot, crypter)
+	if err != nil {
+		return errors.Wrap(err, "serializing deployment")
+	}
 	return persister.backend.client.PatchUpdateCheckpoint(persister.context, persister.update, deployment, token)
 }
 
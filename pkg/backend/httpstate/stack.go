@@ -181,3 +181,7 @@ func (css cloudStackSummary) LastUpdate() *time.Time {
 func (css cloudStackSummary) ResourceCount() *int {
 	return css.summary.ResourceCount
 }
+
+func (css cloudStackSummary) Tags() map[apitype.StackTagName]string {
+	return css.summary.Tags
+}
@@ -241,7 +241,12 @@ func (b *cloudBackend) getSnapshot(ctx context.Context, stackRef backend.StackRe
 		return nil, err
 	}
 
-	snapshot, err := stack.DeserializeUntypedDeployment(untypedDeployment)
+	decrypter, err := b.GetStackCrypter(stackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := stack.DeserializeUntypedDeployment(untypedDeployment, decrypter)
 	if err != nil {
 		return nil, err
 	}
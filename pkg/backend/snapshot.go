@@ -151,6 +151,8 @@ func (sm *SnapshotManager) BeginMutation(step deploy.Step) (engine.SnapshotMutat
 		return sm.doRead(step)
 	case deploy.OpRefresh:
 		return &refreshSnapshotMutation{sm}, nil
+	case deploy.OpImport:
+		return sm.doImport(step)
 	}
 
 	contract.Failf("unknown StepOp: %s", step.Op())
@@ -174,11 +176,14 @@ type sameSnapshotMutation struct {
 // step that forces us to write the checkpoint. If no such difference exists, the checkpoint write that corresponds to
 // this step can be elided.
 func (ssm *sameSnapshotMutation) mustWrite(old, new *resource.State) bool {
-	contract.Assert(old.Type == new.Type)
-	contract.Assert(old.URN == new.URN)
 	contract.Assert(old.Delete == new.Delete)
 	contract.Assert(old.External == new.External)
 
+	// If this resource was found under an alias, its URN or type has changed and we must write the checkpoint.
+	if old.URN != new.URN || old.Type != new.Type {
+		return true
+	}
+
 	// If the kind of this resource has changed, we must write the checkpoint.
 	if old.Custom != new.Custom {
 		return true
@@ -197,6 +202,11 @@ func (ssm *sameSnapshotMutation) mustWrite(old, new *resource.State) bool {
 		return true
 	}
 
+	// If the custom timeouts of this resource have changed, we must write the checkpoint.
+	if old.CustomTimeouts != new.CustomTimeouts {
+		return true
+	}
+
 	// If the inputs or outputs of this resource have changed, we must write the checkpoint. Note that it is possible
 	// for the inputs of a "same" resource to have changed even if the contents of the input bags are different if the
 	// resource's provider deems the physical change to be semantically irrelevant.
@@ -378,6 +388,40 @@ func (rsm *readSnapshotMutation) End(step deploy.Step, successful bool) error {
 	})
 }
 
+func (sm *SnapshotManager) doImport(step deploy.Step) (engine.SnapshotMutation, error) {
+	logging.V(9).Infof("SnapshotManager.doImport(%s)", step.URN())
+	err := sm.mutate(func() bool {
+		sm.markOperationPending(step.New(), resource.OperationTypeImporting)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &importSnapshotMutation{sm}, nil
+}
+
+type importSnapshotMutation struct {
+	manager *SnapshotManager
+}
+
+func (ism *importSnapshotMutation) End(step deploy.Step, successful bool) error {
+	contract.Require(step != nil, "step != nil")
+	contract.Require(step.Op() == deploy.OpImport, "step.Op() == deploy.OpImport")
+	logging.V(9).Infof("SnapshotManager: importSnapshotMutation.End(..., %v)", successful)
+	return ism.manager.mutate(func() bool {
+		ism.manager.markOperationComplete(step.New())
+		if successful {
+			if step.Old() != nil {
+				ism.manager.markDone(step.Old())
+			}
+
+			ism.manager.markNew(step.New())
+		}
+		return true
+	})
+}
+
 type refreshSnapshotMutation struct {
 	manager *SnapshotManager
 }
@@ -486,7 +530,7 @@ func (sm *SnapshotManager) snap() *deploy.Snapshot {
 	}
 
 	manifest.Magic = manifest.NewMagic()
-	return deploy.NewSnapshot(manifest, resources, operations)
+	return deploy.NewSnapshot(manifest, resources, operations).NormalizeURNReferences()
 }
 
 // saveSnapshot persists the current snapshot and optionally verifies it afterwards.
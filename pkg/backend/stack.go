@@ -26,6 +26,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/operations"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/tokens"
 	"github.com/pulumi/pulumi/pkg/util/gitutil"
 	"github.com/pulumi/pulumi/pkg/workspace"
 )
@@ -61,6 +62,11 @@ func RemoveStack(ctx context.Context, s Stack, force bool) (bool, error) {
 	return s.Backend().RemoveStack(ctx, s.Ref(), force)
 }
 
+// RenameStack renames the stack, returning a reference to it under its new name.
+func RenameStack(ctx context.Context, s Stack, newName tokens.QName) (StackReference, error) {
+	return s.Backend().RenameStack(ctx, s.Ref(), newName)
+}
+
 // PreviewStack previews changes to this stack.
 func PreviewStack(ctx context.Context, s Stack, op UpdateOperation) (engine.ResourceChanges, error) {
 	return s.Backend().Preview(ctx, s.Ref(), op)
@@ -81,6 +87,11 @@ func DestroyStack(ctx context.Context, s Stack, op UpdateOperation) (engine.Reso
 	return s.Backend().Destroy(ctx, s.Ref(), op)
 }
 
+// GetStackHistory returns all of the updates that have been performed on a stack, newest first.
+func GetStackHistory(ctx context.Context, s Stack) ([]UpdateInfo, error) {
+	return s.Backend().GetHistory(ctx, s.Ref())
+}
+
 // GetStackCrypter fetches the encrypter/decrypter for a stack.
 func GetStackCrypter(s Stack) (config.Crypter, error) {
 	return s.Backend().GetStackCrypter(s.Ref())
@@ -106,9 +117,39 @@ func ImportStackDeployment(ctx context.Context, s Stack, deployment *apitype.Unt
 	return s.Backend().ImportDeployment(ctx, s.Ref(), deployment)
 }
 
-// GetStackTags returns the set of tags for the "current" stack, based on the environment
+// GetStackTags returns the tags for the given stack.
+func GetStackTags(ctx context.Context, s Stack) (map[apitype.StackTagName]string, error) {
+	return s.Backend().GetStackTags(ctx, s.Ref())
+}
+
+// UpdateStackTags replaces the tags for the given stack with the given set, after validating them.
+func UpdateStackTags(ctx context.Context, s Stack, tags map[apitype.StackTagName]string) error {
+	if err := ValidateStackProperties(string(s.Ref().Name()), tags); err != nil {
+		return err
+	}
+	return s.Backend().UpdateStackTags(ctx, s.Ref(), tags)
+}
+
+// MergeEnvironmentTags returns the given stack tags overlaid with the tags for the "current" stack that are derived
+// from the environment, so that those tags stay up to date without discarding any that were set by the user.
+func MergeEnvironmentTags(tags map[apitype.StackTagName]string) (map[apitype.StackTagName]string, error) {
+	envTags, err := GetEnvironmentTagsForCurrentStack()
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[apitype.StackTagName]string)
+	for k, v := range tags {
+		merged[k] = v
+	}
+	for k, v := range envTags {
+		merged[k] = v
+	}
+	return merged, nil
+}
+
+// GetEnvironmentTagsForCurrentStack returns the set of tags for the "current" stack, based on the environment
 // and Pulumi.yaml file.
-func GetStackTags() (map[apitype.StackTagName]string, error) {
+func GetEnvironmentTagsForCurrentStack() (map[apitype.StackTagName]string, error) {
 	tags := make(map[apitype.StackTagName]string)
 
 	// Tags based on Pulumi.yaml.
@@ -131,6 +172,10 @@ func GetStackTags() (map[apitype.StackTagName]string, error) {
 			tags[apitype.GitHubOwnerNameTag] = owner
 			tags[apitype.GitHubRepositoryNameTag] = repo
 		}
+		remote, err := gitutil.GetGitRemoteURLForOrigin(filepath.Dir(projPath))
+		if err == nil && len(remote) <= maxTagValue {
+			tags[apitype.GitRemoteTag] = remote
+		}
 
 	}
 
@@ -147,6 +192,9 @@ func validateStackName(s string) error {
 	return errors.New("a stack name may only contain alphanumeric, hyphens, underscores, or periods")
 }
 
+// maxTagValue is the maximum length of a stack tag's value accepted by the Pulumi Service.
+const maxTagValue = 256
+
 // ValidateStackProperties validates the stack name and its tags to confirm they adhear to various
 // naming and length restrictions.
 func ValidateStackProperties(stack string, tags map[apitype.StackTagName]string) error {
@@ -161,7 +209,6 @@ func ValidateStackProperties(stack string, tags map[apitype.StackTagName]string)
 	// Ensure tag values won't be rejected by the Pulumi Service. We do not validate that their
 	// values make sense, e.g. ProjectRuntimeTag is a supported runtime.
 	const maxTagName = 40
-	const maxTagValue = 256
 	for t, v := range tags {
 		if len(t) == 0 {
 			return errors.Errorf("invalid stack tag %q", t)
@@ -49,6 +49,24 @@ func GetAnalyzeResourceFailureError(urn resource.URN) *Diag {
 			"\tReason: %v")
 }
 
+func GetAnalyzeStackFailureError(urn resource.URN) *Diag {
+	return newError(urn, 2008,
+		"Analyzer '%v' reported a stack error:\n"+
+			"\tResource: %v\n"+
+			"\tReason: %v")
+}
+
 func GetPreviewFailedError(urn resource.URN) *Diag {
 	return newError(urn, 2005, "Preview failed: %v")
 }
+
+func GetUntargetedCreateError(urn resource.URN) *Diag {
+	return newError(urn, 2006,
+		"Resource '%v' would be created, but it is not a target of this update; add it to the --target list")
+}
+
+func GetUntargetedDeleteDependentError(urn resource.URN) *Diag {
+	return newError(urn, 2007,
+		"Resource '%v' cannot be deleted because '%v' depends on it or is its child and is not a target of this "+
+			"update; add it to the --target list or pass --target-dependents")
+}
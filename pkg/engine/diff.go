@@ -28,7 +28,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/logging"
 )
 
 // GetIndent computes a step's parent indentation.
@@ -191,6 +193,15 @@ func GetResourcePropertiesDetails(
 		if !summary {
 			printObject(&b, old.Inputs, planning, indent, step.Op, false, debug)
 		}
+	} else if step.DetailedDiff != nil {
+		// If the provider supplied a detailed diff, prefer it over our own diff of the old and new properties, as it
+		// reflects what the provider will actually do.
+		if diff := TranslateDetailedDiff(step); diff != nil {
+			replaces := plugin.DetailedDiffReplaceKeys(step.DetailedDiff)
+			printDetailedObjectDiff(&b, *diff, replaces, planning, indent, summary, debug)
+		} else {
+			printObject(&b, new.Inputs, planning, indent, deploy.OpSame, true, debug)
+		}
 	} else if len(new.Outputs) > 0 {
 		printOldNewDiffs(&b, old.Outputs, new.Outputs, planning, indent, step.Op, summary, debug)
 	} else {
@@ -234,13 +245,14 @@ func GetResourceOutputsPropertiesString(
 	// We should only print outputs if the outputs are known to be complete. This will be the case if we are
 	//   1) not doing a preview
 	//   2) doing a refresh
-	//   3) doing a read
+	//   3) doing a read or an import
 	//
 	// Technically, 2 and 3 are the same, since they're both bottoming out at a provider's implementation of Read, but
 	// the upshot is that either way we're ending up with outputs that are exactly accurate. If we are not sure that we
 	// are in one of the above states, we shouldn't try to print outputs.
 	if planning {
-		printOutputDuringPlanning := refresh || step.Op == deploy.OpRead || step.Op == deploy.OpReadReplacement
+		printOutputDuringPlanning := refresh || step.Op == deploy.OpRead || step.Op == deploy.OpReadReplacement ||
+			step.Op == deploy.OpImport
 		if !printOutputDuringPlanning {
 			return ""
 		}
@@ -298,7 +310,7 @@ func GetResourceOutputsPropertiesString(
 					firstout = false
 				}
 				if outputDiff != nil {
-					printObjectPropertyDiff(b, k, maxkey, *outputDiff, planning, indent, false, debug)
+					printObjectPropertyDiff(b, k, maxkey, *outputDiff, planning, indent, false, false, debug)
 				} else {
 					printPropertyTitle(b, string(k), maxkey, indent, op, false)
 					printPropertyValue(b, out, planning, indent, op, false, debug)
@@ -457,6 +469,163 @@ func printOldNewDiffs(
 	}
 }
 
+// TranslateDetailedDiff converts the detailed diff supplied by a step's provider into an ObjectDiff suitable for
+// display. Each path in the detailed diff is resolved against the step's old state (or old inputs, for input diffs)
+// and new inputs, and any properties that do not appear in the detailed diff are treated as unchanged. If the detailed
+// diff contains no changes, TranslateDetailedDiff returns nil.
+func TranslateDetailedDiff(step StepEventMetadata) *resource.ObjectDiff {
+	contract.Assert(step.DetailedDiff != nil)
+	contract.Assert(step.Old != nil && step.New != nil)
+
+	root := resource.ValueDiff{
+		Old:    resource.NewObjectProperty(step.Old.Outputs),
+		New:    resource.NewObjectProperty(step.New.Inputs),
+		Object: newDetailedObjectDiff(step.New.Inputs),
+	}
+	for path, pdiff := range step.DetailedDiff {
+		elements, err := resource.ParsePropertyPath(path)
+		if err != nil || len(elements) == 0 {
+			logging.V(7).Infof("ignoring invalid detailed diff path '%s' for %s: %v", path, step.URN, err)
+			continue
+		}
+		if _, isKey := elements[0].(string); !isKey {
+			logging.V(7).Infof("ignoring detailed diff path '%s' for %s: root is not a property", path, step.URN)
+			continue
+		}
+
+		old := root.Old
+		if pdiff.InputDiff {
+			old = resource.NewObjectProperty(step.Old.Inputs)
+		}
+		addDetailedDiff(elements, pdiff.Kind, &root, old, root.New)
+	}
+
+	if len(root.Object.Adds) == 0 && len(root.Object.Deletes) == 0 && len(root.Object.Updates) == 0 {
+		return nil
+	}
+	return root.Object
+}
+
+// newDetailedObjectDiff creates an ObjectDiff in which every property of the given map is unchanged.
+func newDetailedObjectDiff(props resource.PropertyMap) *resource.ObjectDiff {
+	sames := make(resource.PropertyMap)
+	for k, v := range props {
+		sames[k] = v
+	}
+	return &resource.ObjectDiff{
+		Adds:    make(resource.PropertyMap),
+		Deletes: make(resource.PropertyMap),
+		Sames:   sames,
+		Updates: make(map[resource.PropertyKey]resource.ValueDiff),
+	}
+}
+
+// newDetailedArrayDiff creates an ArrayDiff in which every element of the given array is unchanged.
+func newDetailedArrayDiff(elements []resource.PropertyValue) *resource.ArrayDiff {
+	sames := make(map[int]resource.PropertyValue)
+	for i, v := range elements {
+		sames[i] = v
+	}
+	return &resource.ArrayDiff{
+		Adds:    make(map[int]resource.PropertyValue),
+		Deletes: make(map[int]resource.PropertyValue),
+		Sames:   sames,
+		Updates: make(map[int]resource.ValueDiff),
+	}
+}
+
+// addDetailedDiff records a single entry of a detailed diff in the given parent diff, creating any intermediate object
+// and array diffs as necessary. The old and new parent values are used to fill in the values of the changed property.
+func addDetailedDiff(path resource.PropertyPath, kind plugin.DiffKind, parent *resource.ValueDiff,
+	oldParent, newParent resource.PropertyValue) {
+
+	contract.Assert(len(path) > 0)
+
+	old, new := resource.NewNullProperty(), resource.NewNullProperty()
+	record := func(updates func(resource.ValueDiff), adds, deletes func(resource.PropertyValue)) {
+		switch {
+		case kind == plugin.DiffAdd || kind == plugin.DiffAddReplace:
+			adds(new)
+		case kind == plugin.DiffDelete || kind == plugin.DiffDeleteReplace:
+			deletes(old)
+		default:
+			diff := resource.ValueDiff{Old: old, New: new}
+			if d := old.Diff(new); d != nil {
+				diff = *d
+			}
+			updates(diff)
+		}
+	}
+
+	switch element := path[0].(type) {
+	case string:
+		key := resource.PropertyKey(element)
+		if parent.Object == nil {
+			var props resource.PropertyMap
+			if newParent.IsObject() {
+				props = newParent.ObjectValue()
+			}
+			parent.Object, parent.Array = newDetailedObjectDiff(props), nil
+		}
+		if oldParent.IsObject() {
+			if v, ok := oldParent.ObjectValue()[key]; ok {
+				old = v
+			}
+		}
+		if newParent.IsObject() {
+			if v, ok := newParent.ObjectValue()[key]; ok {
+				new = v
+			}
+		}
+
+		diff := parent.Object
+		delete(diff.Sames, key)
+		if len(path) > 1 {
+			child, ok := diff.Updates[key]
+			if !ok {
+				child = resource.ValueDiff{Old: old, New: new}
+			}
+			addDetailedDiff(path[1:], kind, &child, old, new)
+			diff.Updates[key] = child
+			return
+		}
+		record(
+			func(d resource.ValueDiff) { diff.Updates[key] = d },
+			func(v resource.PropertyValue) { diff.Adds[key] = v },
+			func(v resource.PropertyValue) { diff.Deletes[key] = v })
+	case int:
+		if parent.Array == nil {
+			var elements []resource.PropertyValue
+			if newParent.IsArray() {
+				elements = newParent.ArrayValue()
+			}
+			parent.Array, parent.Object = newDetailedArrayDiff(elements), nil
+		}
+		if oldParent.IsArray() && element < len(oldParent.ArrayValue()) {
+			old = oldParent.ArrayValue()[element]
+		}
+		if newParent.IsArray() && element < len(newParent.ArrayValue()) {
+			new = newParent.ArrayValue()[element]
+		}
+
+		diff := parent.Array
+		delete(diff.Sames, element)
+		if len(path) > 1 {
+			child, ok := diff.Updates[element]
+			if !ok {
+				child = resource.ValueDiff{Old: old, New: new}
+			}
+			addDetailedDiff(path[1:], kind, &child, old, new)
+			diff.Updates[element] = child
+			return
+		}
+		record(
+			func(d resource.ValueDiff) { diff.Updates[element] = d },
+			func(v resource.PropertyValue) { diff.Adds[element] = v },
+			func(v resource.PropertyValue) { diff.Deletes[element] = v })
+	}
+}
+
 func printObjectDiff(b *bytes.Buffer, diff resource.ObjectDiff,
 	planning bool, indent int, summary bool, debug bool) {
 
@@ -468,14 +637,36 @@ func printObjectDiff(b *bytes.Buffer, diff resource.ObjectDiff,
 
 	// To print an object diff, enumerate the keys in stable order, and print each property independently.
 	for _, k := range keys {
-		printObjectPropertyDiff(b, k, maxkey, diff, planning, indent, summary, debug)
+		printObjectPropertyDiff(b, k, maxkey, diff, planning, indent, false, summary, debug)
 	}
 }
 
-func printObjectPropertyDiff(b *bytes.Buffer, key resource.PropertyKey, maxkey int, diff resource.ObjectDiff,
+// printDetailedObjectDiff prints an object diff translated from a provider's detailed diff. The titles of the given
+// replacement keys are printed as replacements rather than as adds, deletes, or updates.
+func printDetailedObjectDiff(b *bytes.Buffer, diff resource.ObjectDiff, replaces []resource.PropertyKey,
 	planning bool, indent int, summary bool, debug bool) {
 
+	contract.Assert(indent > 0)
+
+	replaceKeys := make(map[resource.PropertyKey]bool)
+	for _, k := range replaces {
+		replaceKeys[k] = true
+	}
+
+	keys := diff.Keys()
+	maxkey := maxKey(keys)
+	for _, k := range keys {
+		printObjectPropertyDiff(b, k, maxkey, diff, planning, indent, replaceKeys[k], summary, debug)
+	}
+}
+
+func printObjectPropertyDiff(b *bytes.Buffer, key resource.PropertyKey, maxkey int, diff resource.ObjectDiff,
+	planning bool, indent int, replace bool, summary bool, debug bool) {
+
 	titleFunc := func(top deploy.StepOp, prefix bool) {
+		if replace && top != deploy.OpSame {
+			top = deploy.OpReplace
+		}
 		printPropertyTitle(b, string(key), maxkey, indent, top, prefix)
 	}
 	if add, isadd := diff.Adds[key]; isadd {
@@ -564,7 +755,7 @@ func printPropertyValueDiff(
 
 func isPrimitive(value resource.PropertyValue) bool {
 	return value.IsNull() || value.IsString() || value.IsNumber() ||
-		value.IsBool() || value.IsComputed() || value.IsOutput()
+		value.IsBool() || value.IsComputed() || value.IsOutput() || value.IsSecret()
 }
 
 func printPrimitivePropertyValue(b *bytes.Buffer, v resource.PropertyValue, planning bool, op deploy.StepOp) {
@@ -578,6 +769,8 @@ func printPrimitivePropertyValue(b *bytes.Buffer, v resource.PropertyValue, plan
 		write(b, op, "%v", v.NumberValue())
 	} else if v.IsString() {
 		write(b, op, "%q", v.StringValue())
+	} else if v.IsSecret() {
+		write(b, op, "[secret]")
 	} else if v.IsComputed() || v.IsOutput() {
 		// We render computed and output values differently depending on whether or not we are
 		// planning or deploying: in the former case, we display `computed<type>` or `output<type>`;
@@ -36,10 +36,12 @@ type UpdateInfo interface {
 }
 
 // Context provides cancellation, termination, and eventing options for an engine operation. It also provides
-// a way for the engine to persist snapshots, using the `SnapshotManager`.
+// a way for the engine to persist snapshots, using the `SnapshotManager`, and to read information about other stacks,
+// using the `BackendClient`.
 type Context struct {
 	Cancel          *cancel.Context
 	Events          chan<- Event
 	SnapshotManager SnapshotManager
+	BackendClient   deploy.BackendClient
 	ParentSpan      opentracing.SpanContext
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/tokens"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/util/logging"
@@ -79,6 +80,9 @@ type SummaryEventPayload struct {
 	MaybeCorrupt    bool            // true if one or more resources may be corrupt
 	Duration        time.Duration   // the duration of the entire update operation (zero values for previews)
 	ResourceChanges ResourceChanges // count of changed resources, useful for reporting
+	Failed          []resource.URN  // the resources whose operations failed, when continuing after errors
+	Skipped         []resource.URN  // the resources skipped because they depend on resources that failed
+	Orphaned        []resource.URN  // the resources dropped from the stack that may exist and need manual deletion
 }
 
 type ResourceOperationFailedPayload struct {
@@ -109,6 +113,10 @@ type StepEventMetadata struct {
 	Keys     []resource.PropertyKey  // the keys causing replacement (only for CreateStep and ReplaceStep).
 	Logical  bool                    // true if this step represents a logical operation in the program.
 	Provider string                  // the provider that performed this step.
+
+	// the structured property diff reported by the provider, or nil if the provider did not supply one (only for
+	// CreateStep, ReplaceStep, and UpdateStep).
+	DetailedDiff map[string]plugin.PropertyDiff
 }
 
 type StepEventStateMetadata struct {
@@ -178,10 +186,16 @@ func makeStepEventMetadata(op deploy.StepOp, step deploy.Step, debug bool) StepE
 	contract.Assert(op == step.Op() || step.Op() == deploy.OpRefresh)
 
 	var keys []resource.PropertyKey
-	if step.Op() == deploy.OpCreateReplacement {
-		keys = step.(*deploy.CreateStep).Keys()
-	} else if step.Op() == deploy.OpReplace {
-		keys = step.(*deploy.ReplaceStep).Keys()
+	var detailedDiff map[string]plugin.PropertyDiff
+	switch step.Op() {
+	case deploy.OpCreateReplacement:
+		create := step.(*deploy.CreateStep)
+		keys, detailedDiff = create.Keys(), create.DetailedDiff()
+	case deploy.OpReplace:
+		replace := step.(*deploy.ReplaceStep)
+		keys, detailedDiff = replace.Keys(), replace.DetailedDiff()
+	case deploy.OpUpdate:
+		detailedDiff = step.(*deploy.UpdateStep).DetailedDiff()
 	}
 
 	return StepEventMetadata{
@@ -194,6 +208,8 @@ func makeStepEventMetadata(op deploy.StepOp, step deploy.Step, debug bool) StepE
 		Res:      makeStepEventStateMetadata(step.Res(), debug),
 		Logical:  step.Logical(),
 		Provider: step.Provider(),
+
+		DetailedDiff: detailedDiff,
 	}
 }
 
@@ -285,6 +301,11 @@ func filterPropertyMap(propertyMap resource.PropertyMap, debug bool) resource.Pr
 			return resource.Output{
 				Element: filterPropertyValue(t.Element),
 			}
+		case *resource.Secret:
+			// never send the underlying value of a secret.
+			return &resource.Secret{
+				Element: resource.NewStringProperty("[secret]"),
+			}
 		}
 
 		// Next, see if it's an array, slice, pointer or struct, and handle each accordingly.
@@ -389,7 +410,7 @@ func (e *eventEmitter) preludeEvent(isPreview bool, cfg config.Map) {
 	}
 }
 
-func (e *eventEmitter) previewSummaryEvent(resourceChanges ResourceChanges) {
+func (e *eventEmitter) previewSummaryEvent(resourceChanges ResourceChanges, failures *deploy.FailureReport) {
 	contract.Requiref(e != nil, "e", "!= nil")
 
 	e.Chan <- Event{
@@ -399,12 +420,15 @@ func (e *eventEmitter) previewSummaryEvent(resourceChanges ResourceChanges) {
 			MaybeCorrupt:    false,
 			Duration:        0,
 			ResourceChanges: resourceChanges,
+			Failed:          failures.Failed,
+			Skipped:         failures.Skipped,
+			Orphaned:        failures.Orphaned,
 		},
 	}
 }
 
 func (e *eventEmitter) updateSummaryEvent(maybeCorrupt bool,
-	duration time.Duration, resourceChanges ResourceChanges) {
+	duration time.Duration, resourceChanges ResourceChanges, failures *deploy.FailureReport) {
 	contract.Requiref(e != nil, "e", "!= nil")
 
 	e.Chan <- Event{
@@ -414,6 +438,9 @@ func (e *eventEmitter) updateSummaryEvent(maybeCorrupt bool,
 			MaybeCorrupt:    maybeCorrupt,
 			Duration:        duration,
 			ResourceChanges: resourceChanges,
+			Failed:          failures.Failed,
+			Skipped:         failures.Skipped,
+			Orphaned:        failures.Orphaned,
 		},
 	}
 }
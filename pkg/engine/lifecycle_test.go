@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/mitchellh/copystructure"
@@ -127,6 +128,8 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 				ops = append(ops, resource.NewOperation(e.Step.New(), resource.OperationTypeReading))
 			case deploy.OpUpdate:
 				ops = append(ops, resource.NewOperation(e.Step.New(), resource.OperationTypeUpdating))
+			case deploy.OpImport:
+				ops = append(ops, resource.NewOperation(e.Step.New(), resource.OperationTypeImporting))
 			}
 
 			continue
@@ -134,7 +137,8 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 
 		if e.Kind != JournalEntryOutputs {
 			switch e.Step.Op() {
-			case deploy.OpCreate, deploy.OpCreateReplacement, deploy.OpRead, deploy.OpReadReplacement, deploy.OpUpdate:
+			case deploy.OpCreate, deploy.OpCreateReplacement, deploy.OpRead, deploy.OpReadReplacement, deploy.OpUpdate,
+				deploy.OpImport:
 				doneOps[e.Step.New()] = true
 			case deploy.OpDelete, deploy.OpDeleteReplaced:
 				doneOps[e.Step.Old()] = true
@@ -155,7 +159,7 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 			dones[e.Step.Old()] = true
 		case deploy.OpReplace:
 			// do nothing.
-		case deploy.OpRead, deploy.OpReadReplacement:
+		case deploy.OpRead, deploy.OpReadReplacement, deploy.OpImport:
 			resources = append(resources, e.Step.New())
 			if e.Step.Old() != nil {
 				dones[e.Step.Old()] = true
@@ -183,7 +187,7 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 
 	manifest := deploy.Manifest{}
 	manifest.Magic = manifest.NewMagic()
-	return deploy.NewSnapshot(manifest, resources, operations)
+	return deploy.NewSnapshot(manifest, resources, operations).NormalizeURNReferences()
 }
 
 func newJournal() *Journal {
@@ -229,11 +233,12 @@ type ValidateFunc func(project workspace.Project, target deploy.Target, j *Journ
 func (op TestOp) Run(project workspace.Project, target deploy.Target, opts UpdateOptions,
 	dryRun bool, validate ValidateFunc) (*deploy.Snapshot, error) {
 
-	return op.RunWithContext(context.Background(), project, target, opts, dryRun, validate)
+	return op.RunWithContext(context.Background(), project, target, opts, dryRun, nil, validate)
 }
 
 func (op TestOp) RunWithContext(callerCtx context.Context, project workspace.Project, target deploy.Target,
-	opts UpdateOptions, dryRun bool, validate ValidateFunc) (*deploy.Snapshot, error) {
+	opts UpdateOptions, dryRun bool, backendClient deploy.BackendClient,
+	validate ValidateFunc) (*deploy.Snapshot, error) {
 
 	// Create an appropriate update info and context.
 	info := &updateInfo{project: project, target: target}
@@ -256,6 +261,7 @@ func (op TestOp) RunWithContext(callerCtx context.Context, project workspace.Pro
 		Cancel:          cancelCtx,
 		Events:          events,
 		SnapshotManager: journal,
+		BackendClient:   backendClient,
 	}
 
 	// Begin draining events.
@@ -300,6 +306,8 @@ type TestPlan struct {
 	Decrypter config.Decrypter
 	Options   UpdateOptions
 	Steps     []TestStep
+
+	BackendClient deploy.BackendClient
 }
 
 func (p *TestPlan) getNames() (stack tokens.QName, project tokens.PackageName, runtime string) {
@@ -366,7 +374,8 @@ func (p *TestPlan) Run(t *testing.T, snapshot *deploy.Snapshot) *deploy.Snapshot
 		if !step.SkipPreview {
 			previewSnap := CloneSnapshot(t, snap)
 			previewTarget := p.GetTarget(previewSnap)
-			_, err := step.Op.Run(project, previewTarget, p.Options, true, step.Validate)
+			_, err := step.Op.RunWithContext(
+				context.Background(), project, previewTarget, p.Options, true, p.BackendClient, step.Validate)
 			if step.ExpectFailure {
 				assert.Error(t, err)
 				continue
@@ -377,7 +386,8 @@ func (p *TestPlan) Run(t *testing.T, snapshot *deploy.Snapshot) *deploy.Snapshot
 
 		var err error
 		target := p.GetTarget(snap)
-		snap, err = step.Op.Run(project, target, p.Options, false, step.Validate)
+		snap, err = step.Op.RunWithContext(
+			context.Background(), project, target, p.Options, false, p.BackendClient, step.Validate)
 		if step.ExpectFailure {
 			assert.Error(t, err)
 			continue
@@ -1109,6 +1119,76 @@ func TestParallelRefresh(t *testing.T) {
 	assert.Equal(t, string(snap.Resources[4].URN.Name()), "resD")
 }
 
+// Tests that each delete begins as soon as the deletes of its dependents have finished, without waiting for unrelated
+// deletes, and never before its dependents have been deleted.
+func TestParallelDeletes(t *testing.T) {
+	var lock sync.Mutex
+	var deleted []string
+	deletedA, slowStalled := make(chan bool), false
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					if urn.Name() == "resSlow" {
+						// The slow delete is independent of resA and resB, so resA must be deleted while it is
+						// still running.
+						select {
+						case <-deletedA:
+						case <-time.After(10 * time.Second):
+							lock.Lock()
+							slowStalled = true
+							lock.Unlock()
+						}
+					}
+
+					lock.Lock()
+					deleted = append(deleted, string(urn.Name()))
+					lock.Unlock()
+					if urn.Name() == "resA" {
+						close(deletedA)
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		resA, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "",
+			resource.PropertyMap{})
+		assert.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, "", false, []resource.URN{resA}, "",
+			resource.PropertyMap{})
+		assert.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resSlow", true, "", false, nil, "",
+			resource.PropertyMap{})
+		assert.NoError(t, err)
+
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Parallel: 4, host: host},
+	}
+
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 4)
+
+	p.Steps = []TestStep{{Op: Destroy, SkipPreview: true}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 0)
+
+	assert.False(t, slowStalled)
+	assert.Equal(t, []string{"resB", "resA", "resSlow"}, deleted)
+}
+
 func TestExternalRefresh(t *testing.T) {
 	loaders := []*deploytest.ProviderLoader{
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
@@ -1738,7 +1818,7 @@ func TestCanceledRefresh(t *testing.T) {
 		return err
 	}
 
-	snap, err := op.RunWithContext(ctx, project, target, options, false, validate)
+	snap, err := op.RunWithContext(ctx, project, target, options, false, nil, validate)
 	assert.Error(t, err)
 
 	t.Logf("%v/%v resources refreshed", len(refreshed), len(oldResources))
@@ -1932,8 +2012,8 @@ func TestProviderCancellation(t *testing.T) {
 	loaders := []*deploytest.ProviderLoader{
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
-				CreateF: func(urn resource.URN,
-					inputs resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
 
 					// Inform the waiter that we've entered a provider op and wait for cancellation.
 					ops.Done()
@@ -1977,9 +2057,1136 @@ func TestProviderCancellation(t *testing.T) {
 	}
 	project, target := p.GetProject(), p.GetTarget(nil)
 
-	_, err := op.RunWithContext(ctx, project, target, options, false, nil)
+	_, err := op.RunWithContext(ctx, project, target, options, false, nil, nil)
 	assert.Error(t, err)
 
 	// Wait for the program to finish.
 	<-done
 }
+
+// Tests that updates and destroys that specify a set of targets only touch the targeted resources.
+func TestUpdateTargets(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	value, createD := "foo", false
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.PropertyMap{"value": resource.NewStringProperty(value)}
+
+		urnA, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, "", false, []resource.URN{urnA}, "",
+			inputs)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resC", true, "", false, nil, "", inputs)
+		assert.NoError(t, err)
+		if createD {
+			_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resD", true, "", false, nil, "", inputs)
+		}
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+	urnB := p.NewURN("pkgA:m:typA", "resB", "")
+	urnC := p.NewURN("pkgA:m:typA", "resC", "")
+
+	// Create the resources.
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 4)
+
+	// Change every resource's inputs, but only target resA. Only resA should be updated.
+	value = "bar"
+	p.Options.UpdateTargets = []resource.URN{urnA}
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				if entry.Step.URN() == urnA {
+					assert.Equal(t, deploy.OpUpdate, entry.Step.Op())
+				} else {
+					assert.Equal(t, deploy.OpSame, entry.Step.Op())
+				}
+			}
+			return err
+		},
+	}}
+	snap = p.Run(t, snap)
+	for _, r := range snap.Resources {
+		switch r.URN {
+		case urnA:
+			assert.Equal(t, "bar", r.Inputs["value"].StringValue())
+		case urnB, urnC:
+			assert.Equal(t, "foo", r.Inputs["value"].StringValue())
+		}
+	}
+
+	// Untargeted resources must not be created.
+	createD = true
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 4)
+
+	// Destroying resA alone would leave resB with a dangling dependency, so it must fail.
+	p.Steps = []TestStep{{Op: Destroy, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 4)
+
+	// Destroying resA and its dependents should delete both resA and resB but leave resC and the provider alone.
+	p.Options.TargetDependents = true
+	p.Steps = []TestStep{{
+		Op: Destroy,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				assert.Equal(t, deploy.OpDelete, entry.Step.Op())
+				urn := entry.Step.URN()
+				assert.True(t, urn == urnA || urn == urnB)
+			}
+			return err
+		},
+	}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 2)
+	for _, r := range snap.Resources {
+		assert.NotEqual(t, urnA, r.URN)
+		assert.NotEqual(t, urnB, r.URN)
+	}
+}
+
+// Tests that a targeted update may create the default providers its targets need, and that a targeted destroy treats
+// the children of a target as its dependents.
+func TestUpdateTargetsChildren(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urnA, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "",
+			resource.PropertyMap{})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, urnA, false, nil, "",
+			resource.PropertyMap{})
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+	urnB := p.NewURN("pkgA:m:typA", "resB", urnA)
+
+	// The first update targets both resources, so it must also create their default provider.
+	p.Options.UpdateTargets = []resource.URN{urnA, urnB}
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 3)
+
+	// Destroying resA alone would orphan its child resB, so it must fail.
+	p.Options.UpdateTargets = []resource.URN{urnA}
+	p.Steps = []TestStep{{Op: Destroy, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 3)
+
+	// Destroying resA and its dependents should delete its child as well.
+	p.Options.TargetDependents = true
+	p.Steps = []TestStep{{Op: Destroy}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 1)
+	assert.True(t, providers.IsProviderType(snap.Resources[0].Type))
+}
+
+// Tests that changes to properties listed in a resource's ignoreChanges option do not cause updates.
+func TestIgnoreChanges(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	a, owner, team := "foo", "alice", "infra"
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+			"a": a,
+			"tags": map[string]interface{}{
+				"owner": owner,
+				"team":  team,
+			},
+		})
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs,
+			deploytest.ResourceOptions{IgnoreChanges: []string{"a", "tags.owner"}})
+		assert.NoError(t, err)
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	validateOp := func(op deploy.StepOp) ValidateFunc {
+		return func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				if entry.Step.URN() == urnA {
+					assert.Equal(t, op, entry.Step.Op())
+				}
+			}
+			return err
+		}
+	}
+	validateInputs := func(snap *deploy.Snapshot, a, owner, team string) {
+		for _, r := range snap.Resources {
+			if r.URN == urnA {
+				assert.Equal(t, a, r.Inputs["a"].StringValue())
+				tags := r.Inputs["tags"].ObjectValue()
+				assert.Equal(t, owner, tags["owner"].StringValue())
+				assert.Equal(t, team, tags["team"].StringValue())
+			}
+		}
+	}
+
+	// Create the resource.
+	p.Steps = []TestStep{{Op: Update, Validate: validateOp(deploy.OpCreate)}}
+	snap := p.Run(t, nil)
+	validateInputs(snap, "foo", "alice", "infra")
+
+	// Changing only ignored properties should not update the resource.
+	a, owner = "bar", "bob"
+	p.Steps = []TestStep{{Op: Update, Validate: validateOp(deploy.OpSame)}}
+	snap = p.Run(t, snap)
+	validateInputs(snap, "foo", "alice", "infra")
+
+	// Changing a property that is not ignored should update the resource, but retain the ignored values.
+	team = "platform"
+	p.Steps = []TestStep{{Op: Update, Validate: validateOp(deploy.OpUpdate)}}
+	snap = p.Run(t, snap)
+	validateInputs(snap, "foo", "alice", "platform")
+}
+
+// Tests that a renamed resource with an alias for its old URN is updated in place rather than replaced, and that
+// references to its old URN are rewritten in the resulting snapshot.
+func TestAliases(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	nameA, value := "resA", "foo"
+	var aliases []resource.URN
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.PropertyMap{"value": resource.NewStringProperty(value)}
+
+		urnA, _, _, err := monitor.RegisterResource("pkgA:m:typA", nameA, true, "", false, nil, "", inputs,
+			deploytest.ResourceOptions{Aliases: aliases})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, "", false, []resource.URN{urnA}, "",
+			inputs)
+		assert.NoError(t, err)
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+	urnA2 := p.NewURN("pkgA:m:typA", "resA2", "")
+	urnB := p.NewURN("pkgA:m:typA", "resB", "")
+
+	validateSnap := func(snap *deploy.Snapshot, urn resource.URN) {
+		assert.Len(t, snap.Resources, 3)
+		for _, r := range snap.Resources {
+			switch r.URN {
+			case urnA, urnA2:
+				assert.Equal(t, urn, r.URN)
+			case urnB:
+				assert.Equal(t, []resource.URN{urn}, r.Dependencies)
+			}
+		}
+	}
+
+	// Create the resources.
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	validateSnap(snap, urnA)
+
+	// Rename resA and change its inputs, only targeting resA. resA should be updated in place under its new URN, and
+	// the untargeted resB should have its dependency on resA's old URN rewritten.
+	nameA, value = "resA2", "bar"
+	aliases = []resource.URN{urnA}
+	p.Options.UpdateTargets = []resource.URN{urnA2}
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				switch entry.Step.URN() {
+				case urnA2:
+					assert.Equal(t, deploy.OpUpdate, entry.Step.Op())
+				default:
+					assert.Equal(t, deploy.OpSame, entry.Step.Op())
+				}
+			}
+			return err
+		},
+	}}
+	snap = p.Run(t, snap)
+	validateSnap(snap, urnA2)
+
+	// Re-running the program without any further changes should be a no-op, even once the alias is removed.
+	aliases = nil
+	p.Options.UpdateTargets = nil
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				if entry.Step.URN() == urnA2 || entry.Step.URN() == urnB {
+					assert.Equal(t, deploy.OpSame, entry.Step.Op())
+				}
+			}
+			return err
+		},
+	}}
+	snap = p.Run(t, snap)
+	validateSnap(snap, urnA2)
+}
+
+// Tests that a resource with an import ID is adopted rather than created, and that the import fails if the program's
+// inputs do not match the existing resource.
+func TestImport(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap) (plugin.DiffResult, error) {
+
+					if olds["foo"].DeepEquals(news["foo"]) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					props resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+
+					if id != "imported-id" {
+						return nil, resource.StatusOK, nil
+					}
+					return resource.PropertyMap{
+						"foo": resource.NewStringProperty("bar"),
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	value, importID := "bar", resource.ID("imported-id")
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.PropertyMap{"foo": resource.NewStringProperty(value)}
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs,
+			deploytest.ResourceOptions{ImportID: importID})
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	validateOp := func(op deploy.StepOp) ValidateFunc {
+		return func(project workspace.Project, target deploy.Target, j *Journal, _ []Event, err error) error {
+			for _, entry := range j.Entries {
+				if entry.Step.URN() == urnA {
+					assert.Equal(t, op, entry.Step.Op())
+				}
+			}
+			return err
+		}
+	}
+
+	// Import the resource. It should be recorded as a managed resource with the imported ID and state.
+	p.Steps = []TestStep{{Op: Update, Validate: validateOp(deploy.OpImport)}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 2)
+	for _, r := range snap.Resources {
+		if r.URN == urnA {
+			assert.Equal(t, importID, r.ID)
+			assert.False(t, r.External)
+			assert.Equal(t, "bar", r.Outputs["foo"].StringValue())
+		}
+	}
+
+	// Once the resource has been imported, the import ID is ignored.
+	p.Steps = []TestStep{{Op: Update, Validate: validateOp(deploy.OpSame)}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 2)
+
+	// Importing a resource whose state does not match the program's inputs must fail.
+	value = "baz"
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	p.Run(t, nil)
+
+	// Importing a resource that does not exist must fail.
+	value, importID = "bar", "missing-id"
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	p.Run(t, nil)
+}
+
+// Tests that custom timeouts are passed to providers, persisted in the snapshot, and enforced by the engine.
+func TestCustomTimeouts(t *testing.T) {
+	release, canceled := make(chan bool), false
+	defer close(release)
+
+	var createTimeout, deleteTimeout float64
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					createTimeout = timeout
+					if urn.Name() == "resB" {
+						// Simulate a create that hangs.
+						<-release
+						return "", nil, resource.StatusUnknown, errors.New("released")
+					}
+					if urn.Name() == "resC" {
+						// Simulate a create that succeeds shortly after its deadline.
+						time.Sleep(1500 * time.Millisecond)
+					}
+					return resource.ID(urn.Name()), resource.PropertyMap{}, resource.StatusOK, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					if urn.Name() == "resA" {
+						deleteTimeout = timeout
+					}
+					return resource.StatusOK, nil
+				},
+				CancelF: func() error {
+					canceled = true
+					return nil
+				},
+			}, nil
+		}),
+	}
+
+	createB, createC := false, false
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "",
+			resource.PropertyMap{}, deploytest.ResourceOptions{
+				CustomTimeouts: resource.CustomTimeouts{Create: 600, Delete: 300},
+			})
+		if err != nil {
+			return err
+		}
+		for name, create := range map[string]bool{"resB": createB, "resC": createC} {
+			if !create {
+				continue
+			}
+			_, _, _, err = monitor.RegisterResource("pkgA:m:typA", name, true, "", false, nil, "",
+				resource.PropertyMap{}, deploytest.ResourceOptions{
+					CustomTimeouts: resource.CustomTimeouts{Create: 0.1},
+				})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	// Create resA. The provider should receive its create timeout, and its timeouts should be recorded.
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Equal(t, float64(600), createTimeout)
+	for _, r := range snap.Resources {
+		if r.URN == urnA {
+			assert.Equal(t, resource.CustomTimeouts{Create: 600, Delete: 300}, r.CustomTimeouts)
+		}
+	}
+
+	// A create that takes longer than its timeout must fail rather than hang, without canceling the provider's other
+	// operations.
+	createB = true
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	createB = false
+	assert.Len(t, snap.Resources, 2)
+	assert.False(t, canceled)
+
+	// A create that completes after its timeout must still fail, but the resource it created must be recorded.
+	createC = true
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	createC = false
+	assert.Len(t, snap.Resources, 3)
+
+	// Destroying resA should pass the provider the delete timeout recorded in the snapshot.
+	p.Steps = []TestStep{{Op: Destroy}}
+	p.Run(t, snap)
+	assert.Equal(t, float64(300), deleteTimeout)
+}
+
+// Tests that a detailed diff supplied by a provider is attached to step events and used to render the diff.
+func TestDetailedDiff(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap) (plugin.DiffResult, error) {
+
+					// Report a change to a nested property only, even though the provider sees the whole object.
+					return plugin.DiffResult{
+						Changes: plugin.DiffSome,
+						DetailedDiff: map[string]plugin.PropertyDiff{
+							"foo.bar": {Kind: plugin.DiffUpdate, InputDiff: true},
+						},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": "baz", "qux": "zed"},
+	})
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs)
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	inputs = resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": "baz2", "qux": "zed"},
+	})
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			events []Event, err error) error {
+
+			found := false
+			for _, e := range events {
+				if e.Type != ResourcePreEvent {
+					continue
+				}
+				metadata := e.Payload.(ResourcePreEventPayload).Metadata
+				if metadata.URN != urnA {
+					continue
+				}
+				found = true
+
+				assert.Equal(t, deploy.OpUpdate, metadata.Op)
+				assert.Equal(t, map[string]plugin.PropertyDiff{
+					"foo.bar": {Kind: plugin.DiffUpdate, InputDiff: true},
+				}, metadata.DetailedDiff)
+
+				diff := TranslateDetailedDiff(metadata)
+				assert.NotNil(t, diff)
+				assert.Len(t, diff.Adds, 0)
+				assert.Len(t, diff.Deletes, 0)
+				assert.Len(t, diff.Updates, 1)
+
+				foo, ok := diff.Updates["foo"]
+				assert.True(t, ok)
+				assert.NotNil(t, foo.Object)
+				assert.Equal(t, resource.NewStringProperty("zed"), foo.Object.Sames["qux"])
+				assert.Equal(t, resource.ValueDiff{
+					Old: resource.NewStringProperty("baz"),
+					New: resource.NewStringProperty("baz2"),
+				}, foo.Object.Updates["bar"])
+			}
+			assert.True(t, found)
+			return err
+		},
+	}}
+	p.Run(t, snap)
+}
+
+// Tests that a detailed diff whose entries require a replacement causes a replacement even if the provider does not
+// list any replacement keys, and that those entries are displayed as replacements.
+func TestDetailedDiffReplace(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap) (plugin.DiffResult, error) {
+
+					return plugin.DiffResult{
+						Changes: plugin.DiffSome,
+						DetailedDiff: map[string]plugin.PropertyDiff{
+							"foo.bar": {Kind: plugin.DiffUpdateReplace, InputDiff: true},
+							"baz":     {Kind: plugin.DiffUpdate, InputDiff: true},
+						},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": "baz"},
+		"baz": "qux",
+	})
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs)
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	inputs = resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": "baz2"},
+		"baz": "qux2",
+	})
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			events []Event, err error) error {
+
+			found := false
+			for _, e := range events {
+				if e.Type != ResourcePreEvent {
+					continue
+				}
+				metadata := e.Payload.(ResourcePreEventPayload).Metadata
+				if metadata.URN != urnA || metadata.Op != deploy.OpReplace {
+					continue
+				}
+				found = true
+
+				assert.Equal(t, []resource.PropertyKey{"foo"}, metadata.Keys)
+
+				details := colors.Never.Colorize(GetResourcePropertiesDetails(metadata, 1, true, false, false))
+				assert.Regexp(t, `\+-foo\s*:`, details)
+				assert.Regexp(t, `~ baz\s*:`, details)
+			}
+			assert.True(t, found)
+			return err
+		},
+	}}
+	p.Run(t, snap)
+}
+
+// Tests that stack analyzers see every resource in the stack, and that only mandatory failures fail the update.
+func TestAnalyzeStack(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urnA, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "",
+			resource.PropertyMap{"foo": resource.NewStringProperty("bar")})
+		if err != nil {
+			return err
+		}
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, "", false, []resource.URN{urnA}, "",
+			resource.PropertyMap{})
+		return err
+	})
+
+	var analyzed []plugin.AnalyzerResource
+	severity := plugin.AnalyzeAdvisory
+	analyzer := &deploytest.Analyzer{
+		Info: workspace.PluginInfo{Name: "analyzerA", Kind: workspace.AnalyzerPlugin},
+		AnalyzeStackF: func(resources []plugin.AnalyzerResource) ([]plugin.AnalyzeFailure, error) {
+			analyzed = resources
+			return []plugin.AnalyzeFailure{{Reason: "too many resources", Severity: severity}}, nil
+		},
+	}
+	host := deploytest.NewPluginHostWithAnalyzers(nil, nil, program, []*deploytest.Analyzer{analyzer}, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host, Analyzers: []string{"analyzerA"}}}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+	urnB := p.NewURN("pkgA:m:typA", "resB", "")
+
+	// An advisory failure must not fail the update.
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	byURN := make(map[resource.URN]plugin.AnalyzerResource)
+	for _, r := range analyzed {
+		byURN[r.URN] = r
+	}
+	assert.Contains(t, byURN, urnA)
+	assert.Contains(t, byURN, urnB)
+	assert.Equal(t, tokens.Type("pkgA:m:typA"), byURN[urnA].Type)
+	assert.Equal(t, resource.NewStringProperty("bar"), byURN[urnA].Inputs["foo"])
+	assert.Equal(t, []resource.URN{urnA}, byURN[urnB].Dependencies)
+	assert.NotEqual(t, "", byURN[urnA].Provider)
+
+	// A mandatory failure must fail the preview, which prevents the update from running.
+	severity = plugin.AnalyzeMandatory
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	p.Run(t, snap)
+
+	// Without a preview, stack analysis can only run once the update's changes have been made: the update fails, but
+	// its changes are kept.
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true, ExpectFailure: true}}
+	snap = p.Run(t, nil)
+	if assert.NotNil(t, snap) {
+		assert.Len(t, snap.Resources, 3)
+	}
+}
+
+type testBackendClient struct {
+	getStackOutputs func(ctx context.Context, name string) (resource.PropertyMap, error)
+}
+
+func (c *testBackendClient) GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error) {
+	return c.getStackOutputs(ctx, name)
+}
+
+func TestStackReference(t *testing.T) {
+	outputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	backendClient := &testBackendClient{
+		getStackOutputs: func(ctx context.Context, name string) (resource.PropertyMap, error) {
+			if name != "other" {
+				return nil, errors.Errorf("unknown stack %q", name)
+			}
+			return outputs, nil
+		},
+	}
+
+	stackName := "other"
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, state, err := monitor.ReadResource(deploy.StackReferenceType, "other", resource.ID(stackName), "",
+			resource.PropertyMap{"name": resource.NewStringProperty(stackName)}, "")
+		if err != nil {
+			return err
+		}
+		// Reads are performed during previews as well as updates, so the outputs are always known.
+		assert.Equal(t, resource.NewStringProperty(stackName), state["name"])
+		assert.Equal(t, resource.NewObjectProperty(outputs), state["outputs"])
+		return nil
+	})
+	p := &TestPlan{
+		Options:       UpdateOptions{host: deploytest.NewPluginHost(nil, nil, program)},
+		BackendClient: backendClient,
+		Steps:         []TestStep{{Op: Update}},
+	}
+
+	// The reference is served by the builtin provider, and records the referenced stack's outputs.
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 2)
+	assert.Equal(t, providers.MakeProviderType("pulumi"), snap.Resources[0].Type)
+	assert.Equal(t, deploy.StackReferenceType, snap.Resources[1].Type)
+	assert.Equal(t, resource.ID("other"), snap.Resources[1].ID)
+	assert.True(t, snap.Resources[1].External)
+	assert.Equal(t, resource.NewObjectProperty(outputs), snap.Resources[1].Outputs["outputs"])
+
+	// If the referenced stack's outputs change, the read reports both the old and new outputs so that the change can
+	// be displayed.
+	oldOutputs := outputs
+	outputs = resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, events []Event, err error) error {
+			found := false
+			for _, e := range events {
+				if e.Type != ResourceOutputsEvent {
+					continue
+				}
+				md := e.Payload.(ResourceOutputsEventPayload).Metadata
+				if md.Type == deploy.StackReferenceType {
+					found = true
+					assert.Equal(t, deploy.OpRead, md.Op)
+					assert.Equal(t, resource.NewObjectProperty(oldOutputs), md.Old.Outputs["outputs"])
+					assert.Equal(t, resource.NewObjectProperty(outputs), md.New.Outputs["outputs"])
+				}
+			}
+			assert.True(t, found)
+			return err
+		},
+	}}
+	snap = p.Run(t, snap)
+	assert.Equal(t, resource.NewObjectProperty(outputs), snap.Resources[1].Outputs["outputs"])
+
+	// Referencing a stack that does not exist fails.
+	stackName = "missing"
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	p.Run(t, snap)
+
+	// Without a backend client, stack references cannot be read.
+	stackName = "other"
+	p.BackendClient = nil
+	p.Run(t, snap)
+}
+
+// Tests that a plan that continues after errors skips only the steps that depend on failed steps.
+func TestContinueOnError(t *testing.T) {
+	var failCreate, failDelete tokens.QName
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					if urn.Name() == failCreate {
+						return "", nil, resource.StatusOK, errors.New("create failed")
+					}
+					return resource.ID(urn.Name()), resource.PropertyMap{}, resource.StatusOK, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					if urn.Name() == failDelete {
+						return resource.StatusOK, errors.New("delete failed")
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	p := &TestPlan{}
+	urnA := p.NewURN("pkgA:m:typA", "resA", "")
+	urnB := p.NewURN("pkgA:m:typA", "resB", "")
+
+	// resC depends on resB, and resD is independent of both. If resB fails to create, the registrations of resB and
+	// resC still succeed, but their IDs are unknown.
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "",
+			resource.PropertyMap{})
+		if err != nil {
+			return err
+		}
+		_, idB, _, err := monitor.RegisterResource("pkgA:m:typA", "resB", true, "", false, []resource.URN{urnA}, "",
+			resource.PropertyMap{})
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, failCreate == "resB", idB == "")
+		_, idC, _, err := monitor.RegisterResource("pkgA:m:typA", "resC", true, "", false, []resource.URN{urnB}, "",
+			resource.PropertyMap{})
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, failCreate == "resB", idC == "")
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resD", true, "", false, nil, "",
+			resource.PropertyMap{})
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p.Options = UpdateOptions{host: host, Parallel: 4, ContinueOnError: true}
+
+	names := func(snap *deploy.Snapshot) []string {
+		var result []string
+		for _, r := range snap.Resources {
+			if !providers.IsProviderType(r.Type) {
+				result = append(result, string(r.URN.Name()))
+			}
+		}
+		return result
+	}
+
+	// summary returns the failed and skipped resources listed by the summary event, if any.
+	summary := func(events []Event) ([]resource.URN, []resource.URN) {
+		for _, e := range events {
+			if e.Type == SummaryEvent {
+				payload := e.Payload.(SummaryEventPayload)
+				return payload.Failed, payload.Skipped
+			}
+		}
+		return nil, nil
+	}
+
+	// If resB fails to create, resC is skipped but resD is still created.
+	failCreate = "resB"
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true, ExpectFailure: true,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal, evts []Event, err error) error {
+			failed, skipped := summary(evts)
+			assert.Equal(t, []resource.URN{urnB}, failed)
+			assert.Equal(t, []resource.URN{p.NewURN("pkgA:m:typA", "resC", "")}, skipped)
+			return err
+		},
+	}}
+	snap := p.Run(t, nil)
+	assert.Equal(t, []string{"resA", "resD"}, names(snap))
+
+	failCreate = ""
+	p.Steps = []TestStep{{Op: Update}}
+	snap = p.Run(t, snap)
+	assert.Equal(t, []string{"resA", "resB", "resC", "resD"}, names(snap))
+
+	// If resB fails to delete, resA, on which it depends, is not deleted, but resC and resD are.
+	failDelete = "resB"
+	p.Steps = []TestStep{{Op: Destroy, SkipPreview: true, ExpectFailure: true}}
+	snap = p.Run(t, snap)
+	assert.Equal(t, []string{"resA", "resB"}, names(snap))
+}
+
+// Tests that an update that follows a saved plan fails before changing any resources if it deviates from the plan.
+func TestSavedPlan(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					return resource.ID(urn.Name()), inputs, resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap) (plugin.DiffResult, error) {
+
+					switch {
+					case !olds["foo"].DeepEquals(news["foo"]):
+						return plugin.DiffResult{Changes: plugin.DiffSome, ReplaceKeys: []resource.PropertyKey{"foo"}}, nil
+					case !olds.DeepEquals(news):
+						return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+					default:
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+				},
+				UpdateF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+					timeout float64) (resource.PropertyMap, resource.Status, error) {
+
+					return news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	var inputs resource.PropertyMap
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, "", false, nil, "", inputs)
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	p.Steps = []TestStep{{Op: Update}}
+	inputs = resource.PropertyMap{"foo": resource.NewStringProperty("a"), "bar": resource.NewStringProperty("w")}
+	snap := p.Run(t, nil)
+
+	// Record a plan that updates resA's bar property.
+	plan := &deploy.SavedPlan{}
+	inputs = resource.PropertyMap{"foo": resource.NewStringProperty("a"), "bar": resource.NewStringProperty("x")}
+	opts := UpdateOptions{host: host, RecordPlan: plan}
+	_, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, true, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, plan.Steps, 2) {
+		assert.Equal(t, deploy.OpSame, plan.Steps[0].Op)
+		assert.Equal(t, deploy.OpUpdate, plan.Steps[1].Op)
+		assert.Equal(t, inputs, plan.Steps[1].Inputs)
+	}
+
+	// An unplanned replacement fails during the preview, so the update never runs.
+	p.Options.Plan = plan
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true}}
+	inputs = resource.PropertyMap{"foo": resource.NewStringProperty("b"), "bar": resource.NewStringProperty("x")}
+	p.Run(t, snap)
+
+	// So does an unexpected property value.
+	inputs = resource.PropertyMap{"foo": resource.NewStringProperty("a"), "bar": resource.NewStringProperty("y")}
+	p.Run(t, snap)
+
+	// A plan that depends on values that were unknown when it was saved is rejected before any step is performed.
+	unknownPlan := &deploy.SavedPlan{Steps: append([]deploy.PlannedStep(nil), plan.Steps...)}
+	unknownPlan.Steps[1].Inputs = resource.PropertyMap{
+		"foo": resource.NewStringProperty("a"),
+		"bar": resource.MakeComputed(resource.NewStringProperty("")),
+	}
+	p.Options.Plan = unknownPlan
+	inputs = resource.PropertyMap{"foo": resource.NewStringProperty("a"), "bar": resource.NewStringProperty("x")}
+	_, err = TestOp(Update).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), p.Options, false, nil)
+	assert.Error(t, err)
+
+	// An update that matches the plan succeeds.
+	p.Options.Plan = plan
+	p.Steps = []TestStep{{Op: Update}}
+	snap = p.Run(t, snap)
+	assert.Equal(t, inputs, snap.Resources[1].Outputs)
+}
+
+// Tests that a refresh preview reports the resources whose actual state differs from the checkpoint.
+func TestDriftDetection(t *testing.T) {
+	var actual map[tokens.QName]resource.PropertyMap
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					return resource.ID(urn.Name()), inputs, resource.StatusOK, nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					state resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+
+					if actual == nil {
+						return state, resource.StatusOK, nil
+					}
+					return actual[urn.Name()], resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		for _, name := range []string{"resA", "resB", "resC"} {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, "", false, nil, "",
+				resource.PropertyMap{"foo": resource.NewStringProperty("bar")})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	detect := func() *deploy.DriftReport {
+		report := &deploy.DriftReport{}
+		opts := UpdateOptions{host: host, RecordDrift: report}
+		_, err := TestOp(Refresh).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, true, nil)
+		assert.NoError(t, err)
+		return report
+	}
+
+	// Nothing has changed.
+	assert.False(t, detect().HasDrift())
+
+	// resA is unchanged, resB has been modified, and resC has been deleted.
+	actual = map[tokens.QName]resource.PropertyMap{
+		"resA": {"foo": resource.NewStringProperty("bar")},
+		"resB": {"foo": resource.NewStringProperty("baz")},
+	}
+	report := detect()
+	assert.True(t, report.HasDrift())
+	if assert.Len(t, report.Resources, 2) {
+		assert.Equal(t, p.NewURN("pkgA:m:typA", "resB", ""), report.Resources[0].URN)
+		assert.False(t, report.Resources[0].Deleted)
+		if assert.NotNil(t, report.Resources[0].Diff) {
+			assert.True(t, report.Resources[0].Diff.Updated("foo"))
+		}
+
+		assert.Equal(t, p.NewURN("pkgA:m:typA", "resC", ""), report.Resources[1].URN)
+		assert.True(t, report.Resources[1].Deleted)
+	}
+}
+
+func TestResolvePendingOperations(t *testing.T) {
+	gone := map[resource.ID]bool{}
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					return resource.ID(urn.Name()), inputs, resource.StatusOK, nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					state resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+
+					if gone[id] {
+						return nil, resource.StatusOK, nil
+					}
+					return resource.PropertyMap{"foo": resource.NewStringProperty("bar")}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		for _, name := range []string{"resA", "resB", "resC"} {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, "", false, nil, "",
+				resource.PropertyMap{"foo": resource.NewStringProperty("bar")})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{Options: UpdateOptions{host: host}}
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 4)
+
+	// Simulate an update that was interrupted while replacing resA, deleting resB, updating resC, and creating resD,
+	// resE, and resF. The replacement for resA and resD exist, resB has been deleted, resE's ID is unknown, and resF
+	// was never created.
+	provider := snap.Resources[1].Provider
+	pendingState := func(name string, id resource.ID) *resource.State {
+		return resource.NewState("pkgA:m:typA", p.NewURN("pkgA:m:typA", name, ""), true, false, id,
+			resource.PropertyMap{"foo": resource.NewStringProperty("bar")}, nil, "", false, false, nil, nil,
+			provider, resource.CustomTimeouts{})
+	}
+	gone["resB"], gone["resF"] = true, true
+	snap.PendingOperations = []resource.Operation{
+		resource.NewOperation(pendingState("resA", "resA-new"), resource.OperationTypeCreating),
+		resource.NewOperation(snap.Resources[2], resource.OperationTypeDeleting),
+		resource.NewOperation(pendingState("resC", "resC"), resource.OperationTypeUpdating),
+		resource.NewOperation(pendingState("resD", "resD"), resource.OperationTypeCreating),
+		resource.NewOperation(pendingState("resE", ""), resource.OperationTypeCreating),
+		resource.NewOperation(pendingState("resF", "resF"), resource.OperationTypeCreating),
+	}
+
+	// Neither updates nor ordinary refreshes may proceed.
+	opts := UpdateOptions{host: host}
+	_, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, false, nil)
+	assert.IsType(t, deploy.PlanPendingOperationsError{}, err)
+	_, err = TestOp(Refresh).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, false, nil)
+	assert.IsType(t, deploy.PlanPendingOperationsError{}, err)
+
+	// A refresh that resolves pending operations adopts the replacement for resA and resD, and drops resB, resE, and
+	// resF. The original resA is now pending deletion. resE may exist, so the summary reports it as orphaned.
+	opts.ResolvePending = true
+	_, err = TestOp(Refresh).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, true, nil)
+	assert.NoError(t, err)
+	validate := func(project workspace.Project, target deploy.Target, j *Journal, evts []Event, err error) error {
+		var orphaned []resource.URN
+		for _, e := range evts {
+			if e.Type == SummaryEvent {
+				orphaned = e.Payload.(SummaryEventPayload).Orphaned
+			}
+		}
+		assert.Equal(t, []resource.URN{p.NewURN("pkgA:m:typA", "resE", "")}, orphaned)
+		return err
+	}
+	snap, err = TestOp(Refresh).Run(p.GetProject(), p.GetTarget(CloneSnapshot(t, snap)), opts, false, validate)
+	assert.NoError(t, err)
+	assert.Len(t, snap.PendingOperations, 0)
+
+	type resourceID struct {
+		name   tokens.QName
+		id     resource.ID
+		delete bool
+	}
+	var actual []resourceID
+	for _, res := range snap.Resources[1:] {
+		actual = append(actual, resourceID{res.URN.Name(), res.ID, res.Delete})
+	}
+	assert.Equal(t, []resourceID{
+		{"resA", "resA", true},
+		{"resC", "resC", false},
+		{"resA", "resA-new", false},
+		{"resD", "resD", false},
+	}, actual)
+
+	// The stack can now be updated as usual.
+	gone = map[resource.ID]bool{}
+	_, err = TestOp(Update).Run(p.GetProject(), p.GetTarget(snap), UpdateOptions{host: host}, false, nil)
+	assert.NoError(t, err)
+}
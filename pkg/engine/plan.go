@@ -150,7 +150,9 @@ func plan(ctx *Context, info *planContext, opts planOptions, dryRun bool) (*plan
 	}
 
 	// Generate a plan; this API handles all interesting cases (create, update, delete).
-	plan, err := deploy.NewPlan(plugctx, target, target.Snapshot, source, analyzers, dryRun)
+	resolvePending := opts.ResolvePending && opts.Refresh
+	plan, err := deploy.NewPlan(
+		plugctx, target, target.Snapshot, source, analyzers, dryRun, ctx.BackendClient, resolvePending)
 	if err != nil {
 		return nil, err
 	}
@@ -193,17 +195,26 @@ func (res *planResult) Chdir() (func(), error) {
 // Walk enumerates all steps in the plan, calling out to the provided action at each step.  It returns four things: the
 // resulting Snapshot, no matter whether an error occurs or not; an error, if something went wrong; the step that
 // failed, if the error is non-nil; and finally the state of the resource modified in the failing step.
-func (res *planResult) Walk(cancelCtx *Context, events deploy.Events, preview bool) error {
+func (res *planResult) Walk(cancelCtx *Context, events deploy.Events, failures *deploy.FailureReport,
+	preview bool) error {
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	done := make(chan bool)
 	var err error
 	go func() {
 		opts := deploy.Options{
-			Events:      events,
-			Parallel:    res.Options.Parallel,
-			Refresh:     res.Options.Refresh,
-			RefreshOnly: res.Options.isRefresh,
+			Events:           events,
+			Parallel:         res.Options.Parallel,
+			Refresh:          res.Options.Refresh,
+			RefreshOnly:      res.Options.isRefresh,
+			UpdateTargets:    res.Options.UpdateTargets,
+			TargetDependents: res.Options.TargetDependents,
+			ContinueOnError:  res.Options.ContinueOnError,
+			Plan:             res.Options.Plan,
+			RecordPlan:       res.Options.RecordPlan,
+			RecordDrift:      res.Options.RecordDrift,
+			RecordFailures:   failures,
 		}
 		err = res.Plan.Execute(ctx, opts, preview)
 		close(done)
@@ -239,13 +250,18 @@ func printPlan(ctx *Context, result *planResult, dryRun bool) (ResourceChanges,
 
 	// Walk the plan's steps and and pretty-print them out.
 	actions := newPlanActions(result.Options)
-	if err := result.Walk(ctx, actions, true); err != nil {
+	failures := &deploy.FailureReport{}
+	if err := result.Walk(ctx, actions, failures, true); err != nil {
+		// If the preview continued after errors, summarize the resources that failed or were skipped.
+		if len(failures.Failed) != 0 {
+			result.Options.Events.previewSummaryEvent(ResourceChanges(actions.Ops), failures)
+		}
 		return nil, errors.New("an error occurred while advancing the preview")
 	}
 
 	// Emit an event with a summary of operation counts.
 	changes := ResourceChanges(actions.Ops)
-	result.Options.Events.previewSummaryEvent(changes)
+	result.Options.Events.previewSummaryEvent(changes, failures)
 	return changes, nil
 }
 
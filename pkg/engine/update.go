@@ -44,6 +44,28 @@ type UpdateOptions struct {
 	// true if the plan should refresh before executing.
 	Refresh bool
 
+	// if non-empty, the URNs of the only resources that the plan may change.
+	UpdateTargets []resource.URN
+
+	// true if resources that depend on the update targets may also be changed.
+	TargetDependents bool
+
+	// true if the plan should continue after a step fails, skipping only the steps that depend on the failed step.
+	ContinueOnError bool
+
+	// if non-nil, a saved plan that every step of the update must match.
+	Plan *deploy.SavedPlan
+
+	// if non-nil, a saved plan into which the steps of the update are recorded.
+	RecordPlan *deploy.SavedPlan
+
+	// if non-nil, a report into which any drift observed by a refresh is recorded.
+	RecordDrift *deploy.DriftReport
+
+	// true if a refresh should resolve any operations left pending by a previous update rather than failing. This has
+	// no effect unless Refresh is also true.
+	ResolvePending bool
+
 	// true if we should report events for steps that involve default providers.
 	reportDefaultProviderSteps bool
 
@@ -157,12 +179,13 @@ func update(ctx *Context, info *planContext, opts planOptions, dryRun bool) (Res
 			start := time.Now()
 			actions := newUpdateActions(ctx, info.Update, opts)
 
-			err = result.Walk(ctx, actions, false)
+			failures := &deploy.FailureReport{}
+			err = result.Walk(ctx, actions, failures, false)
 			resourceChanges = ResourceChanges(actions.Ops)
 
-			if len(resourceChanges) != 0 {
+			if len(resourceChanges) != 0 || len(failures.Failed) != 0 || len(failures.Orphaned) != 0 {
 				// Print out the total number of steps performed (and their kinds), the duration, and any summary info.
-				opts.Events.updateSummaryEvent(actions.MaybeCorrupt, time.Since(start), resourceChanges)
+				opts.Events.updateSummaryEvent(actions.MaybeCorrupt, time.Since(start), resourceChanges, failures)
 			}
 		}
 	}
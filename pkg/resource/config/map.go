@@ -47,6 +47,185 @@ func (m Map) HasSecureValue() bool {
 	return false
 }
 
+// GetPath returns the value located by the given path within the value for the given key. The path's elements are
+// strings, which select properties of objects, and ints, which select elements of arrays. If there is no value at the
+// path, GetPath returns false.
+func (m Map) GetPath(k Key, path []interface{}) (Value, bool, error) {
+	v, has := m[k]
+	if !has {
+		return Value{}, false, nil
+	}
+	if len(path) == 0 {
+		return v, true, nil
+	}
+
+	data := v.data()
+	for _, element := range path {
+		switch key := element.(type) {
+		case string:
+			obj, ok := data.(map[string]interface{})
+			if !ok {
+				return Value{}, false, nil
+			}
+			if _, secure := secureLeaf(obj); secure {
+				return Value{}, false, nil
+			}
+			if data, ok = obj[key]; !ok {
+				return Value{}, false, nil
+			}
+		case int:
+			arr, ok := data.([]interface{})
+			if !ok || key < 0 || key >= len(arr) {
+				return Value{}, false, nil
+			}
+			data = arr[key]
+		default:
+			return Value{}, false, errors.Errorf("invalid path element %v", element)
+		}
+	}
+
+	result, err := valueFromData(data)
+	if err != nil {
+		return Value{}, false, err
+	}
+	return result, true, nil
+}
+
+// SetPath sets the value located by the given path within the value for the given key, creating any objects and
+// arrays that are missing along the path. An array element may be appended by using the array's length as its index.
+func (m Map) SetPath(k Key, path []interface{}, v Value) error {
+	if len(path) == 0 {
+		m[k] = v
+		return nil
+	}
+
+	var root interface{}
+	if existing, has := m[k]; has {
+		if !existing.Object() {
+			return errors.Errorf("config value '%s' is not an object or array", k)
+		}
+		root = existing.data()
+	}
+
+	newRoot, err := setPath(root, path, v.data())
+	if err != nil {
+		return errors.Wrapf(err, "setting config value '%s'", k)
+	}
+	newValue, err := valueFromData(newRoot)
+	if err != nil {
+		return err
+	}
+	m[k] = newValue
+	return nil
+}
+
+func setPath(data interface{}, path []interface{}, leaf interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return leaf, nil
+	}
+
+	switch key := path[0].(type) {
+	case string:
+		if data == nil {
+			data = make(map[string]interface{})
+		}
+		obj, ok := data.(map[string]interface{})
+		if _, secure := secureLeaf(obj); !ok || secure {
+			return nil, errors.Errorf("cannot set property '%s' of a value that is not an object", key)
+		}
+		child, err := setPath(obj[key], path[1:], leaf)
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = child
+		return obj, nil
+	case int:
+		if data == nil {
+			data = []interface{}{}
+		}
+		arr, ok := data.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("cannot set index %d of a value that is not an array", key)
+		}
+		if key < 0 || key > len(arr) {
+			return nil, errors.Errorf("array index %d is out of range", key)
+		}
+		if key == len(arr) {
+			arr = append(arr, nil)
+		}
+		child, err := setPath(arr[key], path[1:], leaf)
+		if err != nil {
+			return nil, err
+		}
+		arr[key] = child
+		return arr, nil
+	default:
+		return nil, errors.Errorf("invalid path element %v", path[0])
+	}
+}
+
+// RemovePath removes the value located by the given path within the value for the given key. Removing an array
+// element shifts the elements that follow it. It is not an error to remove a value that does not exist.
+func (m Map) RemovePath(k Key, path []interface{}) error {
+	existing, has := m[k]
+	if !has {
+		return nil
+	}
+	if len(path) == 0 {
+		delete(m, k)
+		return nil
+	}
+
+	newRoot, err := removePath(existing.data(), path)
+	if err != nil {
+		return errors.Wrapf(err, "removing config value '%s'", k)
+	}
+	newValue, err := valueFromData(newRoot)
+	if err != nil {
+		return err
+	}
+	m[k] = newValue
+	return nil
+}
+
+func removePath(data interface{}, path []interface{}) (interface{}, error) {
+	switch key := path[0].(type) {
+	case string:
+		obj, ok := data.(map[string]interface{})
+		if _, secure := secureLeaf(obj); !ok || secure {
+			return data, nil
+		}
+		if len(path) == 1 {
+			delete(obj, key)
+			return obj, nil
+		}
+		if child, has := obj[key]; has {
+			newChild, err := removePath(child, path[1:])
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = newChild
+		}
+		return obj, nil
+	case int:
+		arr, ok := data.([]interface{})
+		if !ok || key < 0 || key >= len(arr) {
+			return data, nil
+		}
+		if len(path) == 1 {
+			return append(arr[:key], arr[key+1:]...), nil
+		}
+		newChild, err := removePath(arr[key], path[1:])
+		if err != nil {
+			return nil, err
+		}
+		arr[key] = newChild
+		return arr, nil
+	default:
+		return nil, errors.Errorf("invalid path element %v", path[0])
+	}
+}
+
 func (m Map) MarshalJSON() ([]byte, error) {
 	rawMap := make(map[string]Value, len(m))
 	for k, v := range m {
@@ -78,3 +78,46 @@ func roundtripMap(m Map, marshal func(v interface{}) ([]byte, error),
 	err = unmarshal(b, &newM)
 	return newM, err
 }
+
+func TestMapPaths(t *testing.T) {
+	k := MustMakeKey("my", "db")
+	m := Map{}
+
+	// Setting a path creates the objects and arrays along it.
+	assert.NoError(t, m.SetPath(k, []interface{}{"hosts", 0}, NewValue("a")))
+	assert.NoError(t, m.SetPath(k, []interface{}{"hosts", 1}, NewValue("b")))
+	assert.NoError(t, m.SetPath(k, []interface{}{"password"}, NewSecureValue("ciphertext")))
+	assert.Equal(t, NewObjectValue(`{"hosts":["a","b"],"password":{"secure":"ciphertext"}}`), m[k])
+	assert.True(t, m.HasSecureValue())
+
+	// Array elements must be set in order.
+	assert.Error(t, m.SetPath(k, []interface{}{"hosts", 3}, NewValue("d")))
+	// Strings have no properties.
+	assert.Error(t, m.SetPath(k, []interface{}{"hosts", 0, "name"}, NewValue("x")))
+
+	v, ok, err := m.GetPath(k, []interface{}{"hosts", 1})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, NewValue("b"), v)
+	v, ok, err = m.GetPath(k, []interface{}{"password"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, NewSecureValue("ciphertext"), v)
+	v, ok, err = m.GetPath(k, []interface{}{"hosts"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, NewObjectValue(`["a","b"]`), v)
+	_, ok, err = m.GetPath(k, []interface{}{"hosts", 2})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// Removing an array element shifts the elements that follow it.
+	assert.NoError(t, m.RemovePath(k, []interface{}{"hosts", 0}))
+	assert.NoError(t, m.RemovePath(k, []interface{}{"password"}))
+	assert.NoError(t, m.RemovePath(k, []interface{}{"missing"}))
+	assert.Equal(t, NewObjectValue(`{"hosts":["b"]}`), m[k])
+	assert.False(t, m.HasSecureValue())
+
+	assert.NoError(t, m.RemovePath(k, nil))
+	assert.Len(t, m, 0)
+}
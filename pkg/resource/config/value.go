@@ -17,12 +17,16 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 )
 
-// Value is a single config value.
+// Value is a single config value. A value is either a string, which may be secure (encrypted), or an object or array,
+// any of whose leaves may be individually secure. Object values are stored as JSON in which each secure leaf is
+// represented as {"secure": "<ciphertext>"}.
 type Value struct {
 	value  string
 	secure bool
+	object bool
 }
 
 func NewSecureValue(v string) Value {
@@ -33,8 +37,23 @@ func NewValue(v string) Value {
 	return Value{value: v, secure: false}
 }
 
+// NewObjectValue returns a new object or array value from its JSON representation, in which secure leaves are
+// represented as {"secure": "<ciphertext>"}.
+func NewObjectValue(v string) Value {
+	var data interface{}
+	if err := json.Unmarshal([]byte(v), &data); err != nil {
+		return Value{value: v, object: true}
+	}
+	if canonical, err := marshalObject(data); err == nil {
+		v = canonical
+	}
+	return Value{value: v, secure: hasSecureLeaf(data), object: true}
+}
+
 // Value fetches the value of this configuration entry, using decrypter to decrypt if necessary.  If the value
-// is a secret and decrypter is nil, or if decryption fails for any reason, a non-nil error is returned.
+// is a secret and decrypter is nil, or if decryption fails for any reason, a non-nil error is returned. The value of
+// an object is its JSON representation, with any secure leaves decrypted; NopDecrypter returns the JSON
+// representation without decrypting the secure leaves.
 func (c Value) Value(decrypter Decrypter) (string, error) {
 	if !c.secure {
 		return c.value, nil
@@ -42,15 +61,172 @@ func (c Value) Value(decrypter Decrypter) (string, error) {
 	if decrypter == nil {
 		return "", errors.New("non-nil decrypter required for secret")
 	}
+	if c.object {
+		if decrypter == NopDecrypter {
+			return c.value, nil
+		}
+		data, err := mapSecureLeaves(c.data(), func(ciphertext string) (interface{}, error) {
+			return decrypter.DecryptValue(ciphertext)
+		})
+		if err != nil {
+			return "", err
+		}
+		return marshalObject(data)
+	}
 
 	return decrypter.DecryptValue(c.value)
 }
 
+// Secure returns true if the value, or any leaf of an object value, is a secret.
 func (c Value) Secure() bool {
 	return c.secure
 }
 
+// Object returns true if the value is an object or array rather than a string.
+func (c Value) Object() bool {
+	return c.object
+}
+
+// Copy returns a copy of the value in which every secret has been decrypted using decrypter and re-encrypted using
+// encrypter. This is used to copy a value from one stack to another.
+func (c Value) Copy(decrypter Decrypter, encrypter Encrypter) (Value, error) {
+	if !c.secure {
+		return c, nil
+	}
+	data, err := mapSecureLeaves(c.data(), func(ciphertext string) (interface{}, error) {
+		plaintext, err := decrypter.DecryptValue(ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		reencrypted, err := encrypter.EncryptValue(plaintext)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"secure": reencrypted}, nil
+	})
+	if err != nil {
+		return Value{}, err
+	}
+	return valueFromData(data)
+}
+
+// data returns the JSON-compatible representation of the value: a string for a plaintext value, {"secure":
+// "<ciphertext>"} for a secure value, and the decoded JSON for an object value.
+func (c Value) data() interface{} {
+	switch {
+	case c.object:
+		var data interface{}
+		err := json.Unmarshal([]byte(c.value), &data)
+		if err != nil {
+			return c.value
+		}
+		return data
+	case c.secure:
+		return map[string]interface{}{"secure": c.value}
+	default:
+		return c.value
+	}
+}
+
+// valueFromData returns the value whose JSON-compatible representation is data.
+func valueFromData(data interface{}) (Value, error) {
+	switch d := data.(type) {
+	case string:
+		return NewValue(d), nil
+	case map[string]interface{}:
+		if ciphertext, ok := secureLeaf(d); ok {
+			return NewSecureValue(ciphertext), nil
+		}
+	case []interface{}:
+	case nil:
+		return Value{}, errors.New("config values may not be null")
+	default:
+		// Other scalars, such as numbers and booleans, are stored as strings.
+		return NewValue(fmt.Sprintf("%v", d)), nil
+	}
+
+	v, err := marshalObject(data)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{value: v, secure: hasSecureLeaf(data), object: true}, nil
+}
+
+// secureLeaf returns the ciphertext of the given object if it represents a secure leaf.
+func secureLeaf(obj map[string]interface{}) (string, bool) {
+	if len(obj) != 1 {
+		return "", false
+	}
+	ciphertext, ok := obj["secure"].(string)
+	return ciphertext, ok
+}
+
+// hasSecureLeaf returns true if any leaf of the given JSON-compatible data is secure.
+func hasSecureLeaf(data interface{}) bool {
+	switch d := data.(type) {
+	case map[string]interface{}:
+		if _, ok := secureLeaf(d); ok {
+			return true
+		}
+		for _, v := range d {
+			if hasSecureLeaf(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range d {
+			if hasSecureLeaf(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mapSecureLeaves returns a copy of the given JSON-compatible data in which each secure leaf has been replaced by the
+// result of calling f on its ciphertext.
+func mapSecureLeaves(data interface{}, f func(ciphertext string) (interface{}, error)) (interface{}, error) {
+	switch d := data.(type) {
+	case map[string]interface{}:
+		if ciphertext, ok := secureLeaf(d); ok {
+			return f(ciphertext)
+		}
+		result := make(map[string]interface{}, len(d))
+		for k, v := range d {
+			mapped, err := mapSecureLeaves(v, f)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = mapped
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(d))
+		for i, v := range d {
+			mapped, err := mapSecureLeaves(v, f)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = mapped
+		}
+		return result, nil
+	default:
+		return data, nil
+	}
+}
+
+func marshalObject(data interface{}) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func (c Value) MarshalJSON() ([]byte, error) {
+	if c.object {
+		return []byte(c.value), nil
+	}
 	if !c.secure {
 		return json.Marshal(c.value)
 	}
@@ -62,27 +238,17 @@ func (c Value) MarshalJSON() ([]byte, error) {
 }
 
 func (c *Value) UnmarshalJSON(b []byte) error {
-	var m map[string]string
-	err := json.Unmarshal(b, &m)
-	if err == nil {
-		if len(m) != 1 {
-			return errors.New("malformed secure data")
-		}
-
-		val, has := m["secure"]
-		if !has {
-			return errors.New("malformed secure data")
-		}
-
-		c.value = val
-		c.secure = true
-		return nil
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
 	}
-
-	return json.Unmarshal(b, &c.value)
+	return c.fromData(data)
 }
 
 func (c Value) MarshalYAML() (interface{}, error) {
+	if c.object {
+		return c.data(), nil
+	}
 	if !c.secure {
 		return c.value, nil
 	}
@@ -94,23 +260,60 @@ func (c Value) MarshalYAML() (interface{}, error) {
 }
 
 func (c *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	var m map[string]string
-	err := unmarshal(&m)
-	if err == nil {
-		if len(m) != 1 {
-			return errors.New("malformed secure data")
-		}
+	// Scalars of any type are read as strings.
+	var s string
+	if err := unmarshal(&s); err == nil {
+		*c = NewValue(s)
+		return nil
+	}
+
+	var data interface{}
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	return c.fromData(normalizeYAML(data))
+}
 
-		val, has := m["secure"]
-		if !has {
-			return errors.New("malformed secure data")
+// fromData sets the value from its JSON-compatible representation, which must be a string, a secure value, or an
+// object or array.
+func (c *Value) fromData(data interface{}) error {
+	switch d := data.(type) {
+	case string:
+	case map[string]interface{}:
+		if _, has := d["secure"]; has {
+			if _, ok := secureLeaf(d); !ok {
+				return errors.New("malformed secure data")
+			}
 		}
+	case []interface{}:
+	default:
+		return errors.New("malformed config value")
+	}
 
-		c.value = val
-		c.secure = true
-		return nil
+	v, err := valueFromData(data)
+	if err != nil {
+		return err
 	}
+	*c = v
+	return nil
+}
 
-	c.secure = false
-	return unmarshal(&c.value)
+// normalizeYAML converts the maps in data, which was decoded from YAML, into JSON-compatible maps with string keys.
+func normalizeYAML(data interface{}) interface{} {
+	switch d := data.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(d))
+		for k, v := range d {
+			result[fmt.Sprintf("%v", k)] = normalizeYAML(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(d))
+		for i, v := range d {
+			result[i] = normalizeYAML(v)
+		}
+		return result
+	default:
+		return data
+	}
 }
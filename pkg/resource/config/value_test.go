@@ -16,6 +16,7 @@ package config
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -89,3 +90,66 @@ func roundtripValue(v Value, marshal func(v interface{}) ([]byte, error),
 	err = unmarshal(b, &newV)
 	return newV, err
 }
+
+// prefixCrypter "encrypts" values by adding a prefix to them.
+type prefixCrypter struct {
+	prefix string
+}
+
+func (c prefixCrypter) EncryptValue(plaintext string) (string, error) {
+	return c.prefix + plaintext, nil
+}
+
+func (c prefixCrypter) DecryptValue(ciphertext string) (string, error) {
+	return strings.TrimPrefix(ciphertext, c.prefix), nil
+}
+
+func TestMarshalObjectValueYAML(t *testing.T) {
+	text := "hosts:\n- a\n- b\npassword:\n  secure: enc:hunter2\nport: 8080\n"
+
+	var v Value
+	assert.NoError(t, yaml.Unmarshal([]byte(text), &v))
+	assert.True(t, v.Object())
+	assert.True(t, v.Secure())
+
+	b, err := yaml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, text, string(b))
+
+	newV, err := roundtripValueJSON(v)
+	assert.NoError(t, err)
+	assert.Equal(t, v, newV)
+
+	// Secret leaves are decrypted individually; NopDecrypter leaves them as they are.
+	s, err := v.Value(prefixCrypter{"enc:"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"hosts":["a","b"],"password":"hunter2","port":8080}`, s)
+	s, err = v.Value(NopDecrypter)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"hosts":["a","b"],"password":{"secure":"enc:hunter2"},"port":8080}`, s)
+	_, err = v.Value(nil)
+	assert.Error(t, err)
+
+	// Objects without secrets are not secure.
+	plain := NewObjectValue(`{"b": 1, "a": [true]}`)
+	assert.False(t, plain.Secure())
+	s, err = plain.Value(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":[true],"b":1}`, s)
+}
+
+func TestCopyValue(t *testing.T) {
+	src, dest := prefixCrypter{"src:"}, prefixCrypter{"dest:"}
+
+	copied, err := NewSecureValue("src:secret").Copy(src, dest)
+	assert.NoError(t, err)
+	assert.Equal(t, NewSecureValue("dest:secret"), copied)
+
+	copied, err = NewValue("plain").Copy(src, dest)
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("plain"), copied)
+
+	copied, err = NewObjectValue(`{"a":{"secure":"src:x"},"b":[{"secure":"src:y"},"z"]}`).Copy(src, dest)
+	assert.NoError(t, err)
+	assert.Equal(t, NewObjectValue(`{"a":{"secure":"dest:x"},"b":[{"secure":"dest:y"},"z"]}`), copied)
+}
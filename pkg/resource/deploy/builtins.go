@@ -0,0 +1,213 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+// BackendClient provides an interface for retrieving information about other stacks.
+type BackendClient interface {
+	// GetStackOutputs returns the outputs of the root resource of the named stack, or an error if the stack cannot be
+	// found. A stack that has never been updated has no outputs.
+	GetStackOutputs(ctx context.Context, name string) (resource.PropertyMap, error)
+}
+
+// StackReferenceType is the type of the built-in resource that reads the outputs of another stack. The stack is
+// identified by its fully-qualified name, which serves as the resource's ID and is recorded in its `name` property;
+// the stack's outputs are recorded in its `outputs` property.
+const StackReferenceType tokens.Type = "pulumi:pulumi:StackReference"
+
+// builtinProvider is the provider for the resource types that are implemented by the engine itself rather than by a
+// plugin. It is served by the provider registry as the provider for the "pulumi" package.
+type builtinProvider struct {
+	context       context.Context
+	backendClient BackendClient
+}
+
+var _ plugin.Provider = (*builtinProvider)(nil)
+
+func newBuiltinProvider(backendClient BackendClient) *builtinProvider {
+	return &builtinProvider{
+		context:       context.Background(),
+		backendClient: backendClient,
+	}
+}
+
+func (p *builtinProvider) Close() error {
+	return nil
+}
+
+func (p *builtinProvider) Pkg() tokens.Package {
+	return "pulumi"
+}
+
+// CheckConfig validates the configuration for this resource provider.
+func (p *builtinProvider) CheckConfig(olds,
+	news resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	return news, nil, nil
+}
+
+// DiffConfig checks what impacts a hypothetical change to this provider's configuration will have on the provider.
+func (p *builtinProvider) DiffConfig(olds, news resource.PropertyMap) (plugin.DiffResult, error) {
+	return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+}
+
+func (p *builtinProvider) Configure(props resource.PropertyMap) error {
+	return nil
+}
+
+// Check validates the inputs of a stack reference, which must consist of the name of the referenced stack.
+func (p *builtinProvider) Check(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	if urn.Type() != StackReferenceType {
+		return nil, nil, errors.Errorf("unrecognized resource type '%v'", urn.Type())
+	}
+
+	var failures []plugin.CheckFailure
+	for k := range news {
+		if k != "name" {
+			failures = append(failures, plugin.CheckFailure{Property: k, Reason: fmt.Sprintf("unknown property \"%v\"", k)})
+		}
+	}
+
+	name, ok := news["name"]
+	switch {
+	case !ok:
+		failures = append(failures, plugin.CheckFailure{Property: "name", Reason: `missing required property "name"`})
+	case name.IsComputed() && allowUnknowns:
+		// The name will be checked once it is known.
+	case !name.IsString() || name.StringValue() == "":
+		failures = append(failures, plugin.CheckFailure{Property: "name", Reason: `property "name" must be a string`})
+	}
+
+	return news, failures, nil
+}
+
+// Diff reports that a stack reference must be replaced if the name of the referenced stack changes.
+func (p *builtinProvider) Diff(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	allowUnknowns bool) (plugin.DiffResult, error) {
+
+	contract.Assert(urn.Type() == StackReferenceType)
+
+	if !olds["name"].DeepEquals(news["name"]) {
+		return plugin.DiffResult{
+			Changes:     plugin.DiffSome,
+			ReplaceKeys: []resource.PropertyKey{"name"},
+		}, nil
+	}
+	return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+}
+
+// Create reads the outputs of the referenced stack. The stack's name is used as the resource's ID.
+func (p *builtinProvider) Create(urn resource.URN, news resource.PropertyMap,
+	timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	contract.Assert(urn.Type() == StackReferenceType)
+
+	state, err := p.readStackReference(news["name"].StringValue())
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+	return resource.ID(news["name"].StringValue()), state, resource.StatusOK, nil
+}
+
+// Update rereads the outputs of the referenced stack.
+func (p *builtinProvider) Update(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	timeout float64) (resource.PropertyMap, resource.Status, error) {
+
+	contract.Assert(urn.Type() == StackReferenceType)
+
+	state, err := p.readStackReference(news["name"].StringValue())
+	if err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	return state, resource.StatusOK, nil
+}
+
+// Delete does nothing: deleting a stack reference has no effect on the referenced stack.
+func (p *builtinProvider) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	contract.Assert(urn.Type() == StackReferenceType)
+	return resource.StatusOK, nil
+}
+
+// Read reads the outputs of the stack whose name is given by the ID.
+func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
+	props resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+
+	contract.Require(urn != "", "urn")
+	contract.Require(id != "", "id")
+
+	if urn.Type() != StackReferenceType {
+		return nil, resource.StatusUnknown, errors.Errorf("unrecognized resource type '%v'", urn.Type())
+	}
+
+	state, err := p.readStackReference(string(id))
+	if err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	return state, resource.StatusOK, nil
+}
+
+func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	return nil, nil, errors.Errorf("unrecognized function name: '%v'", tok)
+}
+
+func (p *builtinProvider) GetPluginInfo() (workspace.PluginInfo, error) {
+	// return an error: this should not be called for the builtin provider
+	return workspace.PluginInfo{}, errors.New("the builtin provider does not report plugin info")
+}
+
+func (p *builtinProvider) GetSchema(version int) ([]byte, error) {
+	// return an error: this should not be called for the builtin provider
+	return nil, errors.New("the builtin provider does not report a schema")
+}
+
+func (p *builtinProvider) SignalCancellation() error {
+	return nil
+}
+
+// readStackReference returns the state of a reference to the named stack.
+func (p *builtinProvider) readStackReference(name string) (resource.PropertyMap, error) {
+	if p.backendClient == nil {
+		return nil, errors.New("no backend client is available to read stack references")
+	}
+
+	outputs, err := p.backendClient.GetStackOutputs(p.context, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading outputs of stack '%s'", name)
+	}
+	if outputs == nil {
+		outputs = resource.PropertyMap{}
+	}
+
+	return resource.PropertyMap{
+		"name":    resource.NewStringProperty(name),
+		"outputs": resource.NewObjectProperty(outputs),
+	}, nil
+}
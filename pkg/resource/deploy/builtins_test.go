@@ -0,0 +1,56 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+func TestBuiltinStackReferenceCheckDiff(t *testing.T) {
+	p := newBuiltinProvider(nil)
+	urn := resource.NewURN("dev", "proj", "", StackReferenceType, "ref")
+
+	_, failures, err := p.Check(urn, nil, resource.PropertyMap{"name": resource.NewStringProperty("other")}, false)
+	assert.NoError(t, err)
+	assert.Empty(t, failures)
+
+	// Unknown names are permitted during previews.
+	computed := resource.MakeComputed(resource.NewStringProperty(""))
+	_, failures, err = p.Check(urn, nil, resource.PropertyMap{"name": computed}, true)
+	assert.NoError(t, err)
+	assert.Empty(t, failures)
+
+	bad := resource.PropertyMap{"name": resource.NewNumberProperty(42), "x": computed}
+	_, failures, err = p.Check(urn, nil, bad, false)
+	assert.NoError(t, err)
+	assert.Len(t, failures, 2)
+
+	_, _, err = p.Check(resource.NewURN("dev", "proj", "", "pulumi:pulumi:Bogus", "b"), nil, nil, false)
+	assert.Error(t, err)
+
+	// Changing the referenced stack requires replacement.
+	diff, err := p.Diff(urn, "other", resource.PropertyMap{"name": resource.NewStringProperty("other")},
+		resource.PropertyMap{"name": resource.NewStringProperty("another")}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.PropertyKey{"name"}, diff.ReplaceKeys)
+
+	// Without a backend client, reads fail.
+	_, _, err = p.Read(urn, "other", nil)
+	assert.Error(t, err)
+}
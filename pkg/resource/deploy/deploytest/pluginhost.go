@@ -45,6 +45,7 @@ func NewProviderLoader(pkg tokens.Package, version semver.Version, load LoadProv
 
 type pluginHost struct {
 	providerLoaders []*ProviderLoader
+	analyzers       []*Analyzer
 	languageRuntime plugin.LanguageRuntime
 	sink            diag.Sink
 	statusSink      diag.Sink
@@ -56,8 +57,15 @@ type pluginHost struct {
 func NewPluginHost(sink, statusSink diag.Sink, languageRuntime plugin.LanguageRuntime,
 	providerLoaders ...*ProviderLoader) plugin.Host {
 
+	return NewPluginHostWithAnalyzers(sink, statusSink, languageRuntime, nil, providerLoaders...)
+}
+
+func NewPluginHostWithAnalyzers(sink, statusSink diag.Sink, languageRuntime plugin.LanguageRuntime,
+	analyzers []*Analyzer, providerLoaders ...*ProviderLoader) plugin.Host {
+
 	return &pluginHost{
 		providerLoaders: providerLoaders,
+		analyzers:       analyzers,
 		languageRuntime: languageRuntime,
 		sink:            sink,
 		statusSink:      statusSink,
@@ -124,6 +132,11 @@ func (host *pluginHost) LogStatus(sev diag.Severity, urn resource.URN, msg strin
 	host.statusSink.Logf(sev, diag.StreamMessage(urn, msg, streamID))
 }
 func (host *pluginHost) Analyzer(nm tokens.QName) (plugin.Analyzer, error) {
+	for _, a := range host.analyzers {
+		if a.Name() == nm {
+			return a, nil
+		}
+	}
 	return nil, errors.New("unsupported")
 }
 func (host *pluginHost) CloseProvider(provider plugin.Provider) error {
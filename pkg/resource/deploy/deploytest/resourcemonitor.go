@@ -16,6 +16,7 @@ package deploytest
 
 import (
 	"context"
+	"time"
 
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/plugin"
@@ -27,9 +28,38 @@ type ResourceMonitor struct {
 	resmon pulumirpc.ResourceMonitorClient
 }
 
+// ResourceOptions contains optional settings for a resource registration.
+type ResourceOptions struct {
+	IgnoreChanges  []string                // a list of property paths whose changes should be ignored.
+	Aliases        []resource.URN          // additional URNs that refer to prior incarnations of the resource.
+	ImportID       resource.ID             // the ID of an existing resource to import, if any.
+	CustomTimeouts resource.CustomTimeouts // custom timeouts for the resource's provisioning operations.
+}
+
 func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom bool, parent resource.URN, protect bool,
-	dependencies []resource.URN, provider string,
-	inputs resource.PropertyMap) (resource.URN, resource.ID, resource.PropertyMap, error) {
+	dependencies []resource.URN, provider string, inputs resource.PropertyMap,
+	opts ...ResourceOptions) (resource.URN, resource.ID, resource.PropertyMap, error) {
+
+	// merge options
+	var ignoreChanges, aliases []string
+	var importID resource.ID
+	var customTimeouts *pulumirpc.RegisterResourceRequest_CustomTimeouts
+	for _, opt := range opts {
+		ignoreChanges = append(ignoreChanges, opt.IgnoreChanges...)
+		for _, a := range opt.Aliases {
+			aliases = append(aliases, string(a))
+		}
+		if opt.ImportID != "" {
+			importID = opt.ImportID
+		}
+		if !opt.CustomTimeouts.IsZero() {
+			customTimeouts = &pulumirpc.RegisterResourceRequest_CustomTimeouts{
+				Create: formatTimeout(opt.CustomTimeouts.Create),
+				Update: formatTimeout(opt.CustomTimeouts.Update),
+				Delete: formatTimeout(opt.CustomTimeouts.Delete),
+			}
+		}
+	}
 
 	// marshal inputs
 	ins, err := plugin.MarshalProperties(inputs, plugin.MarshalOptions{KeepUnknowns: true})
@@ -45,14 +75,18 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 
 	// submit request
 	resp, err := rm.resmon.RegisterResource(context.Background(), &pulumirpc.RegisterResourceRequest{
-		Type:         string(t),
-		Name:         name,
-		Custom:       custom,
-		Parent:       string(parent),
-		Protect:      protect,
-		Dependencies: deps,
-		Provider:     provider,
-		Object:       ins,
+		Type:           string(t),
+		Name:           name,
+		Custom:         custom,
+		Parent:         string(parent),
+		Protect:        protect,
+		Dependencies:   deps,
+		Provider:       provider,
+		Object:         ins,
+		IgnoreChanges:  ignoreChanges,
+		Aliases:        aliases,
+		ImportId:       string(importID),
+		CustomTimeouts: customTimeouts,
 	})
 	if err != nil {
 		return "", "", nil, err
@@ -78,6 +112,7 @@ func (rm *ResourceMonitor) ReadResource(t tokens.Type, name string, id resource.
 
 	// submit request
 	resp, err := rm.resmon.ReadResource(context.Background(), &pulumirpc.ReadResourceRequest{
+		Id:         string(id),
 		Type:       string(t),
 		Name:       name,
 		Parent:     string(parent),
@@ -129,3 +164,11 @@ func (rm *ResourceMonitor) Invoke(tok tokens.ModuleMember,
 
 	return outs, nil, nil
 }
+
+// formatTimeout formats a timeout in seconds as a duration string. A zero timeout is formatted as the empty string.
+func formatTimeout(seconds float64) string {
+	if seconds == 0 {
+		return ""
+	}
+	return time.Duration(seconds * float64(time.Second)).String()
+}
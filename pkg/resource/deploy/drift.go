@@ -0,0 +1,64 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+// DriftReport records the resources whose actual state, as read from their providers by a refresh, differs from the
+// state recorded in the checkpoint.
+type DriftReport struct {
+	Resources []ResourceDrift // the resources that have drifted, in checkpoint order.
+}
+
+// ResourceDrift describes how the actual state of a single resource differs from its state in the checkpoint.
+type ResourceDrift struct {
+	URN     resource.URN         // the URN of the resource.
+	Type    tokens.Type          // the type of the resource.
+	Deleted bool                 // true if the resource no longer exists.
+	Old     resource.PropertyMap // the outputs of the resource recorded in the checkpoint.
+	New     resource.PropertyMap // the actual outputs of the resource, or nil if it no longer exists.
+	Diff    *resource.ObjectDiff // the differences between the old and new outputs, or nil if it no longer exists.
+}
+
+// HasDrift returns true if any resources have drifted.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Resources) > 0
+}
+
+// recordDrift records the drift, if any, observed by the given refresh step, which must have completed successfully.
+func (r *DriftReport) recordDrift(step *RefreshStep) {
+	old := step.Old()
+	switch step.ResultOp() {
+	case OpDelete:
+		r.Resources = append(r.Resources, ResourceDrift{
+			URN:     old.URN,
+			Type:    old.Type,
+			Deleted: true,
+			Old:     old.Outputs,
+		})
+	case OpUpdate:
+		new := step.New()
+		r.Resources = append(r.Resources, ResourceDrift{
+			URN:  old.URN,
+			Type: old.Type,
+			Old:  old.Outputs,
+			New:  new.Outputs,
+			Diff: old.Outputs.Diff(new.Outputs),
+		})
+	}
+}
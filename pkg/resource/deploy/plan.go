@@ -32,10 +32,26 @@ import (
 
 // Options controls the planning and deployment process.
 type Options struct {
-	Events      Events // an optional events callback interface.
-	Parallel    int    // the degree of parallelism for resource operations (<=1 for serial).
-	Refresh     bool   // whether or not to refresh before executing the plan.
-	RefreshOnly bool   // whether or not to exit after refreshing.
+	Events           Events         // an optional events callback interface.
+	Parallel         int            // the degree of parallelism for resource operations (<=1 for serial).
+	Refresh          bool           // whether or not to refresh before executing the plan.
+	RefreshOnly      bool           // whether or not to exit after refreshing.
+	UpdateTargets    []resource.URN // if non-empty, the only resources that may be changed by the plan.
+	TargetDependents bool           // whether or not to also change resources that depend on the update targets.
+	ContinueOnError  bool           // whether or not to continue with steps that do not depend on a failed step.
+	Plan             *SavedPlan     // if non-nil, a saved plan that every step of the plan must match.
+	RecordPlan       *SavedPlan     // if non-nil, a saved plan into which the plan's steps are recorded.
+	RecordDrift      *DriftReport   // if non-nil, a report into which any drift observed by a refresh is recorded.
+	RecordFailures   *FailureReport // if non-nil, a report into which failed, skipped, and orphaned resources go.
+}
+
+// FailureReport records the resources whose steps failed, and the resources whose steps were skipped because they
+// depend on a resource whose step failed, when a plan continues after errors. It also records the resources that may
+// have been created by an interrupted update but that could not be found when resolving its pending operations.
+type FailureReport struct {
+	Failed   []resource.URN // the resources whose steps failed, in order of failure.
+	Skipped  []resource.URN // the resources whose steps were skipped, in the order in which they were skipped.
+	Orphaned []resource.URN // the resources dropped from the stack that must be deleted manually if they exist.
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -79,6 +95,7 @@ type Plan struct {
 	preview   bool                             // true if this plan is to be previewed rather than applied.
 	depGraph  *graph.DependencyGraph           // the dependency graph of the old snapshot
 	providers *providers.Registry              // the provider registry for this plan.
+	pending   []resource.Operation             // the old snapshot's pending operations, to be resolved by a refresh.
 }
 
 // addDefaultProviders adds any necessary default provider definitions and references to the given snapshot. Version
@@ -158,10 +175,16 @@ func addDefaultProviders(target *Target, source Source, prev *Snapshot) error {
 // generated based on analysis of the old and new states.  If a resource exists in new, but not old, for example, it
 // results in a create; if it exists in both, but is different, it results in an update; and so on and so forth.
 //
+// The backend client, if any, is used by the resource types that are built into the engine to read information about
+// other stacks (e.g. the outputs read by a stack reference).
+//
+// If the old snapshot has any pending operations, a PlanPendingOperationsError is returned unless resolvePending is
+// true, in which case the pending operations are resolved when the plan is refreshed.
+//
 // Note that a plan uses internal concurrency and parallelism in various ways, so it must be closed if for some reason
 // a plan isn't carried out to its final conclusion.  This will result in cancelation and reclamation of OS resources.
 func NewPlan(ctx *plugin.Context, target *Target, prev *Snapshot, source Source, analyzers []tokens.QName,
-	preview bool) (*Plan, error) {
+	preview bool, backendClient BackendClient, resolvePending bool) (*Plan, error) {
 
 	contract.Assert(ctx != nil)
 	contract.Assert(target != nil)
@@ -183,9 +206,13 @@ func NewPlan(ctx *plugin.Context, target *Target, prev *Snapshot, source Source,
 	// NOTE: we can and do mutate prev.Resources, olds, and depGraph during execution after performing a refresh. See
 	// planExecutor.refresh for details.
 	olds := make(map[resource.URN]*resource.State)
+	var pending []resource.Operation
 	if prev != nil {
 		if prev.PendingOperations != nil {
-			return nil, PlanPendingOperationsError{prev.PendingOperations}
+			if !resolvePending {
+				return nil, PlanPendingOperationsError{prev.PendingOperations}
+			}
+			pending = prev.PendingOperations
 		}
 		oldResources = prev.Resources
 
@@ -203,10 +230,14 @@ func NewPlan(ctx *plugin.Context, target *Target, prev *Snapshot, source Source,
 		depGraph = graph.NewDependencyGraph(oldResources)
 	}
 
+	// Create a new builtin provider. This provider implements the resource types that are built into the engine, such
+	// as stack references.
+	builtins := newBuiltinProvider(backendClient)
+
 	// Create a new provider registry. Although we really only need to pass in any providers that were present in the
 	// old resource list, the registry itself will filter out other sorts of resources when processing the prior state,
 	// so we just pass all of the old resources.
-	reg, err := providers.NewRegistry(ctx.Host, oldResources, preview)
+	reg, err := providers.NewRegistry(ctx.Host, oldResources, preview, builtins)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +252,7 @@ func NewPlan(ctx *plugin.Context, target *Target, prev *Snapshot, source Source,
 		preview:   preview,
 		depGraph:  depGraph,
 		providers: reg,
+		pending:   pending,
 	}, nil
 }
 
@@ -235,6 +267,30 @@ func (p *Plan) GetProvider(ref providers.Reference) (plugin.Provider, bool) {
 	return p.providers.GetProvider(ref)
 }
 
+// targets computes the set of URNs that this plan may change given the indicated options. If no update targets were
+// specified, this function returns nil, which indicates that every resource is a target. If the options request that
+// dependents be targeted, the set is widened to include every old resource that depends directly or indirectly on an
+// explicit target or is a descendant of one.
+func (p *Plan) targets(opts Options) map[resource.URN]bool {
+	if len(opts.UpdateTargets) == 0 {
+		return nil
+	}
+
+	targets := make(map[resource.URN]bool)
+	for _, urn := range opts.UpdateTargets {
+		targets[urn] = true
+		if !opts.TargetDependents || p.depGraph == nil {
+			continue
+		}
+		if old, has := p.olds[urn]; has {
+			for _, dependent := range p.depGraph.DependingOn(old, true) {
+				targets[dependent.URN] = true
+			}
+		}
+	}
+	return targets
+}
+
 // generateURN generates a resource's URN from its parent, type, and name under the scope of the plan's stack and
 // project.
 func (p *Plan) generateURN(parent resource.URN, ty tokens.Type, name tokens.QName) resource.URN {
@@ -22,6 +22,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/resource/graph"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/util/logging"
 	"github.com/pulumi/pulumi/pkg/util/result"
@@ -72,6 +73,15 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 		}
 	}()
 
+	// A saved plan must be fully known to be followed; otherwise a deviation from it might only be detected after
+	// some of the update's steps had already been performed.
+	if opts.Plan != nil {
+		if err := opts.Plan.CheckKnown(); err != nil {
+			pe.reportError("", err)
+			return execError("failed", preview)
+		}
+	}
+
 	// Before doing anything else, optionally refresh each resource in the base checkpoint.
 	if opts.Refresh {
 		if err := pe.refresh(callerCtx, opts, preview); err != nil {
@@ -101,7 +111,7 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	ctx, cancel := context.WithCancel(callerCtx)
 
 	// Set up a step generator and executor for this plan.
-	pe.stepExec = newStepExecutor(ctx, cancel, pe.plan, opts, preview, false)
+	pe.stepExec = newStepExecutor(ctx, cancel, pe.plan, opts, preview, opts.ContinueOnError)
 
 	// We iterate the source in its own goroutine because iteration is blocking and we want the main loop to be able to
 	// respond to cancellation requests promptly.
@@ -142,19 +152,37 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 
 				if event.Error != nil {
 					pe.reportError("", event.Error)
-					cancel()
+					if opts.ContinueOnError {
+						// Let the steps that have already been issued run to completion. No deletes are issued, as
+						// the program did not run to completion.
+						pe.stepExec.SignalCompletion()
+					} else {
+						cancel()
+					}
 					return false, event.Error
 				}
 
 				if event.Event == nil {
-					// TODO[pulumi/pulumi#1625] Today we lack the ability to parallelize deletions. We have all the
-					// information we need to do so (namely, a dependency graph). `GenerateDeletes` returns a single
-					// chain of every delete that needs to be executed.
-					deletes := pe.stepGen.GenerateDeletes()
-					pe.stepExec.Execute(deletes)
-
-					// Signal completion to the step executor. It'll exit once it's done retiring all of the steps in
-					// the chain that we just gave it.
+					deletes, res := pe.stepGen.GenerateDeletes()
+					if res != nil {
+						if resErr := res.Error(); resErr != nil {
+							logging.V(4).Infof("planExecutor.Execute(...): error generating deletes: %v", resErr)
+							pe.reportError("", resErr)
+						}
+						cancel()
+						return false, result.TODO()
+					}
+
+					// Execute the deletes in dependency order, running independent deletes concurrently. Each delete
+					// begins as soon as the deletes of the resources that depend on it have finished.
+					pe.stepExec.ExecuteDeletes(pe.stepGen.ScheduleDeletes(deletes)).Wait(ctx)
+					if ctx.Err() != nil {
+						logging.V(4).Infof("planExecutor.Execute(...): context finished while deleting: %v", ctx.Err())
+						return callerCtx.Err() != nil, nil
+					}
+
+					// Signal completion to the step executor. It'll exit once it's done retiring all of the steps
+					// that we just gave it.
 					pe.stepExec.SignalCompletion()
 					logging.V(4).Infof("planExecutor.Execute(...): issued deletes")
 
@@ -182,6 +210,16 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	pe.stepExec.WaitForCompletion()
 	logging.V(4).Infof("planExecutor.Execute(...): step executor has completed")
 
+	if opts.ContinueOnError {
+		recordFailures(opts.RecordFailures, pe.stepExec)
+	}
+
+	// If the plan succeeded, give each analyzer -- if any -- a chance to inspect the stack as a whole. Note that this
+	// can only block changes during a preview; see analyzeStack.
+	if err == nil && !pe.stepExec.Errored() && !canceled {
+		err = pe.analyzeStack()
+	}
+
 	// Figure out if execution failed and why. Step generation and execution errors trump cancellation.
 	if err != nil || pe.stepExec.Errored() {
 		err = execError("failed", preview)
@@ -191,6 +229,78 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	return err
 }
 
+// recordFailures records the resources whose steps failed and the resources whose steps were skipped as a result in
+// the given report, if any.
+func recordFailures(report *FailureReport, stepExec *stepExecutor) {
+	if report == nil {
+		return
+	}
+	failed, skipped := stepExec.Failures()
+	report.Failed = append(report.Failed, failed...)
+	report.Skipped = append(report.Skipped, skipped...)
+}
+
+// analyzeStack runs each of the plan's analyzers over all of the resources registered by the plan. Failures are
+// reported as diagnostics; if any mandatory failures are reported, analyzeStack returns an error.
+//
+// Stack analysis needs the complete set of resources, so it can only run once every step has completed. A mandatory
+// failure therefore only prevents changes when it is reported by a preview: the failed preview stops the update that
+// would have followed it. When an update is run without a preview, the failure fails the update, but the update's
+// changes have already been made.
+func (pe *planExecutor) analyzeStack() error {
+	if len(pe.plan.analyzers) == 0 {
+		return nil
+	}
+
+	var resources []plugin.AnalyzerResource
+	for _, state := range pe.stepExec.Registered() {
+		resources = append(resources, plugin.AnalyzerResource{
+			URN:          state.URN,
+			Type:         state.Type,
+			Inputs:       state.Inputs,
+			Outputs:      state.Outputs,
+			Parent:       state.Parent,
+			Dependencies: state.Dependencies,
+			Provider:     state.Provider,
+		})
+	}
+
+	var err error
+	for _, a := range pe.plan.analyzers {
+		analyzer, loadErr := pe.plan.ctx.Host.Analyzer(a)
+		if loadErr != nil {
+			pe.reportError("", loadErr)
+			return loadErr
+		} else if analyzer == nil {
+			loadErr = errors.Errorf("analyzer '%v' could not be loaded from your $PATH", a)
+			pe.reportError("", loadErr)
+			return loadErr
+		}
+
+		logging.V(4).Infof("planExecutor.analyzeStack(...): analyzing %d resources with '%v'", len(resources), a)
+		failures, analyzeErr := analyzer.AnalyzeStack(resources)
+		if analyzeErr != nil {
+			pe.reportError("", analyzeErr)
+			return analyzeErr
+		}
+
+		for _, failure := range failures {
+			// Failures that do not name a resource apply to the stack as a whole.
+			name := string(failure.URN)
+			if name == "" {
+				name = "(stack)"
+			}
+			if failure.Severity == plugin.AnalyzeAdvisory {
+				pe.plan.Diag().Warningf(diag.GetAnalyzeStackFailureError(failure.URN), a, name, failure.Reason)
+				continue
+			}
+			pe.plan.Diag().Errorf(diag.GetAnalyzeStackFailureError(failure.URN), a, name, failure.Reason)
+			err = errors.Errorf("analyzer '%v' reported mandatory stack errors", a)
+		}
+	}
+	return err
+}
+
 // handleSingleEvent handles a single source event. For all incoming events, it produces a chain that needs
 // to be executed and schedules the chain for execution.
 func (pe *planExecutor) handleSingleEvent(event SourceEvent) *result.Result {
@@ -219,13 +329,74 @@ func (pe *planExecutor) handleSingleEvent(event SourceEvent) *result.Result {
 	return nil
 }
 
+// resolvePendingOperations prepares the base snapshot's pending operations, if any, for resolution by a refresh. It
+// returns the set of URNs that must be refreshed in order to determine the true state of their resources and the list
+// of states for pending creates that have been added to the base snapshot: these are adopted if the refresh finds
+// them and dropped otherwise. Pending creates that cannot be resolved are recorded in the given report, if any.
+//
+// Each kind of pending operation is resolved as follows:
+//
+//   - A pending create or import whose ID is known is added to the snapshot and refreshed. A pending create whose
+//     ID is unknown cannot be looked up, so it is dropped with a warning and reported as orphaned.
+//   - A pending update or delete is resolved by refreshing the existing resource, which either still exists (in
+//     which case its state is updated) or does not (in which case it is removed).
+//   - A pending read has no side effects and is simply dropped.
+func (pe *planExecutor) resolvePendingOperations(
+	report *FailureReport) (map[resource.URN]bool, []*resource.State) {
+
+	prev := pe.plan.prev
+	if len(pe.plan.pending) == 0 {
+		return nil, nil
+	}
+
+	pending := make(map[resource.URN]bool)
+	var adopting []*resource.State
+	for _, op := range pe.plan.pending {
+		res := op.Resource
+		switch op.Type {
+		case resource.OperationTypeCreating, resource.OperationTypeImporting:
+			// Components have no physical state, so they can always be adopted as-is.
+			if res.Custom && (res.ID == "" || providers.IsProviderType(res.Type)) {
+				pe.plan.Diag().Warningf(diag.RawMessage(res.URN, "the resource may have been created by a previous "+
+					"update, but it cannot be looked up; it has been removed from the stack and must be deleted "+
+					"manually if it exists"))
+				if report != nil {
+					report.Orphaned = append(report.Orphaned, res.URN)
+				}
+				continue
+			}
+			pe.plan.Ctx().StatusDiag.Infof(diag.RawMessage(res.URN, "resolving pending creation"))
+			prev.Resources = append(prev.Resources, res)
+			adopting, pending[res.URN] = append(adopting, res), true
+		case resource.OperationTypeUpdating:
+			pe.plan.Ctx().StatusDiag.Infof(diag.RawMessage(res.URN, "resolving pending update"))
+			pending[res.URN] = true
+		case resource.OperationTypeDeleting:
+			pe.plan.Ctx().StatusDiag.Infof(diag.RawMessage(res.URN, "resolving pending deletion"))
+			pending[res.URN] = true
+		default:
+			logging.V(7).Infof("planExecutor.resolvePendingOperations(...): dropping pending %s of %s", op.Type, res.URN)
+		}
+	}
+
+	// The pending operations have been folded into the base snapshot and no longer need to be tracked separately.
+	pe.plan.pending, prev.PendingOperations = nil, nil
+	return pending, adopting
+}
+
 // retirePendingDeletes deletes all resources that are pending deletion. Run before the start of a plan, this pass
 // ensures that the engine never sees any resources that are pending deletion from a previous plan.
 //
 // retirePendingDeletes re-uses the plan executor's step generator but uses its own step executor.
 func (pe *planExecutor) retirePendingDeletes(callerCtx context.Context, opts Options, preview bool) error {
 	contract.Require(pe.stepGen != nil, "pe.stepGen != nil")
-	steps := pe.stepGen.GeneratePendingDeletes()
+	steps, res := pe.stepGen.GeneratePendingDeletes()
+	if res != nil {
+		if resErr := res.Error(); resErr != nil {
+			pe.reportError("", resErr)
+		}
+		return execError("failed", preview)
+	}
 	if len(steps) == 0 {
 		logging.V(4).Infoln("planExecutor.retirePendingDeletes(...): no pending deletions")
 		return nil
@@ -234,19 +405,24 @@ func (pe *planExecutor) retirePendingDeletes(callerCtx context.Context, opts Opt
 	logging.V(4).Infof("planExecutor.retirePendingDeletes(...): executing %d steps", len(steps))
 	ctx, cancel := context.WithCancel(callerCtx)
 
-	opts.Parallel = 1 // deletes can't be executed in parallel yet (pulumi/pulumi#1625)
-	stepExec := newStepExecutor(ctx, cancel, pe.plan, opts, preview, false)
-
-	// Submit the deletes for execution and wait for them all to retire.
-	stepExec.Execute(steps)
-	stepExec.SignalCompletion()
+	stepExec := newStepExecutor(ctx, cancel, pe.plan, opts, preview, opts.ContinueOnError)
 
 	// Log an ephemeral diagnostic for each resource we're deleting so it's clear why we are deleting it.
 	for _, step := range steps {
 		pe.plan.Ctx().StatusDiag.Infof(diag.RawMessage(step.URN(), "completing deletion from previous update"))
 	}
 
+	// Submit the deletes for execution in dependency order and wait for them all to retire. If the plan is canceled,
+	// the workers exit on their own; the executor must not be signalled, as chains may still be in the process of
+	// being submitted.
+	stepExec.ExecuteDeletes(pe.stepGen.ScheduleDeletes(steps)).Wait(ctx)
+	if ctx.Err() == nil {
+		stepExec.SignalCompletion()
+	}
 	stepExec.WaitForCompletion()
+	if opts.ContinueOnError {
+		recordFailures(opts.RecordFailures, stepExec)
+	}
 
 	// Like Refresh, we use the presence of an error in the caller's context to detect whether or not we have been
 	// cancelled.
@@ -262,14 +438,25 @@ func (pe *planExecutor) retirePendingDeletes(callerCtx context.Context, opts Opt
 // refresh refreshes the state of the base checkpoint file for the current plan in memory.
 func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview bool) error {
 	prev := pe.plan.prev
-	if prev == nil || len(prev.Resources) == 0 {
+	if prev == nil || (len(prev.Resources) == 0 && len(pe.plan.pending) == 0) {
 		return nil
 	}
 
-	// Create a refresh step for each resource in the old snapshot.
-	steps := make([]Step, len(prev.Resources))
-	for i := range prev.Resources {
-		steps[i] = NewRefreshStep(pe.plan, prev.Resources[i], nil)
+	// Fold any pending operations from a previous update into the base snapshot so that they are resolved by the
+	// refresh below.
+	pending, adopting := pe.resolvePendingOperations(opts.RecordFailures)
+
+	// Create a refresh step for each targeted resource in the old snapshot. Resources that are not targeted are
+	// carried forward as-is. Resources that were the subject of a pending operation are always refreshed.
+	targets := pe.plan.targets(opts)
+	steps := make([]Step, 0, len(prev.Resources))
+	resourceToStep := make(map[*resource.State]Step)
+	for _, res := range prev.Resources {
+		if targets != nil && !targets[res.URN] && !pending[res.URN] {
+			continue
+		}
+		step := NewRefreshStep(pe.plan, res, nil)
+		steps, resourceToStep[res] = append(steps, step), step
 	}
 
 	// Fire up a worker pool and issue each refresh in turn.
@@ -286,6 +473,31 @@ func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview
 	stepExec.SignalCompletion()
 	stepExec.WaitForCompletion()
 
+	// Record any drift before the base snapshot is rebuilt. Drift is only meaningful if every refresh succeeded.
+	if opts.RecordDrift != nil && !stepExec.Errored() && ctx.Err() == nil {
+		for _, step := range steps {
+			opts.RecordDrift.recordDrift(step.(*RefreshStep))
+		}
+	}
+
+	// A pending create that still exists has now been adopted. If it was replacing another resource with the same
+	// URN, that resource must be deleted in its stead.
+	for _, res := range adopting {
+		if s, has := resourceToStep[res]; !has || s.New() == nil {
+			continue
+		}
+		for _, other := range prev.Resources {
+			if other == res || other.URN != res.URN || other.Delete {
+				continue
+			}
+			if s, has := resourceToStep[other]; has && s.New() != nil {
+				s.New().Delete = true
+			} else {
+				other.Delete = true
+			}
+		}
+	}
+
 	// Rebuild this plan's map of old resources and dependency graph, stripping out any deleted resources and repairing
 	// dependency lists as necessary. Note that this updates the base snapshot _in memory_, so it is critical that any
 	// components that use the snapshot refer to the same instance and avoid reading it concurrently with this rebuild.
@@ -314,12 +526,15 @@ func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview
 	resources := make([]*resource.State, 0, len(prev.Resources))
 	referenceable := make(map[resource.URN]bool)
 	olds := make(map[resource.URN]*resource.State)
-	for _, s := range steps {
-		new := s.New()
-		if new == nil {
-			contract.Assert(s.Old().Custom)
-			contract.Assert(!providers.IsProviderType(s.Old().Type))
-			continue
+	for _, res := range prev.Resources {
+		new := res
+		if s, has := resourceToStep[res]; has {
+			new = s.New()
+			if new == nil {
+				contract.Assert(s.Old().Custom)
+				contract.Assert(!providers.IsProviderType(s.Old().Type))
+				continue
+			}
 		}
 
 		// Remove any deleted resources from this resource's dependency list.
@@ -58,25 +58,33 @@ func getProviderVersion(inputs resource.PropertyMap) (*semver.Version, error) {
 // prepared to be used to manage the lifecycle of these providers as well as any new provider resources requested by
 // invoking the registry's CRUD operations.
 //
+// Providers for the "pulumi" package are not loaded from plugins. Instead, they are served by the builtin provider
+// that is handed to the registry when it is created, which implements the resource types that are built into the
+// engine.
+//
 // In order to fit neatly in to the existing infrastructure for managing resources using Pulumi, a provider regidstry
 // itself implements the plugin.Provider interface.
 type Registry struct {
 	host      plugin.Host
 	isPreview bool
 	providers map[Reference]plugin.Provider
+	builtins  plugin.Provider
 	m         sync.RWMutex
 }
 
 var _ plugin.Provider = (*Registry)(nil)
 
-// NewRegistry creates a new provider registry using the given host and old resources. Each provider present in the old
-// resources will be loaded, configured, and added to the returned registry under its reference. If any provider is not
-// loadable/configurable or has an invalid ID, this function returns an error.
-func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool) (*Registry, error) {
+// NewRegistry creates a new provider registry using the given host, old resources, and builtin provider. Each provider
+// present in the old resources will be loaded, configured, and added to the returned registry under its reference. If
+// any provider is not loadable/configurable or has an invalid ID, this function returns an error.
+func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool,
+	builtins plugin.Provider) (*Registry, error) {
+
 	r := &Registry{
 		host:      host,
 		isPreview: isPreview,
 		providers: make(map[Reference]plugin.Provider),
+		builtins:  builtins,
 	}
 
 	for _, res := range prev {
@@ -102,7 +110,7 @@ func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool) (*Reg
 		if err != nil {
 			return nil, errors.Errorf("could not parse version for provider '%v': %v", urn, err)
 		}
-		provider, err := host.Provider(getProviderPackage(urn.Type()), version)
+		provider, err := r.loadProvider(getProviderPackage(urn.Type()), version)
 		if provider == nil {
 			return nil, errors.Errorf("could not find plugin for provider '%v'", urn)
 		}
@@ -110,7 +118,7 @@ func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool) (*Reg
 			return nil, errors.Errorf("could not load plugin for provider '%v': %v", urn, err)
 		}
 		if err := provider.Configure(res.Inputs); err != nil {
-			closeErr := host.CloseProvider(provider)
+			closeErr := r.closeProvider(provider)
 			contract.IgnoreError(closeErr)
 			return nil, errors.Errorf("could not configure provider '%v': %v", urn, err)
 		}
@@ -133,6 +141,22 @@ func (r *Registry) GetProvider(ref Reference) (plugin.Provider, bool) {
 	return provider, ok
 }
 
+// loadProvider loads the provider for the given package, using the builtin provider for the "pulumi" package.
+func (r *Registry) loadProvider(pkg tokens.Package, version *semver.Version) (plugin.Provider, error) {
+	if r.builtins != nil && pkg == r.builtins.Pkg() {
+		return r.builtins, nil
+	}
+	return r.host.Provider(pkg, version)
+}
+
+// closeProvider unloads the given provider, unless it is the builtin provider.
+func (r *Registry) closeProvider(provider plugin.Provider) error {
+	if provider == r.builtins {
+		return nil
+	}
+	return r.host.CloseProvider(provider)
+}
+
 func (r *Registry) setProvider(ref Reference, provider plugin.Provider) {
 	r.m.Lock()
 	defer r.m.Unlock()
@@ -206,7 +230,7 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	if err != nil {
 		return nil, []plugin.CheckFailure{{Property: "version", Reason: err.Error()}}, nil
 	}
-	provider, err := r.host.Provider(getProviderPackage(urn.Type()), version)
+	provider, err := r.loadProvider(getProviderPackage(urn.Type()), version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -217,7 +241,7 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	// Check the provider's config. If the check fails, unload the provider.
 	inputs, failures, err := provider.CheckConfig(olds, news)
 	if len(failures) != 0 || err != nil {
-		closeErr := r.host.CloseProvider(provider)
+		closeErr := r.closeProvider(provider)
 		contract.IgnoreError(closeErr)
 		return nil, failures, err
 	}
@@ -226,7 +250,7 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	// provider when it is created or updated.
 	if r.isPreview {
 		if err := provider.Configure(inputs); err != nil {
-			closeErr := r.host.CloseProvider(provider)
+			closeErr := r.closeProvider(provider)
 			contract.IgnoreError(closeErr)
 			return nil, nil, err
 		}
@@ -263,7 +287,7 @@ func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.Pr
 	// If the diff does not require replacement and we are running a preview, register it under its current ID so that
 	// references to the provider from other resources will resolve properly.
 	if len(diff.ReplaceKeys) != 0 {
-		closeErr := r.host.CloseProvider(provider)
+		closeErr := r.closeProvider(provider)
 		contract.IgnoreError(closeErr)
 	} else if r.isPreview {
 		r.setProvider(mustNewReference(urn, id), provider)
@@ -276,8 +300,8 @@ func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.Pr
 // registers it under the assigned (URN, ID).
 //
 // The provider must have been loaded by a prior call to Check.
-func (r *Registry) Create(urn resource.URN,
-	news resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error) {
+func (r *Registry) Create(urn resource.URN, news resource.PropertyMap,
+	timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
 
 	contract.Assert(!r.isPreview)
 
@@ -303,8 +327,8 @@ func (r *Registry) Create(urn resource.URN,
 // reference indicated by the (URN, ID) pair.
 //
 // THe provider must have been loaded by a prior call to Check.
-func (r *Registry) Update(urn resource.URN, id resource.ID, olds,
-	news resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+func (r *Registry) Update(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	timeout float64) (resource.PropertyMap, resource.Status, error) {
 
 	contract.Assert(!r.isPreview)
 
@@ -326,14 +350,15 @@ func (r *Registry) Update(urn resource.URN, id resource.ID, olds,
 
 // Delete unregisters and unloads the provider with the given URN and ID. The provider must have been loaded when the
 // registry was created (i.e. it must have been present in the state handed to NewRegistry).
-func (r *Registry) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap) (resource.Status, error) {
+func (r *Registry) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
 	contract.Assert(!r.isPreview)
 
 	ref := mustNewReference(urn, id)
 	provider, has := r.deleteProvider(ref)
 	contract.Assert(has)
 
-	closeErr := r.host.CloseProvider(provider)
+	closeErr := r.closeProvider(provider)
 	contract.IgnoreError(closeErr)
 	return resource.StatusOK, nil
 }
@@ -357,6 +382,11 @@ func (r *Registry) GetPluginInfo() (workspace.PluginInfo, error) {
 	return workspace.PluginInfo{}, errors.New("the provider registry does not report plugin info")
 }
 
+func (r *Registry) GetSchema(version int) ([]byte, error) {
+	// return an error: this should not be called for the provider registry
+	return nil, errors.New("the provider registry does not report a schema")
+}
+
 func (r *Registry) SignalCancellation() error {
 	// At the moment there isn't anything reasonable we can do here. In the future, it might be nice to plumb
 	// cancellation through the plugin loader and cancel any outstanding load requests here.
@@ -0,0 +1,190 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+// SavedPlan records the steps that a preview expected to perform, so that a later update can verify that it performs
+// exactly those steps and no others.
+type SavedPlan struct {
+	Steps []PlannedStep // the planned steps, in the order in which they were generated.
+}
+
+// PlannedStep describes a single step that a preview expected to perform.
+type PlannedStep struct {
+	Op  StepOp       // the operation that the step performs.
+	URN resource.URN // the URN of the resource on which the step operates.
+	// Inputs are the expected inputs of the resource produced by the step, if any. Values that were unknown at the
+	// time of the preview are computed, and match any value.
+	Inputs resource.PropertyMap
+	// Outputs are the outputs of the existing resource on which the step operates, if any. The step may only be
+	// performed if the resource has not changed since the preview.
+	Outputs resource.PropertyMap
+}
+
+// NewPlannedStep returns a description of the given step suitable for recording in a saved plan.
+func NewPlannedStep(step Step) PlannedStep {
+	planned := PlannedStep{Op: step.Op(), URN: step.URN()}
+	if new := step.New(); new != nil {
+		planned.Inputs = new.Inputs
+	}
+	if old := step.Old(); old != nil {
+		planned.Outputs = old.Outputs
+	}
+	return planned
+}
+
+// CheckKnown returns an error if the expected inputs of any planned step include values that were unknown when the
+// plan was made. Such a step can only be checked once the update has performed the steps on which it depends, so an
+// update that follows the plan could not detect a deviation from it before changing resources.
+func (p *SavedPlan) CheckKnown() error {
+	var unknown []string
+	for _, planned := range p.Steps {
+		if planned.Inputs.ContainsUnknowns() {
+			unknown = append(unknown, fmt.Sprintf("    %s (%s)", planned.URN, planned.Op))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	return errors.Errorf("the following planned steps depend on values that are unknown until the update runs, so "+
+		"an update could not be checked against the plan before changing resources:\n%s", strings.Join(unknown, "\n"))
+}
+
+// planChecker verifies the steps generated by a plan against a saved plan.
+type planChecker struct {
+	remaining map[resource.URN][]PlannedStep // the planned steps that have yet to be generated, by URN.
+}
+
+func newPlanChecker(plan *SavedPlan) *planChecker {
+	remaining := make(map[resource.URN][]PlannedStep)
+	for _, planned := range plan.Steps {
+		remaining[planned.URN] = append(remaining[planned.URN], planned)
+	}
+	return &planChecker{remaining: remaining}
+}
+
+// Check verifies that the given step matches a planned step that has yet to be generated, and marks that planned
+// step as generated. An error is returned if the step was not planned, or if its properties do not match the plan.
+func (c *planChecker) Check(step Step) error {
+	urn := step.URN()
+	candidates := c.remaining[urn]
+	for i, planned := range candidates {
+		if !plannedOpMatches(planned.Op, step.Op()) {
+			continue
+		}
+
+		if new := step.New(); new != nil {
+			if key, ok := matchesPlannedProperties(planned.Inputs, new.Inputs); !ok {
+				return errors.Errorf("%s: the %s step does not match the plan: input property '%s' has changed",
+					urn, step.Op(), key)
+			}
+		}
+		if old := step.Old(); old != nil {
+			if key, ok := matchesPlannedProperties(planned.Outputs, old.Outputs); !ok {
+				return errors.Errorf("%s: the %s step does not match the plan: output property '%s' of the "+
+					"existing resource has changed since the plan was made", urn, step.Op(), key)
+			}
+		}
+
+		c.remaining[urn] = append(candidates[:i:i], candidates[i+1:]...)
+		return nil
+	}
+
+	if len(candidates) == 0 {
+		return errors.Errorf("%s: the %s step was not planned", urn, step.Op())
+	}
+	ops := make([]string, len(candidates))
+	for i, planned := range candidates {
+		ops[i] = string(planned.Op)
+	}
+	return errors.Errorf("%s: the %s step was not planned; the plan expected: %s", urn, step.Op(),
+		strings.Join(ops, ", "))
+}
+
+// Complete returns an error if any planned steps have not been generated.
+func (c *planChecker) Complete() error {
+	var missing []string
+	for urn, candidates := range c.remaining {
+		for _, planned := range candidates {
+			missing = append(missing, fmt.Sprintf("    %s (%s)", urn, planned.Op))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return errors.Errorf("the following planned steps were not performed:\n%s", strings.Join(missing, "\n"))
+}
+
+// plannedOpMatches returns true if a step that performs the actual operation satisfies a planned step that performs the
+// planned operation. A planned update may turn out to be unnecessary once the values that were unknown at the time of
+// the plan are known, so it is satisfied by a same step.
+func plannedOpMatches(planned, actual StepOp) bool {
+	return planned == actual || planned == OpUpdate && actual == OpSame
+}
+
+// matchesPlannedProperties returns true if the actual properties match the planned properties. If they do not, the
+// key of the first mismatched property is returned.
+func matchesPlannedProperties(planned, actual resource.PropertyMap) (resource.PropertyKey, bool) {
+	for _, k := range planned.StableKeys() {
+		if !matchesPlannedValue(planned[k], actual[k]) {
+			return k, false
+		}
+	}
+	for _, k := range actual.StableKeys() {
+		if _, has := planned[k]; !has && actual[k].HasValue() {
+			return k, false
+		}
+	}
+	return "", true
+}
+
+// matchesPlannedValue returns true if the actual value matches the planned value. A planned value that was unknown
+// matches any value.
+func matchesPlannedValue(planned, actual resource.PropertyValue) bool {
+	switch {
+	case planned.IsComputed() || planned.IsOutput():
+		return true
+	case planned.IsArray():
+		if !actual.IsArray() || len(planned.ArrayValue()) != len(actual.ArrayValue()) {
+			return false
+		}
+		for i, elem := range planned.ArrayValue() {
+			if !matchesPlannedValue(elem, actual.ArrayValue()[i]) {
+				return false
+			}
+		}
+		return true
+	case planned.IsObject():
+		if !actual.IsObject() {
+			return false
+		}
+		_, ok := matchesPlannedProperties(planned.ObjectValue(), actual.ObjectValue())
+		return ok
+	case planned.IsSecret():
+		return actual.IsSecret() && matchesPlannedValue(planned.SecretValue().Element, actual.SecretValue().Element)
+	default:
+		return planned.DeepEquals(actual)
+	}
+}
@@ -62,6 +62,67 @@ func NewSnapshot(manifest Manifest, resources []*resource.State, ops []resource.
 	}
 }
 
+// NormalizeURNReferences rewrites any references to resources by URNs they were previously known by (i.e. the URNs of
+// the old resources that they alias) so that they instead refer to the resources' current URNs. References may appear
+// in a resource's parent or its dependencies. Any resource states that need to be rewritten are copied rather than
+// modified in place, as they may be shared with an in-progress plan.
+func (snap *Snapshot) NormalizeURNReferences() *Snapshot {
+	if snap == nil {
+		return nil
+	}
+
+	aliased := make(map[resource.URN]resource.URN)
+	for _, state := range snap.Resources {
+		for _, alias := range state.Aliases {
+			aliased[alias] = state.URN
+		}
+	}
+	if len(aliased) == 0 {
+		return snap
+	}
+
+	fixURN := func(urn resource.URN) (resource.URN, bool) {
+		if newURN, has := aliased[urn]; has {
+			return newURN, true
+		}
+		return urn, false
+	}
+
+	resources := make([]*resource.State, len(snap.Resources))
+	for i, state := range snap.Resources {
+		var copied *resource.State
+		ensureCopy := func() *resource.State {
+			if copied == nil {
+				clone := *state
+				clone.Dependencies = append([]resource.URN(nil), state.Dependencies...)
+				copied = &clone
+			}
+			return copied
+		}
+
+		if parent, fixed := fixURN(state.Parent); fixed {
+			ensureCopy().Parent = parent
+		}
+		for j, dep := range state.Dependencies {
+			if newDep, fixed := fixURN(dep); fixed {
+				ensureCopy().Dependencies[j] = newDep
+			}
+		}
+
+		if copied != nil {
+			resources[i] = copied
+		} else {
+			resources[i] = state
+		}
+	}
+
+	return &Snapshot{
+		Manifest:          snap.Manifest,
+		Resources:         resources,
+		PendingOperations: snap.PendingOperations,
+	}
+}
+
 // VerifyIntegrity checks a snapshot to ensure it is well-formed.  Because of the cost of this operation,
 // integrity verification is only performed on demand, and not automatically during snapshot construction.
 //
@@ -17,6 +17,7 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/tokens"
@@ -179,6 +181,14 @@ func (iter *evalSourceIterator) forkRun(opts Options) {
 			// Make sure to clean up before exiting.
 			defer contract.IgnoreClose(langhost)
 
+			// Note which configuration values are secrets, so that the program can mark them as such.
+			var configSecrets []config.Key
+			for k, v := range iter.src.runinfo.Target.Config {
+				if v.Secure() {
+					configSecrets = append(configSecrets, k)
+				}
+			}
+
 			// Decrypt the configuration.
 			config, err := iter.src.runinfo.Target.Config.Decrypt(iter.src.runinfo.Target.Decrypter)
 			if err != nil {
@@ -195,6 +205,7 @@ func (iter *evalSourceIterator) forkRun(opts Options) {
 				Program:        iter.src.runinfo.Program,
 				Args:           iter.src.runinfo.Args,
 				Config:         config,
+				ConfigSecrets:  configSecrets,
 				DryRun:         iter.src.dryRun,
 				Parallel:       opts.Parallel,
 			})
@@ -256,7 +267,8 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 	// Create the result channel and the event.
 	done := make(chan *RegisterResult)
 	event := &registerResourceEvent{
-		goal: resource.NewGoal(providers.MakeProviderType(pkg), "default", true, inputs, "", false, nil, "", nil),
+		goal: resource.NewGoal(providers.MakeProviderType(pkg), "default", true, inputs, "", false, nil, "", nil, nil,
+			nil, "", resource.CustomTimeouts{}),
 		done: done,
 	}
 	return event, done, nil
@@ -328,8 +340,6 @@ func (d *defaultProviders) serve() {
 
 // getDefaultProviderRef fetches the provider reference for the default provider for a particular package.
 func (d *defaultProviders) getDefaultProviderRef(pkg tokens.Package) (providers.Reference, error) {
-	contract.Assert(pkg != "pulumi")
-
 	response := make(chan defaultProviderResponse)
 	select {
 	case d.requests <- defaultProviderRequest{pkg: pkg, response: response}:
@@ -463,7 +473,7 @@ func (rm *resmon) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pu
 	label := fmt.Sprintf("ResourceMonitor.Invoke(%s)", tok)
 
 	args, err := plugin.UnmarshalProperties(
-		req.GetArgs(), plugin.MarshalOptions{Label: label, KeepUnknowns: true})
+		req.GetArgs(), plugin.MarshalOptions{Label: label, KeepUnknowns: true, KeepSecrets: true})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal %v args", tok)
 	}
@@ -519,6 +529,7 @@ func (rm *resmon) ReadResource(ctx context.Context,
 	props, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
 		Label:        label,
 		KeepUnknowns: true,
+		KeepSecrets:  true,
 	})
 	if err != nil {
 		return nil, err
@@ -549,8 +560,8 @@ func (rm *resmon) ReadResource(ctx context.Context,
 		logging.V(5).Infof("ResourceMonitor.ReadResource operation canceled, name=%s", name)
 		return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on step's done channel")
 	}
-
 	contract.Assert(result != nil)
+
 	marshaled, err := plugin.MarshalProperties(result.State.Outputs, plugin.MarshalOptions{
 		Label:        label,
 		KeepUnknowns: true,
@@ -606,19 +617,42 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	}
 
 	props, err := plugin.UnmarshalProperties(
-		req.GetObject(), plugin.MarshalOptions{Label: label, KeepUnknowns: true, ComputeAssetHashes: true})
+		req.GetObject(), plugin.MarshalOptions{
+			Label: label, KeepUnknowns: true, KeepSecrets: true, ComputeAssetHashes: true})
 	if err != nil {
 		return nil, err
 	}
 
+	ignoreChanges := req.GetIgnoreChanges()
+
+	var aliases []resource.URN
+	for _, aliasURN := range req.GetAliases() {
+		aliases = append(aliases, resource.URN(aliasURN))
+	}
+
+	// Only custom resources that are not providers may be imported.
+	importID := resource.ID(req.GetImportId())
+	if importID != "" && (!custom || providers.IsProviderType(t)) {
+		return nil, rpcerror.New(codes.InvalidArgument,
+			fmt.Sprintf("resource %v of type %v cannot be imported", name, t))
+	}
+
+	customTimeouts, err := parseCustomTimeouts(req.GetCustomTimeouts())
+	if err != nil {
+		return nil, rpcerror.New(codes.InvalidArgument,
+			fmt.Sprintf("resource %v has invalid custom timeouts: %v", name, err))
+	}
+
 	logging.V(5).Infof(
 		"ResourceMonitor.RegisterResource received: t=%v, name=%v, custom=%v, #props=%v, parent=%v, protect=%v, "+
-			"provider=%v, deps=%v",
-		t, name, custom, len(props), parent, protect, provider, dependencies)
+			"provider=%v, deps=%v, ignoreChanges=%v, aliases=%v, importID=%v, customTimeouts=%v",
+		t, name, custom, len(props), parent, protect, provider, dependencies, ignoreChanges, aliases, importID,
+		customTimeouts)
 
 	// Send the goal state to the engine.
 	step := &registerResourceEvent{
-		goal: resource.NewGoal(t, name, custom, props, parent, protect, dependencies, provider, nil),
+		goal: resource.NewGoal(t, name, custom, props, parent, protect, dependencies, provider, nil,
+			ignoreChanges, aliases, importID, customTimeouts),
 		done: make(chan *RegisterResult),
 	}
 
@@ -664,6 +698,42 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	}, nil
 }
 
+// parseCustomTimeouts converts the duration strings in a resource registration's custom timeouts into seconds.
+func parseCustomTimeouts(
+	timeouts *pulumirpc.RegisterResourceRequest_CustomTimeouts) (resource.CustomTimeouts, error) {
+
+	parse := func(op, timeout string) (float64, error) {
+		if timeout == "" {
+			return 0, nil
+		}
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return 0, errors.Wrapf(err, "bad %s timeout", op)
+		}
+		if d <= 0 {
+			return 0, errors.Errorf("bad %s timeout: %q must be positive", op, timeout)
+		}
+		return d.Seconds(), nil
+	}
+
+	var result resource.CustomTimeouts
+	if timeouts == nil {
+		return result, nil
+	}
+
+	var err error
+	if result.Create, err = parse("create", timeouts.GetCreate()); err != nil {
+		return resource.CustomTimeouts{}, err
+	}
+	if result.Update, err = parse("update", timeouts.GetUpdate()); err != nil {
+		return resource.CustomTimeouts{}, err
+	}
+	if result.Delete, err = parse("delete", timeouts.GetDelete()); err != nil {
+		return resource.CustomTimeouts{}, err
+	}
+	return result, nil
+}
+
 // RegisterResourceOutputs records some new output properties for a resource that have arrived after its initial
 // provisioning.  These will make their way into the eventual checkpoint state file for that resource.
 func (rm *resmon) RegisterResourceOutputs(ctx context.Context,
@@ -676,7 +746,8 @@ func (rm *resmon) RegisterResourceOutputs(ctx context.Context,
 	}
 	label := fmt.Sprintf("ResourceMonitor.RegisterResourceOutputs(%s)", urn)
 	outs, err := plugin.UnmarshalProperties(
-		req.GetOutputs(), plugin.MarshalOptions{Label: label, KeepUnknowns: true, ComputeAssetHashes: true})
+		req.GetOutputs(), plugin.MarshalOptions{
+			Label: label, KeepUnknowns: true, KeepSecrets: true, ComputeAssetHashes: true})
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot unmarshal output properties")
 	}
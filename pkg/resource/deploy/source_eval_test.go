@@ -61,7 +61,7 @@ func fixedProgram(steps []RegisterResourceEvent) deploytest.ProgramFunc {
 			}
 			s.Done(&RegisterResult{
 				State: resource.NewState(g.Type, urn, g.Custom, false, id, g.Properties, outs, g.Parent, g.Protect,
-					false, g.Dependencies, nil, g.Provider),
+					false, g.Dependencies, nil, g.Provider, resource.CustomTimeouts{}),
 			})
 		}
 		return nil
@@ -143,16 +143,16 @@ func TestRegisterNoDefaultProviders(t *testing.T) {
 		// Register a component resource.
 		&testRegEvent{
 			goal: resource.NewGoal(componentURN.Type(), componentURN.Name(), false, resource.PropertyMap{}, "", false,
-				nil, "", []string{}),
+				nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		// Register a couple resources using provider A.
 		&testRegEvent{
 			goal: resource.NewGoal("pkgA:index:typA", "res1", true, resource.PropertyMap{}, componentURN, false, nil,
-				providerARef.String(), []string{}),
+				providerARef.String(), []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		&testRegEvent{
 			goal: resource.NewGoal("pkgA:index:typA", "res2", true, resource.PropertyMap{}, componentURN, false, nil,
-				providerARef.String(), []string{}),
+				providerARef.String(), []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		// Register two more providers.
 		newProviderEvent("pkgA", "providerB", nil, ""),
@@ -160,11 +160,11 @@ func TestRegisterNoDefaultProviders(t *testing.T) {
 		// Register a few resources that use the new providers.
 		&testRegEvent{
 			goal: resource.NewGoal("pkgB:index:typB", "res3", true, resource.PropertyMap{}, "", false, nil,
-				providerBRef.String(), []string{}),
+				providerBRef.String(), []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		&testRegEvent{
 			goal: resource.NewGoal("pkgB:index:typC", "res4", true, resource.PropertyMap{}, "", false, nil,
-				providerCRef.String(), []string{}),
+				providerCRef.String(), []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 	}
 
@@ -197,7 +197,7 @@ func TestRegisterNoDefaultProviders(t *testing.T) {
 		}
 		reg.Done(&RegisterResult{
 			State: resource.NewState(goal.Type, urn, goal.Custom, false, id, goal.Properties, resource.PropertyMap{},
-				goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider),
+				goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider, resource.CustomTimeouts{}),
 		})
 
 		processed++
@@ -226,25 +226,25 @@ func TestRegisterDefaultProviders(t *testing.T) {
 		// Register a component resource.
 		&testRegEvent{
 			goal: resource.NewGoal(componentURN.Type(), componentURN.Name(), false, resource.PropertyMap{}, "", false,
-				nil, "", []string{}),
+				nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		// Register a couple resources from package A.
 		&testRegEvent{
 			goal: resource.NewGoal("pkgA:m:typA", "res1", true, resource.PropertyMap{},
-				componentURN, false, nil, "", []string{}),
+				componentURN, false, nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		&testRegEvent{
 			goal: resource.NewGoal("pkgA:m:typA", "res2", true, resource.PropertyMap{},
-				componentURN, false, nil, "", []string{}),
+				componentURN, false, nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		// Register a few resources from other packages.
 		&testRegEvent{
 			goal: resource.NewGoal("pkgB:m:typB", "res3", true, resource.PropertyMap{}, "", false,
-				nil, "", []string{}),
+				nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 		&testRegEvent{
 			goal: resource.NewGoal("pkgB:m:typC", "res4", true, resource.PropertyMap{}, "", false,
-				nil, "", []string{}),
+				nil, "", []string{}, nil, nil, "", resource.CustomTimeouts{}),
 		},
 	}
 
@@ -288,7 +288,7 @@ func TestRegisterDefaultProviders(t *testing.T) {
 
 		reg.Done(&RegisterResult{
 			State: resource.NewState(goal.Type, urn, goal.Custom, false, id, goal.Properties, resource.PropertyMap{},
-				goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider),
+				goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider, resource.CustomTimeouts{}),
 		})
 
 		processed++
@@ -377,7 +377,8 @@ func TestReadInvokeNoDefaultProviders(t *testing.T) {
 		urn := newURN(read.Type(), string(read.Name()), read.Parent())
 		read.Done(&ReadResult{
 			State: resource.NewState(read.Type(), urn, true, false, read.ID(), read.Properties(),
-				resource.PropertyMap{}, read.Parent(), false, false, read.Dependencies(), nil, read.Provider()),
+				resource.PropertyMap{}, read.Parent(), false, false, read.Dependencies(), nil, read.Provider(),
+				resource.CustomTimeouts{}),
 		})
 		reads++
 	}
@@ -461,7 +462,8 @@ func TestReadInvokeDefaultProviders(t *testing.T) {
 
 			e.Done(&RegisterResult{
 				State: resource.NewState(goal.Type, urn, goal.Custom, false, id, goal.Properties, resource.PropertyMap{},
-					goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider),
+					goal.Parent, goal.Protect, false, goal.Dependencies, nil, goal.Provider,
+					resource.CustomTimeouts{}),
 			})
 			registers++
 
@@ -469,7 +471,8 @@ func TestReadInvokeDefaultProviders(t *testing.T) {
 			urn := newURN(e.Type(), string(e.Name()), e.Parent())
 			e.Done(&ReadResult{
 				State: resource.NewState(e.Type(), urn, true, false, e.ID(), e.Properties(),
-					resource.PropertyMap{}, e.Parent(), false, false, e.Dependencies(), nil, e.Provider()),
+					resource.PropertyMap{}, e.Parent(), false, false, e.Dependencies(), nil, e.Provider(),
+					resource.CustomTimeouts{}),
 			})
 			reads++
 		}
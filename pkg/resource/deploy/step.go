@@ -80,17 +80,17 @@ func NewSameStep(plan *Plan, reg RegisterResourceEvent, old *resource.State, new
 
 func (s *SameStep) Op() StepOp           { return OpSame }
 func (s *SameStep) Plan() *Plan          { return s.plan }
-func (s *SameStep) Type() tokens.Type    { return s.old.Type }
+func (s *SameStep) Type() tokens.Type    { return s.new.Type }
 func (s *SameStep) Provider() string     { return s.old.Provider }
-func (s *SameStep) URN() resource.URN    { return s.old.URN }
+func (s *SameStep) URN() resource.URN    { return s.new.URN }
 func (s *SameStep) Old() *resource.State { return s.old }
 func (s *SameStep) New() *resource.State { return s.new }
 func (s *SameStep) Res() *resource.State { return s.new }
 func (s *SameStep) Logical() bool        { return true }
 
 func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
-	// Retain the URN, ID, and outputs:
-	s.new.URN = s.old.URN
+	// Retain the ID and outputs. Note that the URN is not retained: if the resource was found under an alias, the new
+	// URN differs from the old one.
 	s.new.ID = s.old.ID
 	s.new.Outputs = s.old.Outputs
 	complete := func() { s.reg.Done(&RegisterResult{State: s.new, Stable: true}) }
@@ -99,13 +99,14 @@ func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 
 // CreateStep is a mutating step that creates an entirely new resource.
 type CreateStep struct {
-	plan          *Plan                  // the current plan.
-	reg           RegisterResourceEvent  // the registration intent to convey a URN back to.
-	old           *resource.State        // the state of the existing resource (only for replacements).
-	new           *resource.State        // the state of the resource after this step.
-	keys          []resource.PropertyKey // the keys causing replacement (only for replacements).
-	replacing     bool                   // true if this is a create due to a replacement.
-	pendingDelete bool                   // true if this replacement should create a pending delete.
+	plan          *Plan                          // the current plan.
+	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
+	old           *resource.State                // the state of the existing resource (only for replacements).
+	new           *resource.State                // the state of the resource after this step.
+	keys          []resource.PropertyKey         // the keys causing replacement (only for replacements).
+	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff (only for replacements).
+	replacing     bool                           // true if this is a create due to a replacement.
+	pendingDelete bool                           // true if this replacement should create a pending delete.
 }
 
 var _ Step = (*CreateStep)(nil)
@@ -126,7 +127,8 @@ func NewCreateStep(plan *Plan, reg RegisterResourceEvent, new *resource.State) S
 }
 
 func NewCreateReplacementStep(plan *Plan, reg RegisterResourceEvent,
-	old *resource.State, new *resource.State, keys []resource.PropertyKey, pendingDelete bool) Step {
+	old *resource.State, new *resource.State, keys []resource.PropertyKey,
+	detailedDiff map[string]plugin.PropertyDiff, pendingDelete bool) Step {
 	contract.Assert(reg != nil)
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
@@ -137,7 +139,6 @@ func NewCreateReplacementStep(plan *Plan, reg RegisterResourceEvent,
 	contract.Assert(new.ID == "")
 	contract.Assert(!new.Custom || new.Provider != "" || providers.IsProviderType(new.Type))
 	contract.Assert(!new.Delete)
-	contract.Assert(old.Type == new.Type)
 	contract.Assert(!new.External)
 	return &CreateStep{
 		plan:          plan,
@@ -145,6 +146,7 @@ func NewCreateReplacementStep(plan *Plan, reg RegisterResourceEvent,
 		old:           old,
 		new:           new,
 		keys:          keys,
+		detailedDiff:  detailedDiff,
 		replacing:     true,
 		pendingDelete: pendingDelete,
 	}
@@ -166,6 +168,8 @@ func (s *CreateStep) Res() *resource.State         { return s.new }
 func (s *CreateStep) Keys() []resource.PropertyKey { return s.keys }
 func (s *CreateStep) Logical() bool                { return !s.replacing }
 
+func (s *CreateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
+
 func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	var resourceError error
 	resourceStatus := resource.StatusOK
@@ -176,7 +180,7 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			if err != nil {
 				return resource.StatusOK, nil, err
 			}
-			id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs)
+			id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create)
 			if err != nil {
 				if rst != resource.StatusPartialFailure {
 					return rst, nil, err
@@ -274,7 +278,7 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			if err != nil {
 				return resource.StatusOK, nil, err
 			}
-			if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.All()); err != nil {
+			if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.All(), s.old.CustomTimeouts.Delete); err != nil {
 				return rst, nil, err
 			}
 		}
@@ -290,12 +294,14 @@ type UpdateStep struct {
 	old     *resource.State        // the state of the existing resource.
 	new     *resource.State        // the newly computed state of the resource after updating.
 	stables []resource.PropertyKey // an optional list of properties that won't change during this update.
+
+	detailedDiff map[string]plugin.PropertyDiff // the structured property diff, if the provider supplied one.
 }
 
 var _ Step = (*UpdateStep)(nil)
 
 func NewUpdateStep(plan *Plan, reg RegisterResourceEvent, old *resource.State,
-	new *resource.State, stables []resource.PropertyKey) Step {
+	new *resource.State, stables []resource.PropertyKey, detailedDiff map[string]plugin.PropertyDiff) Step {
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
@@ -305,7 +311,6 @@ func NewUpdateStep(plan *Plan, reg RegisterResourceEvent, old *resource.State,
 	contract.Assert(new.URN != "")
 	contract.Assert(new.ID == "")
 	contract.Assert(!new.Delete)
-	contract.Assert(old.Type == new.Type)
 	contract.Assert(!new.External)
 	contract.Assert(!old.External)
 	return &UpdateStep{
@@ -314,22 +319,25 @@ func NewUpdateStep(plan *Plan, reg RegisterResourceEvent, old *resource.State,
 		old:     old,
 		new:     new,
 		stables: stables,
+
+		detailedDiff: detailedDiff,
 	}
 }
 
 func (s *UpdateStep) Op() StepOp           { return OpUpdate }
 func (s *UpdateStep) Plan() *Plan          { return s.plan }
-func (s *UpdateStep) Type() tokens.Type    { return s.old.Type }
+func (s *UpdateStep) Type() tokens.Type    { return s.new.Type }
 func (s *UpdateStep) Provider() string     { return s.old.Provider }
-func (s *UpdateStep) URN() resource.URN    { return s.old.URN }
+func (s *UpdateStep) URN() resource.URN    { return s.new.URN }
 func (s *UpdateStep) Old() *resource.State { return s.old }
 func (s *UpdateStep) New() *resource.State { return s.new }
 func (s *UpdateStep) Res() *resource.State { return s.new }
 func (s *UpdateStep) Logical() bool        { return true }
 
+func (s *UpdateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
+
 func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
-	// Always propagate the URN and ID, even in previews and refreshes.
-	s.new.URN = s.old.URN
+	// Always propagate the ID, even in previews and refreshes.
 	s.new.ID = s.old.ID
 
 	var resourceError error
@@ -343,7 +351,8 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			}
 
 			// Update to the combination of the old "all" state (including outputs), but overwritten with new inputs.
-			outs, rst, upderr := prov.Update(s.URN(), s.old.ID, s.old.All(), s.new.Inputs)
+			outs, rst, upderr := prov.Update(s.URN(), s.old.ID, s.old.All(), s.new.Inputs,
+				s.new.CustomTimeouts.Update)
 			if upderr != nil {
 				if rst != resource.StatusPartialFailure {
 					return rst, nil, upderr
@@ -374,17 +383,18 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 // a creation of the new resource, any number of intervening updates of dependents to the new resource, and then
 // a deletion of the now-replaced old resource.  This logical step is primarily here for tools and visualization.
 type ReplaceStep struct {
-	plan          *Plan                  // the current plan.
-	old           *resource.State        // the state of the existing resource.
-	new           *resource.State        // the new state snapshot.
-	keys          []resource.PropertyKey // the keys causing replacement.
-	pendingDelete bool                   // true if a pending deletion should happen.
+	plan          *Plan                          // the current plan.
+	old           *resource.State                // the state of the existing resource.
+	new           *resource.State                // the new state snapshot.
+	keys          []resource.PropertyKey         // the keys causing replacement.
+	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff, if the provider supplied one.
+	pendingDelete bool                           // true if a pending deletion should happen.
 }
 
 var _ Step = (*ReplaceStep)(nil)
 
 func NewReplaceStep(plan *Plan, old *resource.State, new *resource.State,
-	keys []resource.PropertyKey, pendingDelete bool) Step {
+	keys []resource.PropertyKey, detailedDiff map[string]plugin.PropertyDiff, pendingDelete bool) Step {
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
@@ -398,21 +408,24 @@ func NewReplaceStep(plan *Plan, old *resource.State, new *resource.State,
 		old:           old,
 		new:           new,
 		keys:          keys,
+		detailedDiff:  detailedDiff,
 		pendingDelete: pendingDelete,
 	}
 }
 
 func (s *ReplaceStep) Op() StepOp                   { return OpReplace }
 func (s *ReplaceStep) Plan() *Plan                  { return s.plan }
-func (s *ReplaceStep) Type() tokens.Type            { return s.old.Type }
+func (s *ReplaceStep) Type() tokens.Type            { return s.new.Type }
 func (s *ReplaceStep) Provider() string             { return s.old.Provider }
-func (s *ReplaceStep) URN() resource.URN            { return s.old.URN }
+func (s *ReplaceStep) URN() resource.URN            { return s.new.URN }
 func (s *ReplaceStep) Old() *resource.State         { return s.old }
 func (s *ReplaceStep) New() *resource.State         { return s.new }
 func (s *ReplaceStep) Res() *resource.State         { return s.new }
 func (s *ReplaceStep) Keys() []resource.PropertyKey { return s.keys }
 func (s *ReplaceStep) Logical() bool                { return true }
 
+func (s *ReplaceStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
+
 func (s *ReplaceStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	// If this is a pending delete, we should have marked the old resource for deletion in the CreateReplacement step.
 	contract.Assert(!s.pendingDelete || s.old.Delete)
@@ -537,6 +550,87 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 	return resourceStatus, complete, resourceError
 }
 
+// ImportStep is a step indicating that an existing resource will be adopted by Pulumi. The step reads the current state
+// of the resource with the requested ID from its provider and diffs it against the inputs supplied by the program. If
+// the two differ, the import fails; otherwise, the resource is recorded as a fully managed (i.e. non-External) resource
+// whose lifecycle is owned by Pulumi from then on.
+type ImportStep struct {
+	plan *Plan                 // the plan that produced this import
+	reg  RegisterResourceEvent // the registration intent to convey a URN back to.
+	old  *resource.State       // the old resource state, if one exists for this urn (must be External)
+	new  *resource.State       // the new resource state, to be populated with the imported state
+}
+
+// NewImportStep creates a new Import step.
+func NewImportStep(plan *Plan, reg RegisterResourceEvent, old *resource.State, new *resource.State) Step {
+	contract.Assert(new != nil)
+	contract.Assertf(new.ID != "", "target of Import step must have an ID")
+	contract.Assertf(new.Custom, "target of Import step must be Custom")
+	contract.Assertf(!new.External, "target of Import step must not be External")
+	contract.Assertf(old == nil || old.External, "old target of Import step must be External")
+	return &ImportStep{
+		plan: plan,
+		reg:  reg,
+		old:  old,
+		new:  new,
+	}
+}
+
+func (s *ImportStep) Op() StepOp           { return OpImport }
+func (s *ImportStep) Plan() *Plan          { return s.plan }
+func (s *ImportStep) Type() tokens.Type    { return s.new.Type }
+func (s *ImportStep) Provider() string     { return s.new.Provider }
+func (s *ImportStep) URN() resource.URN    { return s.new.URN }
+func (s *ImportStep) Old() *resource.State { return s.old }
+func (s *ImportStep) New() *resource.State { return s.new }
+func (s *ImportStep) Res() *resource.State { return s.new }
+func (s *ImportStep) Logical() bool        { return true }
+
+func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	// Like Read steps, Import steps run during previews so that a mismatch between the program and the resource being
+	// imported is reported as early as possible.
+	prov, err := getProvider(s)
+	if err != nil {
+		return resource.StatusOK, nil, err
+	}
+
+	outputs, rst, err := prov.Read(s.new.URN, s.new.ID, nil)
+	if err != nil {
+		return rst, nil, err
+	}
+	if outputs == nil {
+		return rst, nil, errors.Errorf("resource '%v' does not exist", s.new.ID)
+	}
+	s.new.Outputs = outputs
+
+	// Diff the live state of the resource against the inputs supplied by the program. If the provider is unable to
+	// compute a diff, fall back to comparing each known input with the output of the same name.
+	diff, err := prov.Diff(s.new.URN, s.new.ID, outputs, s.new.Inputs, preview)
+	if err != nil {
+		return rst, nil, err
+	}
+	changes := diff.Changes
+	if changes == plugin.DiffUnknown {
+		changes = plugin.DiffNone
+		for k, v := range s.new.Inputs {
+			if v.ContainsUnknowns() {
+				continue
+			}
+			if out, has := outputs[k]; !has || !v.DeepEquals(out) {
+				changes = plugin.DiffSome
+				break
+			}
+		}
+	}
+	if changes != plugin.DiffNone {
+		return rst, nil, errors.Errorf(
+			"inputs to import do not match the existing resource '%v'; importing this resource will fail", s.new.ID)
+	}
+
+	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
+	return resource.StatusOK, complete, nil
+}
+
 // RefreshStep is a step used to track the progress of a refresh operation. A refresh operation updates the an existing
 // resource by reading its current state from its provider plugin. These steps are not issued by the step generator;
 // instead, they are issued by the plan executor as the optional first step in plan execution.
@@ -612,7 +706,8 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 
 	if refreshed != nil {
 		s.new = resource.NewState(s.old.Type, s.old.URN, s.old.Custom, s.old.Delete, s.old.ID, s.old.Inputs, refreshed,
-			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider)
+			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
+			s.old.CustomTimeouts)
 	} else {
 		s.new = nil
 	}
@@ -634,6 +729,7 @@ const (
 	OpRead              StepOp = "read"               // reading an existing resource.
 	OpReadReplacement   StepOp = "read-replacement"   // reading an existing resource for a replacement.
 	OpRefresh           StepOp = "refresh"            // refreshing an existing resource.
+	OpImport            StepOp = "import"             // adopting an existing resource.
 )
 
 // StepOps contains the full set of step operation types.
@@ -648,6 +744,7 @@ var StepOps = []StepOp{
 	OpRead,
 	OpReadReplacement,
 	OpRefresh,
+	OpImport,
 }
 
 // Color returns a suggested color for lines of this op type.
@@ -673,6 +770,8 @@ func (op StepOp) Color() string {
 		return colors.SpecReplace
 	case OpRefresh:
 		return colors.SpecUpdate
+	case OpImport:
+		return colors.SpecImport
 	default:
 		contract.Failf("Unrecognized resource step op: '%v'", op)
 		return ""
@@ -707,6 +806,8 @@ func (op StepOp) RawPrefix() string {
 		return ">~"
 	case OpRefresh:
 		return "~ "
+	case OpImport:
+		return "= "
 	default:
 		contract.Failf("Unrecognized resource step op: %v", op)
 		return ""
@@ -721,6 +822,8 @@ func (op StepOp) PastTense() string {
 		return "refreshed"
 	case OpRead:
 		return "read"
+	case OpImport:
+		return "imported"
 	default:
 		contract.Failf("Unexpected resource step op: %v", op)
 		return ""
@@ -19,9 +19,12 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/util/logging"
 )
@@ -32,6 +35,11 @@ const (
 
 	// Utility constant for easy debugging.
 	stepExecutorLogLevel = 4
+
+	// The minimum grace period given to a provider beyond a step's custom timeout before the step is failed.
+	minStepTimeoutGrace = time.Second
+	// The period for which a step that has timed out may still return so that its result can be recorded.
+	stepTimeoutSettlePeriod = time.Second
 )
 
 var (
@@ -44,6 +52,27 @@ var (
 // A Chain is a sequence of Steps that must be executed in the given order.
 type Chain = []Step
 
+// incomingChain is a Chain that has been submitted for execution, along with an optional channel that is closed once
+// the chain has finished executing, whether or not it succeeded.
+type incomingChain struct {
+	Chain          Chain
+	CompletionChan chan bool
+}
+
+// completionToken is returned by ExecuteDeletes and may be used to wait for the steps it was given to finish.
+type completionToken struct {
+	channel chan bool
+}
+
+// Wait blocks until the steps associated with this token have finished executing or until the given context is
+// done, whichever comes first.
+func (c completionToken) Wait(ctx context.Context) {
+	select {
+	case <-c.channel:
+	case <-ctx.Done():
+	}
+}
+
 // stepExecutor is the component of the engine responsible for taking steps and executing
 // them, possibly in parallel if requested. The step generator operates on the granularity
 // of "chains", which are sequences of steps that must be executed exactly in the given order.
@@ -58,8 +87,19 @@ type stepExecutor struct {
 	pendingNews     sync.Map // Resources that have been created but are pending a RegisterResourceOutputs.
 	continueOnError bool     // True if we want to continue the plan after a step error.
 
-	workers        sync.WaitGroup // WaitGroup tracking the worker goroutines that are owned by this step executor.
-	incomingChains chan Chain     // Incoming chains that we are to execute
+	registeredLock sync.Mutex        // Lock protecting registered.
+	registered     []*resource.State // The states of the resources registered by this plan, in completion order.
+
+	// When continuing after errors, the executor tracks which steps failed so that the steps that depend on them can be
+	// skipped. Each of the maps below maps a URN to the URN of the failed resource responsible.
+	failuresLock sync.Mutex                    // Lock protecting the fields below.
+	failed       []resource.URN                // Resources whose steps failed, in order of failure.
+	skipped      []resource.URN                // Resources whose steps were skipped due to a failure.
+	blocked      map[resource.URN]resource.URN // Resources that failed or were skipped; their dependents are skipped.
+	undeletable  map[resource.URN]resource.URN // Resources whose deletion is skipped because a dependent remains.
+
+	workers        sync.WaitGroup     // WaitGroup tracking the worker goroutines that are owned by this step executor.
+	incomingChains chan incomingChain // Incoming chains that we are to execute
 
 	ctx      context.Context    // cancellation context for the current plan.
 	cancel   context.CancelFunc // CancelFunc that cancels the above context.
@@ -75,11 +115,59 @@ type stepExecutor struct {
 // Execute submits a Chain for asynchronous execution. The execution of the chain will begin as soon as there
 // is a worker available to execute it.
 func (se *stepExecutor) Execute(chain Chain) {
+	se.execute(chain, nil)
+}
+
+// ExecuteDeletes submits the given scheduled deletes for execution. Each delete is submitted as soon as the deletes of
+// its dependents have finished, so deletes that are independent of one another execute concurrently, using as many
+// workers as are available. The returned token may be used to wait for all of the deletes to finish executing.
+func (se *stepExecutor) ExecuteDeletes(deletes []ScheduledDelete) completionToken {
+	// Each delete has its own completion token, which is closed once the delete has finished executing. A delete
+	// waits on the tokens of its dependents before it is submitted; the waiting happens outside of the workers so
+	// that a waiting delete never occupies a worker that one of its dependents needs.
+	completions := make([]chan bool, len(deletes))
+	for i := range deletes {
+		completions[i] = make(chan bool)
+	}
+	for i, del := range deletes {
+		go func(del ScheduledDelete, completion chan bool) {
+			for _, dependent := range del.Dependents {
+				select {
+				case <-completions[dependent]:
+				case <-se.ctx.Done():
+					// The plan was canceled, so this delete will never execute. Waiters observe the cancellation
+					// themselves.
+					return
+				}
+			}
+			se.execute(Chain{del.Step}, completion)
+		}(del, completions[i])
+	}
+
+	done := make(chan bool)
+	go func() {
+		for _, c := range completions {
+			select {
+			case <-c:
+			case <-se.ctx.Done():
+				return
+			}
+		}
+		close(done)
+	}()
+	return completionToken{channel: done}
+}
+
+// execute submits a chain for execution, returning false if the chain was not submitted because the plan was
+// canceled. If completion is non-nil, it is closed once the chain has finished executing.
+func (se *stepExecutor) execute(chain Chain, completion chan bool) bool {
 	// The select here is to avoid blocking on a send to se.incomingChains if a cancellation is pending.
 	// If one is pending, we should exit early - we will shortly be tearing down the engine and exiting.
 	select {
-	case se.incomingChains <- chain:
+	case se.incomingChains <- incomingChain{Chain: chain, CompletionChan: completion}:
+		return true
 	case <-se.ctx.Done():
+		return false
 	}
 }
 
@@ -138,6 +226,23 @@ func (se *stepExecutor) WaitForCompletion() {
 	se.log(synchronousWorkerID, "StepExecutor.waitForCompletion(): worker threads all exited")
 }
 
+// Failures returns the URNs of the resources whose steps failed and of the resources whose steps were skipped because
+// they depend on a resource whose step failed, each in the order in which they occurred.
+func (se *stepExecutor) Failures() ([]resource.URN, []resource.URN) {
+	se.failuresLock.Lock()
+	defer se.failuresLock.Unlock()
+
+	return se.failed, se.skipped
+}
+
+// Registered returns the states of all resources that have been successfully registered by this step executor.
+func (se *stepExecutor) Registered() []*resource.State {
+	se.registeredLock.Lock()
+	defer se.registeredLock.Unlock()
+
+	return se.registered
+}
+
 //
 // As calls to `Execute` submit chains for execution, some number of worker goroutines will continuously
 // read from `incomingChains` and execute any chains that are received. The core execution logic is in
@@ -147,7 +252,7 @@ func (se *stepExecutor) WaitForCompletion() {
 // executeChain executes a chain, one step at a time. If any step in the chain fails to execute, or if the
 // context is canceled, the chain stops execution.
 func (se *stepExecutor) executeChain(workerID int, chain Chain) {
-	for _, step := range chain {
+	for i, step := range chain {
 		select {
 		case <-se.ctx.Done():
 			se.log(workerID, "step %v on %v canceled", step.Op(), step.URN())
@@ -155,9 +260,20 @@ func (se *stepExecutor) executeChain(workerID int, chain Chain) {
 		default:
 		}
 
+		if se.continueOnError {
+			if cause, has := se.failedDependency(step); has {
+				se.skipSteps(workerID, chain[i:], cause)
+				return
+			}
+		}
+
 		if err := se.executeStep(workerID, step); err != nil {
 			se.log(workerID, "step %v on %v failed, signalling cancellation", step.Op(), step.URN())
 			se.cancelDueToError()
+			if se.continueOnError {
+				// The rest of the chain depends on the failed step, so skip it.
+				se.skipSteps(workerID, chain[i+1:], step.URN())
+			}
 			if err != errStepApplyFailed {
 				// Step application errors are recorded by the OnResourceStepPost callback. This is confusing,
 				// but it means that at this level we shouldn't be logging any errors that came from there.
@@ -179,6 +295,128 @@ func (se *stepExecutor) cancelDueToError() {
 	}
 }
 
+// failedDependency returns the URN of the failed resource that prevents the given step from executing, if any. A
+// step that creates, updates, or reads a resource depends on the resource's dependencies, parent, and provider; a step
+// that deletes a resource depends on the deletion of the resources that depend on it or are its children.
+func (se *stepExecutor) failedDependency(step Step) (resource.URN, bool) {
+	se.failuresLock.Lock()
+	defer se.failuresLock.Unlock()
+
+	if isDeleteOp(step.Op()) {
+		cause, has := se.undeletable[step.URN()]
+		return cause, has
+	}
+	if step.Op() == OpRefresh || step.New() == nil {
+		// Refreshes are independent of one another.
+		return "", false
+	}
+	for _, urn := range resourceDependencies(step.New()) {
+		if cause, has := se.blocked[urn]; has {
+			return cause, true
+		}
+	}
+	return "", false
+}
+
+// recordFailure records that the given step failed or, if skipped is true, that it was skipped due to the failure of
+// the step for the resource named by cause.
+func (se *stepExecutor) recordFailure(step Step, cause resource.URN, skipped bool) {
+	se.failuresLock.Lock()
+	defer se.failuresLock.Unlock()
+
+	if skipped {
+		se.skipped = append(se.skipped, step.URN())
+	} else {
+		se.failed = append(se.failed, step.URN())
+	}
+
+	if isDeleteOp(step.Op()) {
+		// The resource has not been deleted, so neither may any of the resources upon which it depends.
+		for _, urn := range resourceDependencies(step.Old()) {
+			if _, has := se.undeletable[urn]; !has {
+				se.undeletable[urn] = cause
+			}
+		}
+	} else if _, has := se.blocked[step.URN()]; !has {
+		se.blocked[step.URN()] = cause
+	}
+}
+
+// skipSteps skips the given steps due to the failure of the step for the resource named by cause. Any resource
+// registrations or reads associated with the steps are failed so that the program does not wait on them.
+func (se *stepExecutor) skipSteps(workerID int, steps []Step, cause resource.URN) {
+	for _, step := range steps {
+		se.log(workerID, "step %v on %v skipped due to failure of %v", step.Op(), step.URN(), cause)
+		se.plan.Diag().Warningf(diag.RawMessage(step.URN(),
+			fmt.Sprintf("skipping %s because %s failed", step.Op(), cause)))
+		se.recordFailure(step, cause, true)
+		failRegistration(step)
+	}
+}
+
+// stepFailed records the failure of the given step if the plan is to continue after the failure. If failReg is true,
+// the step's registration or read, if any, has not been completed and is failed. If the plan is not to continue, it
+// is canceled, which tears down the program.
+func (se *stepExecutor) stepFailed(step Step, failReg bool) {
+	if !se.continueOnError {
+		return
+	}
+	se.recordFailure(step, step.URN(), false)
+	if failReg {
+		failRegistration(step)
+	}
+}
+
+// failRegistration completes the registration or read, if any, of the resource affected by the given step, which has
+// failed or been skipped. The program is given a state whose ID and properties are unknown so that it may continue to
+// register resources that do not depend upon this one.
+func failRegistration(step Step) {
+	switch s := step.(type) {
+	case *SameStep:
+		s.reg.Done(&RegisterResult{State: unknownState(s.new)})
+	case *CreateStep:
+		if s.reg != nil {
+			s.reg.Done(&RegisterResult{State: unknownState(s.new)})
+		}
+	case *UpdateStep:
+		s.reg.Done(&RegisterResult{State: unknownState(s.new)})
+	case *ImportStep:
+		s.reg.Done(&RegisterResult{State: unknownState(s.new)})
+	case *ReadStep:
+		s.event.Done(&ReadResult{State: unknownState(s.new)})
+	}
+}
+
+// unknownState returns a copy of the given resource state whose ID is blank and whose properties are all unknown.
+func unknownState(state *resource.State) *resource.State {
+	unknowns := resource.PropertyMap{}
+	for k := range state.Inputs {
+		unknowns[k] = resource.MakeComputed(resource.NewStringProperty(""))
+	}
+	return resource.NewState(state.Type, state.URN, state.Custom, false, "", unknowns, unknowns, state.Parent,
+		state.Protect, state.External, state.Dependencies, state.InitErrors, state.Provider, state.CustomTimeouts)
+}
+
+// isDeleteOp returns true if the given operation deletes a resource.
+func isDeleteOp(op StepOp) bool {
+	return op == OpDelete || op == OpDeleteReplaced
+}
+
+// resourceDependencies returns the URNs of the resources upon which the given resource depends: its dependencies,
+// its parent, and its provider.
+func resourceDependencies(res *resource.State) []resource.URN {
+	urns := append([]resource.URN{}, res.Dependencies...)
+	if res.Parent != "" {
+		urns = append(urns, res.Parent)
+	}
+	if res.Provider != "" {
+		if ref, err := providers.ParseReference(res.Provider); err == nil {
+			urns = append(urns, ref.URN())
+		}
+	}
+	return urns
+}
+
 //
 // The next few functions are responsible for executing individual steps. The basic flow of step
 // execution is
@@ -200,32 +438,45 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 		payload, err = events.OnResourceStepPre(step)
 		if err != nil {
 			se.log(workerID, "step %v on %v failed pre-resource step: %v", step.Op(), step.URN(), err)
+			se.stepFailed(step, true)
 			return errors.Wrap(err, "pre-step event returned an error")
 		}
 	}
 
 	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
-	status, stepComplete, err := step.Apply(se.preview)
+	status, stepComplete, err := se.applyStep(workerID, step)
 
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
 		if step.Logical() && step.New() != nil {
 			if prior, has := se.pendingNews.Load(step.URN()); has {
+				se.stepFailed(step, true)
 				return errors.Errorf(
 					"resource '%s' registered twice (%s and %s)", step.URN(), prior.(Step).Op(), step.Op())
 			}
 
 			se.pendingNews.Store(step.URN(), step)
+
+			se.registeredLock.Lock()
+			se.registered = append(se.registered, step.New())
+			se.registeredLock.Unlock()
 		}
 	}
 
 	if events != nil {
 		if postErr := events.OnResourceStepPost(payload, step, status, err); postErr != nil {
 			se.log(workerID, "step %v on %v failed post-resource step: %v", step.Op(), step.URN(), postErr)
+			se.stepFailed(step, true)
 			return errors.Wrap(postErr, "post-step event returned an error")
 		}
 	}
 
+	// The failure must be recorded before the program learns of it, as it may respond by registering resources that
+	// depend on this one.
+	if err != nil {
+		se.stepFailed(step, stepComplete == nil)
+	}
+
 	// Calling stepComplete allows steps that depend on this step to continue. OnResourceStepPost saved the results
 	// of the step in the snapshot, so we are ready to go.
 	if stepComplete != nil {
@@ -241,6 +492,78 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 	return nil
 }
 
+// applyStep applies a single step. If the resource has a custom timeout for the step's operation, the step is given
+// a context deadline that allows the provider a grace period beyond the timeout it was passed. If the step has not
+// completed by then, it is failed with a timeout error. The step is given a further, bounded period in which to
+// return so that a late result can be recorded; a step that completes late is still failed, but its resource is
+// kept in the snapshot. A step that does not return in that period is abandoned, and its outcome is unknown.
+func (se *stepExecutor) applyStep(workerID int, step Step) (resource.Status, StepCompleteFunc, error) {
+	timeout := stepTimeout(step)
+	if se.preview || timeout == 0 {
+		return step.Apply(se.preview)
+	}
+
+	grace := timeout / 10
+	if grace < minStepTimeoutGrace {
+		grace = minStepTimeoutGrace
+	}
+	ctx, cancel := context.WithTimeout(se.ctx, timeout+grace)
+	defer cancel()
+
+	type applyResult struct {
+		status   resource.Status
+		complete StepCompleteFunc
+		err      error
+	}
+	done := make(chan applyResult, 1)
+	go func() {
+		status, complete, err := step.Apply(se.preview)
+		done <- applyResult{status: status, complete: complete, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.status, res.complete, res.err
+	case <-ctx.Done():
+		if ctx.Err() != context.DeadlineExceeded {
+			// The plan was canceled. Cancellation is handled by the provider, so just wait for the step to finish.
+			res := <-done
+			return res.status, res.complete, res.err
+		}
+	}
+
+	se.log(workerID, "step %v on %v timed out after %v", step.Op(), step.URN(), timeout)
+	timeoutErr := errors.Errorf("resource '%v' %v operation did not complete within its custom timeout of %v",
+		step.URN(), step.Op(), timeout)
+
+	select {
+	case res := <-done:
+		se.log(workerID, "step %v on %v returned after its timeout", step.Op(), step.URN())
+		if res.err == nil || res.status == resource.StatusPartialFailure {
+			// The operation took effect, so record its result, but fail the step all the same.
+			return resource.StatusPartialFailure, res.complete, timeoutErr
+		}
+		return res.status, res.complete, errors.Wrap(res.err, timeoutErr.Error())
+	case <-time.After(stepTimeoutSettlePeriod):
+		se.log(workerID, "abandoning step %v on %v", step.Op(), step.URN())
+		return resource.StatusUnknown, nil, timeoutErr
+	}
+}
+
+// stepTimeout returns the custom timeout for the given step's operation, or zero if there is none.
+func stepTimeout(step Step) time.Duration {
+	var seconds float64
+	switch step.Op() {
+	case OpCreate, OpCreateReplacement:
+		seconds = step.New().CustomTimeouts.Create
+	case OpUpdate:
+		seconds = step.New().CustomTimeouts.Update
+	case OpDelete, OpDeleteReplaced:
+		seconds = step.Old().CustomTimeouts.Delete
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
 // log is a simple logging helper for the step executor.
 func (se *stepExecutor) log(workerID int, msg string, args ...interface{}) {
 	if logging.V(stepExecutorLogLevel) {
@@ -272,14 +595,17 @@ func (se *stepExecutor) worker(workerID int) {
 	for {
 		se.log(workerID, "worker waiting for incoming chains")
 		select {
-		case chain := <-se.incomingChains:
-			if chain == nil {
+		case chain, ok := <-se.incomingChains:
+			if !ok {
 				se.log(workerID, "worker received nil chain, exiting")
 				return
 			}
 
 			se.log(workerID, "worker received chain for execution")
-			se.executeChain(workerID, chain)
+			se.executeChain(workerID, chain.Chain)
+			if chain.CompletionChan != nil {
+				close(chain.CompletionChan)
+			}
 		case <-se.ctx.Done():
 			se.log(workerID, "worker exiting due to cancellation")
 			return
@@ -294,9 +620,11 @@ func newStepExecutor(ctx context.Context, cancel context.CancelFunc, plan *Plan,
 		opts:            opts,
 		preview:         preview,
 		continueOnError: continueOnError,
-		incomingChains:  make(chan Chain),
+		incomingChains:  make(chan incomingChain),
 		ctx:             ctx,
 		cancel:          cancel,
+		blocked:         make(map[resource.URN]resource.URN),
+		undeletable:     make(map[resource.URN]resource.URN),
 	}
 
 	exec.sawError.Store(false)
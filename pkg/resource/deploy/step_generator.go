@@ -32,19 +32,36 @@ type stepGenerator struct {
 	plan *Plan   // the plan to which this step generator belongs
 	opts Options // options for this step generator
 
-	urns           map[resource.URN]bool    // set of URNs discovered for this plan
-	reads          map[resource.URN]bool    // set of URNs read for this plan
-	deletes        map[resource.URN]bool    // set of URNs deleted in this plan
-	replaces       map[resource.URN]bool    // set of URNs replaced in this plan
-	updates        map[resource.URN]bool    // set of URNs updated in this plan
-	creates        map[resource.URN]bool    // set of URNs created in this plan
-	sames          map[resource.URN]bool    // set of URNs that were not changed in this plan
-	pendingDeletes map[*resource.State]bool // set of resources (not URNs!) that are pending deletion
+	urns           map[resource.URN]bool         // set of URNs discovered for this plan
+	reads          map[resource.URN]bool         // set of URNs read for this plan
+	deletes        map[resource.URN]bool         // set of URNs deleted in this plan
+	replaces       map[resource.URN]bool         // set of URNs replaced in this plan
+	updates        map[resource.URN]bool         // set of URNs updated in this plan
+	creates        map[resource.URN]bool         // set of URNs created in this plan
+	imports        map[resource.URN]bool         // set of URNs imported in this plan
+	sames          map[resource.URN]bool         // set of URNs that were not changed in this plan
+	pendingDeletes map[*resource.State]bool      // set of resources (not URNs!) that are pending deletion
+	targets        map[resource.URN]bool         // set of URNs that this plan may change (nil if all URNs are targets)
+	aliased        map[resource.URN]resource.URN // map from the old URN of each aliased resource to its new URN
+	planned        *planChecker                  // the checker for the saved plan being followed, if any
+}
+
+// isTarget returns true if the resource with the given URN may be changed by this plan.
+func (sg *stepGenerator) isTarget(urn resource.URN) bool {
+	return sg.targets == nil || sg.targets[urn]
 }
 
 // GenerateReadSteps is responsible for producing one or more steps required to service
 // a ReadResourceEvent coming from the language host.
 func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, *result.Result) {
+	steps, res := sg.generateReadSteps(event)
+	if res != nil {
+		return nil, res
+	}
+	return sg.checkPlanned(steps)
+}
+
+func (sg *stepGenerator) generateReadSteps(event ReadResourceEvent) ([]Step, *result.Result) {
 	urn := sg.plan.generateURN(event.Parent(), event.Type(), event.Name())
 	newState := resource.NewState(event.Type(),
 		urn,
@@ -58,7 +75,8 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, *re
 		true,  /*external*/
 		event.Dependencies(),
 		nil, /* initErrors */
-		event.Provider())
+		event.Provider(),
+		resource.CustomTimeouts{})
 	old, hasOld := sg.plan.Olds()[urn]
 
 	// If the snapshot has an old resource for this URN and it's not external, we're going
@@ -80,7 +98,7 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, *re
 		sg.replaces[urn] = true
 		return []Step{
 			NewReadReplacementStep(sg.plan, event, old, newState),
-			NewReplaceStep(sg.plan, old, newState, nil, true),
+			NewReplaceStep(sg.plan, old, newState, nil, nil, true),
 		}, nil
 	}
 
@@ -101,6 +119,14 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, *re
 // and Check on the provider associated with that resource. If those fail, an error
 // is returned.
 func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *result.Result) {
+	steps, res := sg.generateSteps(event)
+	if res != nil {
+		return nil, res
+	}
+	return sg.checkPlanned(steps)
+}
+
+func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, *result.Result) {
 	var invalid bool // will be set to true if this object fails validation.
 
 	goal := event.Goal()
@@ -112,9 +138,38 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		sg.plan.Diag().Errorf(diag.GetDuplicateResourceURNError(urn), urn)
 	}
 	sg.urns[urn] = true
+	if aliasedBy, isAliased := sg.aliased[urn]; isAliased {
+		return nil, result.Errorf("resource '%v' was already claimed as an alias by resource '%v'", urn, aliasedBy)
+	}
 
-	// Check for an old resource so that we can figure out if this is a create, delete, etc., and/or to diff.
+	// Check for an old resource so that we can figure out if this is a create, delete, etc., and/or to diff. If there
+	// is no old resource with this URN, look for an old resource under each of this resource's aliases. Finding one
+	// means that the old resource has been renamed, re-parented, or re-typed, and should be updated in place rather
+	// than replaced.
 	old, hasOld := sg.plan.Olds()[urn]
+	if !hasOld && len(goal.Aliases) > 0 {
+		// Provider references include the provider's URN, so provider resources cannot be aliased.
+		if providers.IsProviderType(goal.Type) {
+			return nil, result.Errorf("provider resource '%v' cannot have aliases", urn)
+		}
+
+		for _, alias := range goal.Aliases {
+			if old, hasOld = sg.plan.Olds()[alias]; hasOld {
+				if sg.urns[alias] {
+					return nil, result.Errorf(
+						"resource '%v' cannot alias '%v', which is also registered by this program", urn, alias)
+				}
+				if aliasedBy, isAliased := sg.aliased[alias]; isAliased {
+					return nil, result.Errorf("resource '%v' cannot alias '%v', which was already claimed by '%v'",
+						urn, alias, aliasedBy)
+				}
+
+				logging.V(7).Infof("Planner found old resource '%v' under alias for '%v'", alias, urn)
+				sg.aliased[alias] = urn
+				break
+			}
+		}
+	}
 	var oldInputs resource.PropertyMap
 	var oldOutputs resource.PropertyMap
 	if hasOld {
@@ -122,11 +177,46 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		oldOutputs = old.Outputs
 	}
 
+	// If this plan has a set of update targets and this resource is not one of them (under either its URN or the URN
+	// of the old resource it aliases), carry its old state forward unchanged. Note that a resource that was deleted
+	// earlier in this plan due to a dependent delete-before-replace must be re-created regardless. A resource that
+	// does not exist yet has no old state to carry forward, so we refuse to create it unless it is a default provider,
+	// which is only registered on behalf of the resources that need it.
+	targeted := sg.isTarget(urn) || hasOld && sg.isTarget(old.URN) || !hasOld && providers.IsDefaultProvider(urn)
+	if !targeted && !(hasOld && sg.deletes[old.URN]) {
+		if !hasOld || old.External {
+			sg.plan.Diag().Errorf(diag.GetUntargetedCreateError(urn), urn)
+			return nil, result.Bail()
+		}
+
+		logging.V(7).Infof("Planner decided not to update '%v' (not a target)", urn)
+		sg.sames[urn] = true
+		new := resource.NewState(goal.Type, urn, old.Custom, false, "", old.Inputs, nil, old.Parent, old.Protect, false,
+			old.Dependencies, old.InitErrors, old.Provider, old.CustomTimeouts)
+		if old.URN != urn {
+			new.Aliases = []resource.URN{old.URN}
+		}
+		return []Step{NewSameStep(sg.plan, event, old, new)}, nil
+	}
+
+	// If the resource requests that changes to some of its properties be ignored, replace the values of those
+	// properties in the new inputs with their old values before checking or diffing them.
+	props := goal.Properties
+	if hasOld && len(goal.IgnoreChanges) > 0 {
+		var res *result.Result
+		if props, res = processIgnoreChanges(urn, props, oldInputs, goal.IgnoreChanges); res != nil {
+			return nil, res
+		}
+	}
+
 	// Produce a new state object that we'll build up as operations are performed.  Ultimately, this is what will
 	// get serialized into the checkpoint file.
-	inputs := goal.Properties
+	inputs := props
 	new := resource.NewState(goal.Type, urn, goal.Custom, false, "", inputs, nil, goal.Parent, goal.Protect, false,
-		goal.Dependencies, goal.InitErrors, goal.Provider)
+		goal.Dependencies, goal.InitErrors, goal.Provider, goal.CustomTimeouts)
+	if hasOld && old.URN != urn {
+		new.Aliases = []resource.URN{old.URN}
+	}
 
 	// Fetch the provider for this resource type, assuming it isn't just a logical one.
 	var prov plugin.Provider
@@ -155,7 +245,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 	allowUnknowns := sg.plan.preview
 
 	// We may be re-creating this resource if it got deleted earlier in the execution of this plan.
-	_, recreating := sg.deletes[urn]
+	recreating := hasOld && sg.deletes[old.URN]
 
 	// We may be creating this resource if it previously existed in the snapshot as an External resource
 	wasExternal := hasOld && old.External
@@ -168,7 +258,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		// invalid (they got deleted) so don't consider them. Similarly, if the old resource was External,
 		// don't consider those inputs since Pulumi does not own them.
 		if recreating || wasExternal {
-			inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns)
+			inputs, failures, err = prov.Check(urn, nil, props, allowUnknowns)
 		} else {
 			inputs, failures, err = prov.Check(urn, oldInputs, inputs, allowUnknowns)
 		}
@@ -196,6 +286,12 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 			return nil, result.FromError(err)
 		}
 		for _, failure := range failures {
+			// Advisory failures are reported as warnings and do not prevent the resource from being changed.
+			if failure.Severity == plugin.AnalyzeAdvisory {
+				sg.plan.Diag().Warningf(
+					diag.GetAnalyzeResourceFailureError(urn), a, urn, failure.Property, failure.Reason)
+				continue
+			}
 			invalid = true
 			sg.plan.Diag().Errorf(
 				diag.GetAnalyzeResourceFailureError(urn), a, urn, failure.Property, failure.Reason)
@@ -207,6 +303,15 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		return nil, result.Bail()
 	}
 
+	// If the program has asked to adopt an existing resource that we do not already manage, import it. Once a resource
+	// has been imported, its import ID is ignored and the resource is treated like any other managed resource.
+	if goal.ImportID != "" && (!hasOld || wasExternal) {
+		logging.V(7).Infof("Planner decided to import '%v' (id=%v)", urn, goal.ImportID)
+		sg.imports[urn] = true
+		new.ID = goal.ImportID
+		return []Step{NewImportStep(sg.plan, event, old, new)}, nil
+	}
+
 	// There are four cases we need to consider when figuring out what to do with this resource.
 	//
 	// Case 1: recreating
@@ -226,11 +331,11 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		logging.V(7).Infof("Planner decided to re-create replaced resource '%v' deleted due to dependent DBR", urn)
 
 		// Unmark this resource as deleted, we now know it's being replaced instead.
-		delete(sg.deletes, urn)
+		delete(sg.deletes, old.URN)
 		sg.replaces[urn] = true
 		return []Step{
-			NewReplaceStep(sg.plan, old, new, nil, false),
-			NewCreateReplacementStep(sg.plan, event, old, new, nil, false),
+			NewReplaceStep(sg.plan, old, new, nil, nil, false),
+			NewCreateReplacementStep(sg.plan, event, old, new, nil, nil, false),
 		}, nil
 	}
 
@@ -250,8 +355,8 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 		}
 
 		return []Step{
-			NewCreateReplacementStep(sg.plan, event, old, new, nil, true),
-			NewReplaceStep(sg.plan, old, new, nil, true),
+			NewCreateReplacementStep(sg.plan, event, old, new, nil, nil, true),
+			NewReplaceStep(sg.plan, old, new, nil, nil, true),
 		}, nil
 	}
 
@@ -265,7 +370,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 	//  - Otherwise, we invoke the resource's provider's `Diff` method. If this method indicates that the resource must
 	//    be replaced, we do so. If it does not, we update the resource in place.
 	if hasOld {
-		contract.Assert(old != nil && old.Type == new.Type)
+		contract.Assert(old != nil)
 
 		var diff plugin.DiffResult
 		if old.Provider != new.Provider {
@@ -294,7 +399,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 				// had assumed that we were going to carry them over from the old resource, which is no longer true.
 				if prov != nil {
 					var failures []plugin.CheckFailure
-					inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns)
+					inputs, failures, err = prov.Check(urn, nil, props, allowUnknowns)
 					if err != nil {
 						return nil, result.FromError(err)
 					} else if sg.issueCheckErrors(new, urn, failures) {
@@ -333,7 +438,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 					// To do this, we'll utilize the dependency information contained in the snapshot, which is
 					// interpreted by the DependencyGraph type.
 					var steps []Step
-					dependents := sg.plan.depGraph.DependingOn(old)
+					dependents := sg.plan.depGraph.DependingOn(old, false)
 
 					// Deletions must occur in reverse dependency order, and `deps` is returned in dependency
 					// order, so we iterate in reverse.
@@ -356,14 +461,14 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 
 					return append(steps,
 						NewDeleteReplacementStep(sg.plan, old, false),
-						NewReplaceStep(sg.plan, old, new, diff.ReplaceKeys, false),
-						NewCreateReplacementStep(sg.plan, event, old, new, diff.ReplaceKeys, false),
+						NewReplaceStep(sg.plan, old, new, diff.ReplaceKeys, diff.DetailedDiff, false),
+						NewCreateReplacementStep(sg.plan, event, old, new, diff.ReplaceKeys, diff.DetailedDiff, false),
 					), nil
 				}
 
 				return []Step{
-					NewCreateReplacementStep(sg.plan, event, old, new, diff.ReplaceKeys, true),
-					NewReplaceStep(sg.plan, old, new, diff.ReplaceKeys, true),
+					NewCreateReplacementStep(sg.plan, event, old, new, diff.ReplaceKeys, diff.DetailedDiff, true),
+					NewReplaceStep(sg.plan, old, new, diff.ReplaceKeys, diff.DetailedDiff, true),
 					// note that the delete step is generated "later" on, after all creates/updates finish.
 				}, nil
 			}
@@ -373,14 +478,14 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 			if logging.V(7) {
 				logging.V(7).Infof("Planner decided to update '%v' (oldprops=%v inputs=%v", urn, oldInputs, new.Inputs)
 			}
-			return []Step{NewUpdateStep(sg.plan, event, old, new, diff.StableKeys)}, nil
+			return []Step{NewUpdateStep(sg.plan, event, old, new, diff.StableKeys, diff.DetailedDiff)}, nil
 		}
 
 		// If resource was unchanged, but there were initialization errors, generate an empty update
 		// step to attempt to "continue" awaiting initialization.
 		if len(old.InitErrors) > 0 {
 			sg.updates[urn] = true
-			return []Step{NewUpdateStep(sg.plan, event, old, new, diff.StableKeys)}, nil
+			return []Step{NewUpdateStep(sg.plan, event, old, new, diff.StableKeys, diff.DetailedDiff)}, nil
 		}
 
 		// No need to update anything, the properties didn't change.
@@ -399,11 +504,31 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, *re
 	return []Step{NewCreateStep(sg.plan, event, new)}, nil
 }
 
-func (sg *stepGenerator) GenerateDeletes() []Step {
+// GenerateDeletes produces delete steps for all resources in the previous snapshot that were not registered by this
+// plan, or that are marked for deletion. If the plan is following a saved plan, it is an error for any planned steps
+// to remain once the deletes have been generated.
+func (sg *stepGenerator) GenerateDeletes() ([]Step, *result.Result) {
+	steps, res := sg.generateDeletes()
+	if res != nil {
+		return nil, res
+	}
+	if steps, res = sg.checkPlanned(steps); res != nil {
+		return nil, res
+	}
+	if sg.planned != nil {
+		if err := sg.planned.Complete(); err != nil {
+			return nil, result.FromError(err)
+		}
+	}
+	return steps, nil
+}
+
+func (sg *stepGenerator) generateDeletes() ([]Step, *result.Result) {
 	// To compute the deletion list, we must walk the list of old resources *backwards*.  This is because the list is
 	// stored in dependency order, and earlier elements are possibly leaf nodes for later elements.  We must not delete
 	// dependencies prior to their dependent nodes.
 	var dels []Step
+	var invalid bool
 	if prev := sg.plan.prev; prev != nil {
 		for i := len(prev.Resources) - 1; i >= 0; i-- {
 			// If this resource is explicitly marked for deletion or wasn't seen at all, delete it.
@@ -439,7 +564,30 @@ func (sg *stepGenerator) GenerateDeletes() []Step {
 				logging.V(7).Infof("Planner decided to delete '%v' due to replacement", res.URN)
 				sg.deletes[res.URN] = true
 				dels = append(dels, NewDeleteReplacementStep(sg.plan, res, true))
-			} else if !sg.sames[res.URN] && !sg.updates[res.URN] && !sg.replaces[res.URN] && !sg.reads[res.URN] {
+			} else if _, aliased := sg.aliased[res.URN]; aliased {
+				// This resource has been taken over by a resource that aliases it, so there is nothing to delete.
+				logging.V(7).Infof("Planner decided not to delete '%v' (aliased by '%v')", res.URN, sg.aliased[res.URN])
+			} else if !sg.sames[res.URN] && !sg.updates[res.URN] && !sg.replaces[res.URN] && !sg.reads[res.URN] &&
+				!sg.imports[res.URN] {
+				// If this resource is not a target of the plan, leave it in place.
+				if !sg.isTarget(res.URN) {
+					logging.V(7).Infof("Planner decided not to delete '%v' (not a target)", res.URN)
+					continue
+				}
+
+				// If we are only changing a subset of the resources, we must not delete a resource that has dependents
+				// or children that will be left untouched. Because we walk the list of resources backwards, any
+				// dependents that are to be deleted have already been marked as such.
+				if sg.targets != nil {
+					for _, dependent := range sg.plan.depGraph.DependingOn(res, true) {
+						if !sg.isTarget(dependent.URN) && !sg.deletes[dependent.URN] {
+							sg.plan.Diag().Errorf(
+								diag.GetUntargetedDeleteDependentError(res.URN), res.URN, dependent.URN)
+							invalid = true
+						}
+					}
+				}
+
 				// NOTE: we deliberately do not check sg.deletes here, as it is possible for us to issue multiple
 				// delete steps for the same URN if the old checkpoint contained pending deletes.
 				logging.V(7).Infof("Planner decided to delete '%v'", res.URN)
@@ -448,12 +596,53 @@ func (sg *stepGenerator) GenerateDeletes() []Step {
 			}
 		}
 	}
-	return dels
+
+	if invalid {
+		return nil, result.Bail()
+	}
+	return dels, nil
+}
+
+// ScheduledDelete is a delete step together with the positions, within its schedule, of the deletes that must finish
+// before it may begin: those of the resources that depend on its resource or are its children.
+type ScheduledDelete struct {
+	Step       Step
+	Dependents []int
+}
+
+// ScheduleDeletes determines, for each of the given delete steps, which must be in the order produced by
+// GenerateDeletes or GeneratePendingDeletes, the deletes that must finish before it may begin. A resource is never
+// deleted before any resource that depends on it or is its child; deletes that are independent of one another may be
+// executed concurrently.
+func (sg *stepGenerator) ScheduleDeletes(deletes []Step) []ScheduledDelete {
+	if len(deletes) == 0 {
+		return nil
+	}
+	contract.Assert(sg.plan.depGraph != nil)
+
+	// The deletes are in reverse topological order, so every resource that depends on a resource is scheduled before
+	// it. Each delete therefore records itself as a dependent of the deletes of its resource's dependencies.
+	positions := make(map[*resource.State]int)
+	for i, step := range deletes {
+		positions[step.Old()] = i
+	}
+	schedule := make([]ScheduledDelete, len(deletes))
+	for i, step := range deletes {
+		schedule[i].Step = step
+		for _, dependency := range sg.plan.depGraph.DependenciesOf(step.Old()) {
+			if j, has := positions[dependency]; has && j > i {
+				schedule[j].Dependents = append(schedule[j].Dependents, i)
+			}
+		}
+	}
+
+	logging.V(7).Infof("Planner scheduled %d deletes", len(deletes))
+	return schedule
 }
 
 // GeneratePendingDeletes generates delete steps for all resources that are pending deletion. This function should be
 // called at the start of a plan in order to find all resources that are pending deletion from the prevous plan.
-func (sg *stepGenerator) GeneratePendingDeletes() []Step {
+func (sg *stepGenerator) GeneratePendingDeletes() ([]Step, *result.Result) {
 	var dels []Step
 	if prev := sg.plan.prev; prev != nil {
 		logging.V(7).Infof("stepGenerator.GeneratePendingDeletes(): scanning previous snapshot for pending deletes")
@@ -467,7 +656,23 @@ func (sg *stepGenerator) GeneratePendingDeletes() []Step {
 			}
 		}
 	}
-	return dels
+	return sg.checkPlanned(dels)
+}
+
+// checkPlanned records the given steps in the saved plan being recorded, if any, and verifies them against the saved
+// plan being followed, if any. An error is returned if any step deviates from the saved plan.
+func (sg *stepGenerator) checkPlanned(steps []Step) ([]Step, *result.Result) {
+	for _, step := range steps {
+		if sg.opts.RecordPlan != nil {
+			sg.opts.RecordPlan.Steps = append(sg.opts.RecordPlan.Steps, NewPlannedStep(step))
+		}
+		if sg.planned != nil {
+			if err := sg.planned.Check(step); err != nil {
+				return nil, result.FromError(err)
+			}
+		}
+	}
+	return steps, nil
 }
 
 // diff returns a DiffResult for the given resource.
@@ -500,9 +705,43 @@ func (sg *stepGenerator) diff(urn resource.URN, id resource.ID, oldInputs, oldOu
 	if diff.Changes == plugin.DiffUnknown {
 		diff.Changes = plugin.DiffSome
 	}
+
+	// If the provider did not list the properties that require a replacement, but its detailed diff reports changes
+	// that do, the resource must be replaced all the same.
+	if len(diff.ReplaceKeys) == 0 && diff.DetailedDiff != nil {
+		if diff.ReplaceKeys = plugin.DetailedDiffReplaceKeys(diff.DetailedDiff); len(diff.ReplaceKeys) > 0 {
+			diff.Changes = plugin.DiffSome
+		}
+	}
 	return diff, nil
 }
 
+// processIgnoreChanges returns a copy of the given new inputs in which the value of each ignored property path has been
+// replaced with its value in the old inputs. If a path does not refer to a value in the old inputs, it is removed from
+// the new inputs.
+func processIgnoreChanges(urn resource.URN, inputs, oldInputs resource.PropertyMap,
+	ignoreChanges []string) (resource.PropertyMap, *result.Result) {
+
+	news, olds := resource.NewObjectProperty(inputs), resource.NewObjectProperty(oldInputs)
+	for _, ignoreChange := range ignoreChanges {
+		path, err := resource.ParsePropertyPath(ignoreChange)
+		if err != nil {
+			return nil, result.Errorf("bad ignoreChanges entry for resource '%v': %v", urn, err)
+		}
+
+		if old, hasOld := path.Get(olds); hasOld {
+			var ok bool
+			if news, ok = path.Set(news, old); !ok {
+				return nil, result.Errorf(
+					"cannot ignore changes to '%v' for resource '%v': its path does not exist in the new inputs", path, urn)
+			}
+		} else {
+			news, _ = path.Delete(news)
+		}
+	}
+	return news.ObjectValue(), nil
+}
+
 // issueCheckErrors prints any check errors to the diagnostics sink.
 func (sg *stepGenerator) issueCheckErrors(new *resource.State, urn resource.URN,
 	failures []plugin.CheckFailure) bool {
@@ -524,16 +763,24 @@ func (sg *stepGenerator) issueCheckErrors(new *resource.State, urn resource.URN,
 
 // newStepGenerator creates a new step generator that operates on the given plan.
 func newStepGenerator(plan *Plan, opts Options) *stepGenerator {
+	var planned *planChecker
+	if opts.Plan != nil {
+		planned = newPlanChecker(opts.Plan)
+	}
 	return &stepGenerator{
 		plan:           plan,
 		opts:           opts,
 		urns:           make(map[resource.URN]bool),
 		reads:          make(map[resource.URN]bool),
 		creates:        make(map[resource.URN]bool),
+		imports:        make(map[resource.URN]bool),
 		sames:          make(map[resource.URN]bool),
 		replaces:       make(map[resource.URN]bool),
 		updates:        make(map[resource.URN]bool),
 		deletes:        make(map[resource.URN]bool),
 		pendingDeletes: make(map[*resource.State]bool),
+		targets:        plan.targets(opts),
+		aliased:        make(map[resource.URN]resource.URN),
+		planned:        planned,
 	}
 }
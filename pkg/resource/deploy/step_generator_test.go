@@ -0,0 +1,62 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/graph"
+)
+
+func TestScheduleDeletes(t *testing.T) {
+	a := newResource("a")
+	b := newResource("b")
+	b.Dependencies = []resource.URN{a.URN}
+	c := newResource("c")
+	c.Parent = a.URN
+	d := newResource("d")
+	e := newResource("e")
+	e.Dependencies = []resource.URN{b.URN, d.URN}
+
+	resources := []*resource.State{a, b, c, d, e}
+	plan := &Plan{prev: newSnapshot(resources, nil), depGraph: graph.NewDependencyGraph(resources)}
+	sg := newStepGenerator(plan, Options{})
+
+	deletes, res := sg.GenerateDeletes()
+	assert.Nil(t, res)
+	assert.Len(t, deletes, 5)
+
+	// Each delete must wait for the deletes of the resources that depend on it or are its children, and only those.
+	schedule := sg.ScheduleDeletes(deletes)
+	assert.Len(t, schedule, 5)
+	dependents := make(map[resource.URN][]resource.URN)
+	for i, del := range schedule {
+		assert.Equal(t, deletes[i], del.Step)
+		for _, j := range del.Dependents {
+			assert.True(t, j < i)
+			dependents[del.Step.URN()] = append(dependents[del.Step.URN()], schedule[j].Step.URN())
+		}
+	}
+	assert.Equal(t, map[resource.URN][]resource.URN{
+		a.URN: {c.URN, b.URN},
+		b.URN: {e.URN},
+		d.URN: {e.URN},
+	}, dependents)
+
+	assert.Nil(t, sg.ScheduleDeletes(nil))
+}
@@ -0,0 +1,47 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+// ResourceHasDependenciesError is returned when an edit would leave other resources referring to a resource that
+// no longer exists, either as their parent, as one of their dependencies, or as their provider.
+type ResourceHasDependenciesError struct {
+	Condemned    *resource.State
+	Dependencies []*resource.State
+}
+
+func (r ResourceHasDependenciesError) Error() string {
+	urns := make([]string, len(r.Dependencies))
+	for i, dep := range r.Dependencies {
+		urns[i] = "    " + string(dep.URN)
+	}
+	return fmt.Sprintf("the following resources refer to %s; pass --cascade to include them as well:\n%s",
+		r.Condemned.URN, strings.Join(urns, "\n"))
+}
+
+// ResourceProtectedError is returned when an edit would delete a resource that is protected.
+type ResourceProtectedError struct {
+	Condemned *resource.State
+}
+
+func (r ResourceProtectedError) Error() string {
+	return fmt.Sprintf("%s is protected; run `pulumi state unprotect` on it first", r.Condemned.URN)
+}
@@ -0,0 +1,344 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package edit contains operations that edit the resources in a snapshot directly, without running a deployment.
+// Each operation either leaves the snapshot well-formed or fails without modifying it.
+package edit
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+)
+
+// DeleteResource deletes the given resource from the snapshot. If other resources refer to the condemned resource and
+// cascade is false, a ResourceHasDependenciesError is returned; if cascade is true, those resources are deleted too.
+// Protected resources are never deleted.
+func DeleteResource(snap *deploy.Snapshot, condemned *resource.State, cascade bool) error {
+	contract.Require(snap != nil, "snap")
+	contract.Require(condemned != nil, "condemned")
+
+	if err := checkEditable(snap, condemned); err != nil {
+		return err
+	}
+
+	deleted := []*resource.State{condemned}
+	if dependents := dependentsOf(snap, condemned); len(dependents) > 0 {
+		if !cascade {
+			return ResourceHasDependenciesError{Condemned: condemned, Dependencies: dependents}
+		}
+		deleted = append(deleted, dependents...)
+	}
+	for _, res := range deleted {
+		if res.Protect {
+			return ResourceProtectedError{Condemned: res}
+		}
+		if err := checkNoPendingOperation(snap, res); err != nil {
+			return err
+		}
+	}
+
+	snap.Resources = without(snap.Resources, deleted)
+	return nil
+}
+
+// UnprotectResource clears the protect bit on the given resource, allowing it to be deleted.
+func UnprotectResource(snap *deploy.Snapshot, res *resource.State) error {
+	contract.Require(snap != nil, "snap")
+	contract.Require(res != nil, "res")
+
+	res.Protect = false
+	return nil
+}
+
+// RenameResource changes the name of the given resource, and hence its URN, to newName. All references to the
+// resource from other resources in the snapshot are updated to refer to the new URN.
+func RenameResource(snap *deploy.Snapshot, res *resource.State, newName tokens.QName) error {
+	contract.Require(snap != nil, "snap")
+	contract.Require(res != nil, "res")
+
+	if err := checkEditable(snap, res); err != nil {
+		return err
+	}
+	if err := checkNoPendingOperation(snap, res); err != nil {
+		return err
+	}
+	if newName == "" {
+		return errors.New("the new name must not be empty")
+	}
+
+	oldURN := res.URN
+	newURN := resource.NewURN(oldURN.Stack(), oldURN.Project(), "", oldURN.QualifiedType(), newName)
+	if findResource(snap, newURN) != nil {
+		return errors.Errorf("a resource named %s already exists", newURN)
+	}
+
+	fix := func(urn resource.URN) (resource.URN, bool) {
+		if urn == oldURN {
+			return newURN, true
+		}
+		return urn, false
+	}
+	for _, other := range snap.Resources {
+		if other.Provider != "" {
+			if _, err := providers.ParseReference(other.Provider); err != nil {
+				return errors.Wrapf(err, "parsing provider reference for %s", other.URN)
+			}
+		}
+	}
+
+	// Now that the edit cannot fail, update every reference to the resource.
+	for _, other := range snap.Resources {
+		rewriteReferences(other, fix)
+	}
+	res.URN = newURN
+	return nil
+}
+
+// MoveResource moves the given resource from the src snapshot into the dest snapshot, which belongs to the stack
+// destStack. If other resources in src refer to the resource and cascade is false, a ResourceHasDependenciesError is
+// returned; if cascade is true, those resources are moved too. The resources being moved may not depend on any
+// other resources except for their providers, which are copied into dest, and the root stack resource, which is
+// replaced by dest's root stack resource.
+func MoveResource(src, dest *deploy.Snapshot, res *resource.State, destStack tokens.QName, cascade bool) error {
+	contract.Require(src != nil, "src")
+	contract.Require(dest != nil, "dest")
+	contract.Require(res != nil, "res")
+
+	if err := checkEditable(src, res); err != nil {
+		return err
+	}
+
+	moving := []*resource.State{res}
+	if dependents := dependentsOf(src, res); len(dependents) > 0 {
+		if !cascade {
+			return ResourceHasDependenciesError{Condemned: res, Dependencies: dependents}
+		}
+		moving = append(moving, dependents...)
+	}
+
+	destURN := func(urn resource.URN) resource.URN {
+		return resource.NewURN(destStack, urn.Project(), "", urn.QualifiedType(), urn.Name())
+	}
+	movingURNs := make(map[resource.URN]bool)
+	for _, m := range moving {
+		if err := checkNoPendingOperation(src, m); err != nil {
+			return err
+		}
+		if findResource(dest, destURN(m.URN)) != nil {
+			return errors.Errorf("a resource named %s already exists in stack %s", destURN(m.URN), destStack)
+		}
+		movingURNs[m.URN] = true
+	}
+
+	// Any parent that is not being moved must be the source stack's root resource, which is replaced by the
+	// destination stack's root resource.
+	var destRoot resource.URN
+	for _, d := range dest.Resources {
+		if d.Type == resource.RootStackType {
+			destRoot = d.URN
+		}
+	}
+	fixParent := func(res *resource.State) error {
+		switch {
+		case res.Parent == "":
+			return nil
+		case movingURNs[res.Parent]:
+			res.Parent = destURN(res.Parent)
+		case res.Parent.Type() == resource.RootStackType && destRoot != "":
+			res.Parent = destRoot
+		case res.Parent.Type() == resource.RootStackType:
+			return errors.Errorf("stack %s has no root resource; run `pulumi up` on it first", destStack)
+		default:
+			return errors.Errorf("%s's parent %s is not being moved", res.URN, res.Parent)
+		}
+		return nil
+	}
+
+	// Providers that are not being moved are copied, unless the destination already has a provider by that name.
+	var copiedProviders []*resource.State
+	fixProvider := func(res *resource.State) error {
+		if res.Provider == "" {
+			return nil
+		}
+		ref, err := providers.ParseReference(res.Provider)
+		if err != nil {
+			return errors.Wrapf(err, "parsing provider reference for %s", res.URN)
+		}
+		newURN, id := destURN(ref.URN()), ref.ID()
+		if !movingURNs[ref.URN()] {
+			if existing := findResource(dest, newURN); existing != nil {
+				id = existing.ID
+			} else if findResource(&deploy.Snapshot{Resources: copiedProviders}, newURN) == nil {
+				prov := findResource(src, ref.URN())
+				if prov == nil {
+					return errors.Errorf("%s refers to unknown provider %s", res.URN, ref)
+				}
+				clone := *prov
+				clone.URN = newURN
+				if err = fixParent(&clone); err != nil {
+					return err
+				}
+				if len(clone.Dependencies) > 0 {
+					return errors.Errorf("provider %s has dependencies and cannot be copied", prov.URN)
+				}
+				copiedProviders = append(copiedProviders, &clone)
+			}
+		}
+		newRef, err := providers.NewReference(newURN, id)
+		if err != nil {
+			return err
+		}
+		res.Provider = newRef.String()
+		return nil
+	}
+
+	var moved []*resource.State
+	for _, m := range moving {
+		clone := *m
+		clone.Dependencies = make([]resource.URN, len(m.Dependencies))
+		for i, dep := range m.Dependencies {
+			if !movingURNs[dep] {
+				return errors.Errorf("%s depends on %s, which is not being moved", m.URN, dep)
+			}
+			clone.Dependencies[i] = destURN(dep)
+		}
+		if err := fixParent(&clone); err != nil {
+			return err
+		}
+		if err := fixProvider(&clone); err != nil {
+			return err
+		}
+		clone.URN = destURN(m.URN)
+		moved = append(moved, &clone)
+	}
+
+	dest.Resources = append(dest.Resources, copiedProviders...)
+	dest.Resources = append(dest.Resources, moved...)
+	src.Resources = without(src.Resources, moving)
+	return nil
+}
+
+// checkEditable returns an error if the given resource may not be edited directly.
+func checkEditable(snap *deploy.Snapshot, res *resource.State) error {
+	if res.Type == resource.RootStackType {
+		return errors.Errorf("%s is the stack's root resource and may not be edited", res.URN)
+	}
+	for _, other := range snap.Resources {
+		if other != res && other.URN == res.URN {
+			return errors.Errorf("there are multiple resources named %s; run `pulumi refresh` or "+
+				"`pulumi up` to clean up resources that are pending deletion first", res.URN)
+		}
+	}
+	return nil
+}
+
+// checkNoPendingOperation returns an error if the given resource has an operation pending.
+func checkNoPendingOperation(snap *deploy.Snapshot, res *resource.State) error {
+	for _, op := range snap.PendingOperations {
+		if op.Resource.URN == res.URN {
+			return errors.Errorf("%s has a pending %s operation; run `pulumi refresh` to resolve it first",
+				res.URN, op.Type)
+		}
+	}
+	return nil
+}
+
+// dependentsOf returns the resources in the snapshot that refer to the given resource, directly or indirectly,
+// as their parent, as one of their dependencies, or as their provider. The result is in snapshot order.
+func dependentsOf(snap *deploy.Snapshot, res *resource.State) []*resource.State {
+	// Snapshots are topologically sorted, so every dependent of a resource comes after it.
+	referenced := map[resource.URN]bool{res.URN: true}
+	refersToAny := func(candidate *resource.State) bool {
+		if referenced[candidate.Parent] {
+			return true
+		}
+		for _, dep := range candidate.Dependencies {
+			if referenced[dep] {
+				return true
+			}
+		}
+		if candidate.Provider != "" {
+			if ref, err := providers.ParseReference(candidate.Provider); err == nil && referenced[ref.URN()] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var dependents []*resource.State
+	found := false
+	for _, candidate := range snap.Resources {
+		if candidate == res {
+			found = true
+			continue
+		}
+		if found && refersToAny(candidate) {
+			dependents = append(dependents, candidate)
+			referenced[candidate.URN] = true
+		}
+	}
+	return dependents
+}
+
+// rewriteReferences rewrites the parent, dependencies, and provider reference of the given resource using fix. The
+// resource's provider reference, if any, must be valid.
+func rewriteReferences(res *resource.State, fix func(resource.URN) (resource.URN, bool)) {
+	if parent, fixed := fix(res.Parent); fixed {
+		res.Parent = parent
+	}
+	for i, dep := range res.Dependencies {
+		if newDep, fixed := fix(dep); fixed {
+			res.Dependencies[i] = newDep
+		}
+	}
+	if res.Provider != "" {
+		ref, err := providers.ParseReference(res.Provider)
+		contract.AssertNoError(err)
+		if newURN, fixed := fix(ref.URN()); fixed {
+			newRef, err := providers.NewReference(newURN, ref.ID())
+			contract.AssertNoError(err)
+			res.Provider = newRef.String()
+		}
+	}
+}
+
+// findResource returns the resource in the snapshot with the given URN, or nil if there is none.
+func findResource(snap *deploy.Snapshot, urn resource.URN) *resource.State {
+	for _, res := range snap.Resources {
+		if res.URN == urn {
+			return res
+		}
+	}
+	return nil
+}
+
+// without returns the given resources without any of the removed resources.
+func without(resources []*resource.State, removed []*resource.State) []*resource.State {
+	set := make(map[*resource.State]bool)
+	for _, r := range removed {
+		set[r] = true
+	}
+	var result []*resource.State
+	for _, r := range resources {
+		if !set[r] {
+			result = append(result, r)
+		}
+	}
+	return result
+}
@@ -0,0 +1,184 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+func newResource(stack tokens.QName, parent *resource.State, typ tokens.Type, name string,
+	provider *resource.State, deps ...*resource.State) *resource.State {
+
+	var parentURN resource.URN
+	var parentType tokens.Type
+	if parent != nil {
+		// As in the engine, the root stack type is not included in the qualified types of its children.
+		parentURN = parent.URN
+		if parent.Type != resource.RootStackType {
+			parentType = parent.URN.QualifiedType()
+		}
+	}
+	var depURNs []resource.URN
+	for _, d := range deps {
+		depURNs = append(depURNs, d.URN)
+	}
+	var prov string
+	custom := false
+	id := resource.ID("")
+	if provider != nil {
+		ref, err := providers.NewReference(provider.URN, provider.ID)
+		if err != nil {
+			panic(err)
+		}
+		prov, custom, id = ref.String(), true, resource.ID(name+"-id")
+	}
+	if providers.IsProviderType(typ) {
+		custom, id = true, resource.ID(name+"-id")
+	}
+	urn := resource.NewURN(stack, "proj", parentType, typ, tokens.QName(name))
+	return resource.NewState(typ, urn, custom, false, id, resource.PropertyMap{}, resource.PropertyMap{},
+		parentURN, false, false, depURNs, nil, prov, resource.CustomTimeouts{})
+}
+
+// newTestSnapshot returns a snapshot containing a root stack resource, a provider, a component, a resource that is a
+// child of the component, a resource that depends on that child, and an unrelated resource.
+func newTestSnapshot(stack tokens.QName) *deploy.Snapshot {
+	root := newResource(stack, nil, resource.RootStackType, "proj-"+string(stack), nil)
+	prov := newResource(stack, root, providers.MakeProviderType("pkg"), "default", nil)
+	component := newResource(stack, root, "my:module:Component", "comp", nil)
+	child := newResource(stack, component, "pkg:module:Resource", "child", prov)
+	dependent := newResource(stack, root, "pkg:module:Resource", "dependent", prov, child)
+	unrelated := newResource(stack, root, "pkg:module:Resource", "unrelated", prov)
+	return deploy.NewSnapshot(deploy.Manifest{}, []*resource.State{
+		root, prov, component, child, dependent, unrelated,
+	}, nil)
+}
+
+func urnsOf(snap *deploy.Snapshot) []resource.URN {
+	var urns []resource.URN
+	for _, res := range snap.Resources {
+		urns = append(urns, res.URN)
+	}
+	return urns
+}
+
+func TestDeleteResource(t *testing.T) {
+	snap := newTestSnapshot("dev")
+	component, child, dependent := snap.Resources[2], snap.Resources[3], snap.Resources[4]
+
+	// Deleting a resource that others refer to must fail without --cascade, and must leave the snapshot alone.
+	err := DeleteResource(snap, component, false)
+	if assert.Error(t, err) {
+		deps, ok := err.(ResourceHasDependenciesError)
+		if assert.True(t, ok) {
+			assert.Equal(t, []*resource.State{child, dependent}, deps.Dependencies)
+		}
+	}
+	assert.Len(t, snap.Resources, 6)
+
+	// Protected dependents may not be deleted, even with --cascade.
+	dependent.Protect = true
+	err = DeleteResource(snap, component, true)
+	assert.IsType(t, ResourceProtectedError{}, err)
+	assert.Len(t, snap.Resources, 6)
+
+	// Once unprotected, the component and everything that refers to it is deleted.
+	assert.NoError(t, UnprotectResource(snap, dependent))
+	assert.NoError(t, DeleteResource(snap, component, true))
+	assert.Len(t, snap.Resources, 3)
+	assert.NoError(t, snap.VerifyIntegrity())
+
+	// The root stack resource may never be deleted.
+	assert.Error(t, DeleteResource(snap, snap.Resources[0], true))
+}
+
+func TestDeletePendingResource(t *testing.T) {
+	snap := newTestSnapshot("dev")
+	unrelated := snap.Resources[5]
+	snap.PendingOperations = []resource.Operation{resource.NewOperation(unrelated, resource.OperationTypeUpdating)}
+
+	assert.Error(t, DeleteResource(snap, unrelated, false))
+	assert.Len(t, snap.Resources, 6)
+}
+
+func TestRenameResource(t *testing.T) {
+	snap := newTestSnapshot("dev")
+	prov, child, dependent, unrelated := snap.Resources[1], snap.Resources[3], snap.Resources[4], snap.Resources[5]
+
+	// Renaming to the name of an existing resource of the same type must fail.
+	assert.Error(t, RenameResource(snap, unrelated, "dependent"))
+
+	// Renaming a resource updates its URN and all references to it.
+	assert.NoError(t, RenameResource(snap, child, "renamed"))
+	assert.Equal(t, resource.URN("urn:pulumi:dev::proj::my:module:Component$pkg:module:Resource::renamed"), child.URN)
+	assert.Equal(t, []resource.URN{child.URN}, dependent.Dependencies)
+	assert.NoError(t, snap.VerifyIntegrity())
+
+	// Renaming a provider updates the provider references of the resources that use it.
+	assert.NoError(t, RenameResource(snap, prov, "renamed-provider"))
+	ref, err := providers.ParseReference(unrelated.Provider)
+	assert.NoError(t, err)
+	assert.Equal(t, prov.URN, ref.URN())
+	assert.Equal(t, prov.ID, ref.ID())
+	assert.NoError(t, snap.VerifyIntegrity())
+}
+
+func TestMoveResource(t *testing.T) {
+	src, dest := newTestSnapshot("dev"), newTestSnapshot("prod")
+	dest.Resources = dest.Resources[:1] // just the root stack resource.
+	component, unrelated := src.Resources[2], src.Resources[5]
+
+	// Moving a resource that others refer to must fail without --cascade.
+	err := MoveResource(src, dest, component, "prod", false)
+	assert.IsType(t, ResourceHasDependenciesError{}, err)
+	assert.Len(t, src.Resources, 6)
+	assert.Len(t, dest.Resources, 1)
+
+	// Moving a leaf resource copies its provider and reparents it to the destination's root stack resource.
+	assert.NoError(t, MoveResource(src, dest, unrelated, "prod", false))
+	assert.Equal(t, []resource.URN{
+		"urn:pulumi:prod::proj::pulumi:pulumi:Stack::proj-prod",
+		"urn:pulumi:prod::proj::pulumi:providers:pkg::default",
+		"urn:pulumi:prod::proj::pkg:module:Resource::unrelated",
+	}, urnsOf(dest))
+	assert.Equal(t, dest.Resources[0].URN, dest.Resources[2].Parent)
+	assert.Len(t, src.Resources, 5)
+	assert.NoError(t, src.VerifyIntegrity())
+	assert.NoError(t, dest.VerifyIntegrity())
+
+	// Moving with --cascade moves the dependents too, and reuses the already-copied provider.
+	assert.NoError(t, MoveResource(src, dest, component, "prod", true))
+	assert.Equal(t, []resource.URN{
+		"urn:pulumi:prod::proj::pulumi:pulumi:Stack::proj-prod",
+		"urn:pulumi:prod::proj::pulumi:providers:pkg::default",
+		"urn:pulumi:prod::proj::pkg:module:Resource::unrelated",
+		"urn:pulumi:prod::proj::my:module:Component::comp",
+		"urn:pulumi:prod::proj::my:module:Component$pkg:module:Resource::child",
+		"urn:pulumi:prod::proj::pkg:module:Resource::dependent",
+	}, urnsOf(dest))
+	assert.Equal(t, []resource.URN{
+		"urn:pulumi:dev::proj::pulumi:pulumi:Stack::proj-dev",
+		"urn:pulumi:dev::proj::pulumi:providers:pkg::default",
+	}, urnsOf(src))
+	assert.NoError(t, src.VerifyIntegrity())
+	assert.NoError(t, dest.VerifyIntegrity())
+}
@@ -15,11 +15,12 @@ type DependencyGraph struct {
 }
 
 // DependingOn returns a slice containing all resources that directly or indirectly
-// depend upon the given resource. The returned slice is guaranteed to be in topological
+// depend upon the given resource. If includeChildren is true, the children of a resource
+// are treated as depending upon it. The returned slice is guaranteed to be in topological
 // order with respect to the snapshot dependency graph.
 //
 // The time complexity of DependingOn is linear with respect to the number of resources.
-func (dg *DependencyGraph) DependingOn(res *resource.State) []*resource.State {
+func (dg *DependencyGraph) DependingOn(res *resource.State, includeChildren bool) []*resource.State {
 	// This implementation relies on the detail that snapshots are stored in a valid
 	// topological order.
 	var dependents []*resource.State
@@ -42,7 +43,7 @@ func (dg *DependencyGraph) DependingOn(res *resource.State) []*resource.State {
 				return true
 			}
 		}
-		return false
+		return includeChildren && dependentSet[candidate.Parent]
 	}
 
 	// The dependency graph encoded directly within the snapshot is the reverse of
@@ -69,6 +70,37 @@ func (dg *DependencyGraph) DependingOn(res *resource.State) []*resource.State {
 	return dependents
 }
 
+// DependenciesOf returns a slice containing the resources upon which the given resource directly depends: its
+// dependencies, its provider, and its parent. Because several resources in a snapshot may share a URN, every earlier
+// resource with a matching URN is included. The returned slice is in snapshot order.
+//
+// The time complexity of DependenciesOf is linear with respect to the number of resources.
+func (dg *DependencyGraph) DependenciesOf(res *resource.State) []*resource.State {
+	cursorIndex, ok := dg.index[res]
+	contract.Assert(ok)
+
+	dependencyURNs := make(map[resource.URN]bool)
+	for _, dependency := range res.Dependencies {
+		dependencyURNs[dependency] = true
+	}
+	if res.Provider != "" {
+		ref, err := providers.ParseReference(res.Provider)
+		contract.Assert(err == nil)
+		dependencyURNs[ref.URN()] = true
+	}
+	if res.Parent != "" {
+		dependencyURNs[res.Parent] = true
+	}
+
+	var dependencies []*resource.State
+	for i := 0; i < cursorIndex; i++ {
+		if candidate := dg.resources[i]; dependencyURNs[candidate.URN] {
+			dependencies = append(dependencies, candidate)
+		}
+	}
+	return dependencies
+}
+
 // NewDependencyGraph creates a new DependencyGraph from a list of resources.
 // The resources should be in topological order with respect to their dependencies.
 func NewDependencyGraph(resources []*resource.State) *DependencyGraph {
@@ -63,22 +63,22 @@ func TestBasicGraph(t *testing.T) {
 
 	assert.Equal(t, []*resource.State{
 		a, b, pB, c, d,
-	}, dg.DependingOn(pA))
+	}, dg.DependingOn(pA, false))
 
 	assert.Equal(t, []*resource.State{
 		b, pB, c, d,
-	}, dg.DependingOn(a))
+	}, dg.DependingOn(a, false))
 
 	assert.Equal(t, []*resource.State{
 		pB, c, d,
-	}, dg.DependingOn(b))
+	}, dg.DependingOn(b, false))
 
 	assert.Equal(t, []*resource.State{
 		c,
-	}, dg.DependingOn(pB))
+	}, dg.DependingOn(pB, false))
 
-	assert.Nil(t, dg.DependingOn(c))
-	assert.Nil(t, dg.DependingOn(d))
+	assert.Nil(t, dg.DependingOn(c, false))
+	assert.Nil(t, dg.DependingOn(d, false))
 }
 
 // Tests that we don't add the same node to the DependingOn set twice.
@@ -97,5 +97,53 @@ func TestGraphNoDuplicates(t *testing.T) {
 
 	assert.Equal(t, []*resource.State{
 		b, c, d,
-	}, dg.DependingOn(a))
+	}, dg.DependingOn(a, false))
+}
+
+// Tests that children are only included in the DependingOn set when requested.
+func TestGraphChildren(t *testing.T) {
+	a := NewResource("a", nil)
+	b := NewResource("b", nil)
+	b.Parent = a.URN
+	c := NewResource("c", nil, b.URN)
+	d := NewResource("d", nil)
+
+	dg := NewDependencyGraph([]*resource.State{
+		a,
+		b,
+		c,
+		d,
+	})
+
+	assert.Nil(t, dg.DependingOn(a, false))
+	assert.Equal(t, []*resource.State{
+		b, c,
+	}, dg.DependingOn(a, true))
+}
+
+func TestDependenciesOf(t *testing.T) {
+	pA := NewProviderResource("test", "pA", "0")
+	a := NewResource("a", pA)
+	b := NewResource("b", pA, a.URN)
+	c := NewResource("c", nil)
+	c.Parent = b.URN
+	a2 := NewResource("a", nil)
+	d := NewResource("d", nil, a.URN)
+
+	dg := NewDependencyGraph([]*resource.State{
+		pA,
+		a,
+		b,
+		c,
+		a2,
+		d,
+	})
+
+	assert.Nil(t, dg.DependenciesOf(pA))
+	assert.Equal(t, []*resource.State{pA}, dg.DependenciesOf(a))
+	assert.Equal(t, []*resource.State{pA, a}, dg.DependenciesOf(b))
+	assert.Equal(t, []*resource.State{b}, dg.DependenciesOf(c))
+
+	// Every earlier resource that shares a dependency's URN is a dependency.
+	assert.Equal(t, []*resource.State{a, a2}, dg.DependenciesOf(d))
 }
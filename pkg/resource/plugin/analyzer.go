@@ -32,12 +32,38 @@ type Analyzer interface {
 	Name() tokens.QName
 	// Analyze analyzes a single resource object, and returns any errors that it finds.
 	Analyze(t tokens.Type, props resource.PropertyMap) ([]AnalyzeFailure, error)
+	// AnalyzeStack analyzes all resources in a stack at the end of a successful preview or update, and returns any
+	// errors that it finds.
+	AnalyzeStack(resources []AnalyzerResource) ([]AnalyzeFailure, error)
 	// GetPluginInfo returns this plugin's information.
 	GetPluginInfo() (workspace.PluginInfo, error)
 }
 
+// AnalyzeSeverity indicates whether an analysis failure must block a preview or update.
+type AnalyzeSeverity int
+
+const (
+	// AnalyzeMandatory indicates that the failure blocks the preview or update.
+	AnalyzeMandatory AnalyzeSeverity = 0
+	// AnalyzeAdvisory indicates that the failure is reported as a warning, but does not block the preview or update.
+	AnalyzeAdvisory AnalyzeSeverity = 1
+)
+
 // AnalyzeFailure indicates that resource analysis failed; it contains the property and reason for the failure.
 type AnalyzeFailure struct {
 	Property resource.PropertyKey // the property that failed the analysis.
 	Reason   string               // the reason the property failed the analysis.
+	Severity AnalyzeSeverity      // the severity of the failure.
+	URN      resource.URN         // the resource that failed stack analysis (or "" if the failure is stack-wide).
+}
+
+// AnalyzerResource describes a single resource in a stack for the purposes of stack analysis.
+type AnalyzerResource struct {
+	URN          resource.URN         // the resource's URN.
+	Type         tokens.Type          // the resource's type.
+	Inputs       resource.PropertyMap // the resource's input properties.
+	Outputs      resource.PropertyMap // the resource's output properties.
+	Parent       resource.URN         // an optional parent URN that this resource belongs to.
+	Dependencies []resource.URN       // the resources that this resource depends on.
+	Provider     string               // the resource's provider reference.
 }
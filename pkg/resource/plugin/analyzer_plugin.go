@@ -93,15 +93,72 @@ func (a *analyzer) Analyze(t tokens.Type, props resource.PropertyMap) ([]Analyze
 		return nil, rpcError
 	}
 
+	failures := convertAnalyzeFailures(resp.GetFailures())
+	logging.V(7).Infof("%s success: failures=#%d", label, len(failures))
+	return failures, nil
+}
+
+// AnalyzeStack analyzes all resources in a stack at the end of a successful preview or update, and returns any
+// errors that it finds.
+func (a *analyzer) AnalyzeStack(resources []AnalyzerResource) ([]AnalyzeFailure, error) {
+	label := fmt.Sprintf("%s.AnalyzeStack()", a.label())
+	logging.V(7).Infof("%s executing (#resources=%d)", label, len(resources))
+
+	protoResources := make([]*pulumirpc.AnalyzerResource, len(resources))
+	for i, res := range resources {
+		inputs, err := MarshalProperties(res.Inputs, MarshalOptions{
+			Label: fmt.Sprintf("%s.%s.inputs", label, res.URN), KeepUnknowns: true})
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := MarshalProperties(res.Outputs, MarshalOptions{
+			Label: fmt.Sprintf("%s.%s.outputs", label, res.URN), KeepUnknowns: true})
+		if err != nil {
+			return nil, err
+		}
+
+		var deps []string
+		for _, dep := range res.Dependencies {
+			deps = append(deps, string(dep))
+		}
+
+		protoResources[i] = &pulumirpc.AnalyzerResource{
+			Urn:          string(res.URN),
+			Type:         string(res.Type),
+			Inputs:       inputs,
+			Outputs:      outputs,
+			Parent:       string(res.Parent),
+			Dependencies: deps,
+			Provider:     res.Provider,
+		}
+	}
+
+	resp, err := a.client.AnalyzeStack(a.ctx.Request(), &pulumirpc.AnalyzeStackRequest{
+		Resources: protoResources,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError)
+		return nil, rpcError
+	}
+
+	failures := convertAnalyzeFailures(resp.GetFailures())
+	logging.V(7).Infof("%s success: failures=#%d", label, len(failures))
+	return failures, nil
+}
+
+// convertAnalyzeFailures converts the failures in an analyzer's response into AnalyzeFailures.
+func convertAnalyzeFailures(protoFailures []*pulumirpc.AnalyzeFailure) []AnalyzeFailure {
 	var failures []AnalyzeFailure
-	for _, failure := range resp.GetFailures() {
+	for _, failure := range protoFailures {
 		failures = append(failures, AnalyzeFailure{
-			Property: resource.PropertyKey(failure.Property),
-			Reason:   failure.Reason,
+			Property: resource.PropertyKey(failure.GetProperty()),
+			Reason:   failure.GetReason(),
+			Severity: AnalyzeSeverity(failure.GetSeverity()),
+			URN:      resource.URN(failure.GetUrn()),
 		})
 	}
-	logging.V(7).Infof("%s success: failures=#%d", label, len(failures))
-	return failures, nil
+	return failures
 }
 
 // GetPluginInfo returns this plugin's information.
@@ -52,6 +52,7 @@ type RunInfo struct {
 	Program        string                // the path to the program to execute.
 	Args           []string              // any arguments to pass to the program.
 	Config         map[config.Key]string // the configuration variables to apply before running.
+	ConfigSecrets  []config.Key          // the configuration keys whose values are secrets.
 	DryRun         bool                  // true if we are performing a dry-run (preview).
 	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
 }
@@ -137,16 +137,21 @@ func (h *langhost) Run(info RunInfo) (string, error) {
 	for k, v := range info.Config {
 		config[k.String()] = v
 	}
+	var configSecretKeys []string
+	for _, k := range info.ConfigSecrets {
+		configSecretKeys = append(configSecretKeys, k.String())
+	}
 	resp, err := h.client.Run(h.ctx.Request(), &pulumirpc.RunRequest{
-		MonitorAddress: info.MonitorAddress,
-		Pwd:            info.Pwd,
-		Program:        info.Program,
-		Args:           info.Args,
-		Project:        info.Project,
-		Stack:          info.Stack,
-		Config:         config,
-		DryRun:         info.DryRun,
-		Parallel:       int32(info.Parallel),
+		MonitorAddress:   info.MonitorAddress,
+		Pwd:              info.Pwd,
+		Program:          info.Program,
+		Args:             info.Args,
+		Project:          info.Project,
+		Stack:            info.Stack,
+		Config:           config,
+		ConfigSecretKeys: configSecretKeys,
+		DryRun:           info.DryRun,
+		Parallel:         int32(info.Parallel),
 	})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
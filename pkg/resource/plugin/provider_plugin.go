@@ -253,6 +253,7 @@ func (p *provider) Check(urn resource.URN,
 		if err != nil {
 			return nil, nil, err
 		}
+		annotateSecrets(inputs, news)
 	}
 
 	// And now any properties that failed verification.
@@ -318,20 +319,31 @@ func (p *provider) Diff(urn resource.URN, id resource.ID,
 	for _, stable := range resp.GetStables() {
 		stables = append(stables, resource.PropertyKey(stable))
 	}
+	var detailedDiff map[string]PropertyDiff
+	if resp.GetHasDetailedDiff() {
+		detailedDiff = make(map[string]PropertyDiff)
+		for path, diff := range resp.GetDetailedDiff() {
+			detailedDiff[path] = PropertyDiff{
+				Kind:      DiffKind(diff.GetKind()),
+				InputDiff: diff.GetInputDiff(),
+			}
+		}
+	}
 	changes := resp.GetChanges()
 	deleteBeforeReplace := resp.GetDeleteBeforeReplace()
-	logging.V(7).Infof("%s success: changes=%d #replaces=%d #stables=%d delbefrepl=%v",
-		label, changes, len(replaces), len(stables), deleteBeforeReplace)
+	logging.V(7).Infof("%s success: changes=%d #replaces=%d #stables=%d delbefrepl=%v #detailed=%d",
+		label, changes, len(replaces), len(stables), deleteBeforeReplace, len(detailedDiff))
 	return DiffResult{
 		Changes:             DiffChanges(changes),
 		ReplaceKeys:         replaces,
 		StableKeys:          stables,
 		DeleteBeforeReplace: deleteBeforeReplace,
+		DetailedDiff:        detailedDiff,
 	}, nil
 }
 
 // Create allocates a new instance of the provided resource and assigns its unique resource.ID and outputs afterwards.
-func (p *provider) Create(urn resource.URN, props resource.PropertyMap) (resource.ID,
+func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout float64) (resource.ID,
 	resource.PropertyMap, resource.Status, error) {
 	contract.Assert(urn != "")
 	contract.Assert(props != nil)
@@ -360,6 +372,7 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap) (resourc
 	resp, err := client.Create(p.ctx.Request(), &pulumirpc.CreateRequest{
 		Urn:        string(urn),
 		Properties: mprops,
+		Timeout:    timeout,
 	})
 	if err != nil {
 		resourceStatus, id, liveObject, resourceError = parseError(err)
@@ -384,6 +397,7 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap) (resourc
 	if err != nil {
 		return "", nil, resourceStatus, err
 	}
+	annotateSecrets(outs, props)
 
 	logging.V(7).Infof("%s success: id=%s; #outs=%d", label, id, len(outs))
 	if resourceError == nil {
@@ -457,14 +471,15 @@ func (p *provider) Read(
 	if err != nil {
 		return nil, resourceStatus, err
 	}
+	annotateSecrets(results, props)
 
 	logging.V(7).Infof("%s success; #outs=%d", label, len(results))
 	return results, resourceStatus, resourceError
 }
 
 // Update updates an existing resource with new values.
-func (p *provider) Update(urn resource.URN, id resource.ID,
-	olds resource.PropertyMap, news resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+func (p *provider) Update(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	timeout float64) (resource.PropertyMap, resource.Status, error) {
 	contract.Assert(urn != "")
 	contract.Assert(id != "")
 	contract.Assert(news != nil)
@@ -496,10 +511,11 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	var resourceError error
 	var resourceStatus = resource.StatusOK
 	resp, err := client.Update(p.ctx.Request(), &pulumirpc.UpdateRequest{
-		Id:   string(id),
-		Urn:  string(urn),
-		Olds: molds,
-		News: mnews,
+		Id:      string(id),
+		Urn:     string(urn),
+		Olds:    molds,
+		News:    mnews,
+		Timeout: timeout,
 	})
 	if err != nil {
 		resourceStatus, _, liveObject, resourceError = parseError(err)
@@ -518,6 +534,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	if err != nil {
 		return nil, resourceStatus, err
 	}
+	annotateSecrets(outs, news)
 
 	logging.V(7).Infof("%s success; #outs=%d", label, len(outs))
 	if resourceError == nil {
@@ -527,7 +544,8 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 }
 
 // Delete tears down an existing resource.
-func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap) (resource.Status, error) {
+func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
 	contract.Assert(urn != "")
 	contract.Assert(id != "")
 
@@ -552,6 +570,7 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 		Id:         string(id),
 		Urn:        string(urn),
 		Properties: mprops,
+		Timeout:    timeout,
 	}); err != nil {
 		resourceStatus, rpcErr := resourceStateAndError(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcErr)
@@ -641,6 +660,25 @@ func (p *provider) GetPluginInfo() (workspace.PluginInfo, error) {
 	}, nil
 }
 
+// GetSchema fetches the schema for this resource provider, if any.
+func (p *provider) GetSchema(version int) ([]byte, error) {
+	label := fmt.Sprintf("%s.GetSchema(%d)", p.label(), version)
+	logging.V(7).Infof("%s executing", label)
+
+	// Like GetPluginInfo, fetching the schema does not require configuration, so we access the raw client.
+	resp, err := p.clientRaw.GetSchema(p.ctx.Request(), &pulumirpc.GetSchemaRequest{
+		Version: int32(version),
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return nil, rpcError
+	}
+
+	logging.V(7).Infof("%s success (#schema=%d)", label, len(resp.GetSchema()))
+	return []byte(resp.GetSchema()), nil
+}
+
 func (p *provider) SignalCancellation() error {
 	_, err := p.clientRaw.Cancel(p.ctx.Request(), &pbempty.Empty{})
 	if err != nil {
@@ -756,3 +794,34 @@ func (ie *InitError) Error() string {
 	}
 	return err.Error()
 }
+
+// annotateSecrets marks as secret each property in outs whose corresponding property in ins is secret, recursing into
+// nested objects and arrays. Providers do not understand secrets, so they receive and return plain values; without
+// this, a secret input would be returned by the provider as an ordinary value and then written to the checkpoint in
+// plaintext.
+func annotateSecrets(outs, ins resource.PropertyMap) {
+	for k, v := range outs {
+		if in, has := ins[k]; has {
+			outs[k] = annotateSecret(v, in)
+		}
+	}
+}
+
+// annotateSecret returns out marked as secret if in is secret. Otherwise, if both values are objects or both are
+// arrays, their elements are annotated in place.
+func annotateSecret(out, in resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case in.IsSecret():
+		if !out.IsSecret() {
+			return resource.MakeSecret(out)
+		}
+	case in.IsObject() && out.IsObject():
+		annotateSecrets(out.ObjectValue(), in.ObjectValue())
+	case in.IsArray() && out.IsArray():
+		outArr, inArr := out.ArrayValue(), in.ArrayValue()
+		for i := 0; i < len(outArr) && i < len(inArr); i++ {
+			outArr[i] = annotateSecret(outArr[i], inArr[i])
+		}
+	}
+	return out
+}
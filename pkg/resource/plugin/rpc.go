@@ -32,6 +32,7 @@ type MarshalOptions struct {
 	SkipNulls          bool   // true to skip nulls altogether in the resulting map.
 	KeepUnknowns       bool   // true if we are keeping unknown values (otherwise we skip them).
 	RejectUnknowns     bool   // true if we should return errors on unknown values. Takes precedence over KeepUnknowns.
+	KeepSecrets        bool   // true if we are keeping secrets (otherwise we replace them with their underlying values).
 	ElideAssetContents bool   // true if we are eliding the contents of assets.
 	ComputeAssetHashes bool   // true if we are computing missing asset hashes on the fly.
 }
@@ -126,6 +127,26 @@ func MarshalPropertyValue(v resource.PropertyValue, opts MarshalOptions) (*struc
 			return nil, err
 		}
 		return MarshalStruct(obj, opts), nil
+	} else if v.IsSecret() {
+		if v.SecretValue().IsEncrypted() {
+			return nil, errors.New("secret value has not been decrypted")
+		}
+
+		// If the other end doesn't understand secrets, simply send the underlying value.
+		if !opts.KeepSecrets {
+			logging.V(5).Infof("marshalling secret value as raw value as opts.KeepSecrets is false")
+			return MarshalPropertyValue(v.SecretValue().Element, opts)
+		}
+		elem, err := MarshalPropertyValue(v.SecretValue().Element, opts)
+		if err != nil || elem == nil {
+			return nil, err // if the underlying value was skipped (e.g., it is unknown), skip the secret too.
+		}
+		return MarshalStruct(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				string(resource.SigKey): MarshalString(resource.SecretSig, opts),
+				"value":                 elem,
+			},
+		}, opts), nil
 	} else if v.IsComputed() {
 		if opts.RejectUnknowns {
 			return nil, errors.New("unexpected unknown property value")
@@ -265,6 +286,20 @@ func UnmarshalPropertyValue(v *structpb.Value, opts MarshalOptions) (*resource.P
 			return nil, err
 		}
 
+		// Secrets are objects that carry the secret signature and wrap the underlying value.
+		if resource.HasSig(obj, resource.SecretSig) {
+			value, has := obj["value"]
+			if !has {
+				return nil, nil // the underlying value was skipped (e.g., it is unknown), so skip the secret too.
+			}
+			if !opts.KeepSecrets {
+				logging.V(5).Infof("unmarshalling secret as raw value, as opts.KeepSecrets is false")
+				return &value, nil
+			}
+			m := resource.MakeSecret(value)
+			return &m, nil
+		}
+
 		// Before returning it as an object, check to see if it's a known recoverable type.
 		objmap := obj.Mappable()
 		asset, isasset, err := resource.DeserializeAsset(objmap)
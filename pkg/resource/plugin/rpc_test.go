@@ -121,3 +121,67 @@ func TestComputedReject(t *testing.T) {
 		assert.Nil(t, cpropU)
 	}
 }
+
+func TestSecretSerialize(t *testing.T) {
+	// Ensure that secrets round trip when both sides keep them.
+	secret := resource.MakeSecret(resource.NewStringProperty("hunter2"))
+	prop, err := MarshalPropertyValue(secret, MarshalOptions{KeepSecrets: true})
+	assert.Nil(t, err)
+	value, err := UnmarshalPropertyValue(prop, MarshalOptions{KeepSecrets: true})
+	assert.Nil(t, err)
+	if assert.True(t, value.IsSecret()) {
+		assert.Equal(t, "hunter2", value.SecretValue().Element.StringValue())
+	}
+
+	// Ensure that secrets are unwrapped when the receiving side does not keep them.
+	value, err = UnmarshalPropertyValue(prop, MarshalOptions{})
+	assert.Nil(t, err)
+	assert.True(t, value.IsString())
+	assert.Equal(t, "hunter2", value.StringValue())
+
+	// Ensure that secrets are sent as their underlying values when the sending side does not keep them.
+	prop, err = MarshalPropertyValue(secret, MarshalOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "hunter2", prop.GetStringValue())
+}
+
+func TestAnnotateSecrets(t *testing.T) {
+	secret := func(s string) resource.PropertyValue {
+		return resource.MakeSecret(resource.NewStringProperty(s))
+	}
+	ins := resource.PropertyMap{
+		"top": secret("a"),
+		"nested": resource.NewObjectProperty(resource.PropertyMap{
+			"secret": secret("b"),
+			"plain":  resource.NewStringProperty("c"),
+		}),
+		"array": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("d"),
+			secret("e"),
+		}),
+	}
+	outs := resource.PropertyMap{
+		"top": resource.NewStringProperty("a"),
+		"nested": resource.NewObjectProperty(resource.PropertyMap{
+			"secret": resource.NewStringProperty("b"),
+			"plain":  resource.NewStringProperty("c"),
+		}),
+		"array": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("d"),
+			resource.NewStringProperty("e"),
+			resource.NewStringProperty("f"),
+		}),
+		"extra": resource.NewStringProperty("g"),
+	}
+
+	annotateSecrets(outs, ins)
+	assert.True(t, outs["top"].IsSecret())
+	nested := outs["nested"].ObjectValue()
+	assert.True(t, nested["secret"].IsSecret())
+	assert.False(t, nested["plain"].IsSecret())
+	array := outs["array"].ArrayValue()
+	assert.False(t, array[0].IsSecret())
+	assert.True(t, array[1].IsSecret())
+	assert.False(t, array[2].IsSecret())
+	assert.False(t, outs["extra"].IsSecret())
+}
@@ -86,6 +86,20 @@ type Output struct {
 	Element PropertyValue // the eventual value (type) of the output property.
 }
 
+// Secret is a property value that is sensitive.  Secret values are never displayed, and are encrypted before they are
+// written to a checkpoint.
+type Secret struct {
+	Element PropertyValue // the underlying value of the secret property.
+	// Ciphertext is the encrypted form of a secret that was read from a checkpoint without being decrypted.  Such a
+	// secret has a null Element until it is decrypted.
+	Ciphertext string
+}
+
+// IsEncrypted returns true if the secret's underlying value has not been decrypted.
+func (s *Secret) IsEncrypted() bool {
+	return s.Ciphertext != ""
+}
+
 type ReqError struct {
 	K PropertyKey
 }
@@ -117,6 +131,16 @@ func (m PropertyMap) ContainsUnknowns() bool {
 	return false
 }
 
+// ContainsSecrets returns true if the property map contains at least one secret value.
+func (m PropertyMap) ContainsSecrets() bool {
+	for _, v := range m {
+		if v.ContainsSecrets() {
+			return true
+		}
+	}
+	return false
+}
+
 // Mappable returns a mapper-compatible object map, suitable for deserialization into structures.
 func (m PropertyMap) Mappable() map[string]interface{} {
 	return m.MapRepl(nil, nil)
@@ -182,6 +206,7 @@ func NewArchiveProperty(v *Archive) PropertyValue      { return PropertyValue{v}
 func NewObjectProperty(v PropertyMap) PropertyValue    { return PropertyValue{v} }
 func NewComputedProperty(v Computed) PropertyValue     { return PropertyValue{v} }
 func NewOutputProperty(v Output) PropertyValue         { return PropertyValue{v} }
+func NewSecretProperty(v *Secret) PropertyValue        { return PropertyValue{v} }
 
 func MakeComputed(v PropertyValue) PropertyValue {
 	return NewComputedProperty(Computed{Element: v})
@@ -191,6 +216,10 @@ func MakeOutput(v PropertyValue) PropertyValue {
 	return NewOutputProperty(Output{Element: v})
 }
 
+func MakeSecret(v PropertyValue) PropertyValue {
+	return NewSecretProperty(&Secret{Element: v})
+}
+
 // NewPropertyValue turns a value into a property value, provided it is of a legal "JSON-like" kind.
 func NewPropertyValue(v interface{}) PropertyValue {
 	return NewPropertyValueRepl(v, nil, nil)
@@ -242,6 +271,8 @@ func NewPropertyValueRepl(v interface{},
 		return NewComputedProperty(t)
 	case Output:
 		return NewOutputProperty(t)
+	case *Secret:
+		return NewSecretProperty(t)
 	}
 
 	// Next, see if it's an array, slice, pointer or struct, and handle each accordingly.
@@ -305,6 +336,8 @@ func (v PropertyValue) HasValue() bool {
 func (v PropertyValue) ContainsUnknowns() bool {
 	if v.IsComputed() || v.IsOutput() {
 		return true
+	} else if v.IsSecret() {
+		return v.SecretValue().Element.ContainsUnknowns()
 	} else if v.IsArray() {
 		for _, e := range v.ArrayValue() {
 			if e.ContainsUnknowns() {
@@ -317,6 +350,26 @@ func (v PropertyValue) ContainsUnknowns() bool {
 	return false
 }
 
+// ContainsSecrets returns true if the property value contains at least one secret (deeply).
+func (v PropertyValue) ContainsSecrets() bool {
+	if v.IsSecret() {
+		return true
+	} else if v.IsComputed() {
+		return v.Input().Element.ContainsSecrets()
+	} else if v.IsOutput() {
+		return v.OutputValue().Element.ContainsSecrets()
+	} else if v.IsArray() {
+		for _, e := range v.ArrayValue() {
+			if e.ContainsSecrets() {
+				return true
+			}
+		}
+	} else if v.IsObject() {
+		return v.ObjectValue().ContainsSecrets()
+	}
+	return false
+}
+
 // BoolValue fetches the underlying bool value (panicking if it isn't a bool).
 func (v PropertyValue) BoolValue() bool { return v.V.(bool) }
 
@@ -344,6 +397,9 @@ func (v PropertyValue) Input() Computed { return v.V.(Computed) }
 // OutputValue fetches the underlying output value (panicking if it isn't a output).
 func (v PropertyValue) OutputValue() Output { return v.V.(Output) }
 
+// SecretValue fetches the underlying secret value (panicking if it isn't a secret).
+func (v PropertyValue) SecretValue() *Secret { return v.V.(*Secret) }
+
 // IsNull returns true if the underlying value is a null.
 func (v PropertyValue) IsNull() bool {
 	return v.V == nil
@@ -403,6 +459,12 @@ func (v PropertyValue) IsOutput() bool {
 	return is
 }
 
+// IsSecret returns true if the underlying value is a secret value.
+func (v PropertyValue) IsSecret() bool {
+	_, is := v.V.(*Secret)
+	return is
+}
+
 // TypeString returns a type representation of the property value's holder type.
 func (v PropertyValue) TypeString() string {
 	if v.IsNull() {
@@ -425,6 +487,8 @@ func (v PropertyValue) TypeString() string {
 		return "computed<" + v.Input().Element.TypeString() + ">"
 	} else if v.IsOutput() {
 		return "output<" + v.OutputValue().Element.TypeString() + ">"
+	} else if v.IsSecret() {
+		return "secret<" + v.SecretValue().Element.TypeString() + ">"
 	}
 	contract.Failf("Unrecognized PropertyValue type")
 	return ""
@@ -466,6 +530,8 @@ func (v PropertyValue) MapRepl(replk func(string) (string, bool),
 		return v.Input()
 	} else if v.IsOutput() {
 		return v.OutputValue()
+	} else if v.IsSecret() {
+		return v.SecretValue()
 	}
 	contract.Assertf(v.IsObject(), "v is not Object '%v' instead", v.TypeString())
 	return v.ObjectValue().MapRepl(replk, replv)
@@ -512,6 +578,9 @@ func (v PropertyValue) String() string {
 	if v.IsComputed() || v.IsOutput() {
 		// For computed and output properties, show their type followed by an empty object string.
 		return fmt.Sprintf("%v{}", v.TypeString())
+	} else if v.IsSecret() {
+		// Never display the underlying value of a secret.
+		return "{[secret]}"
 	}
 	// For all others, just display the underlying property value.
 	return fmt.Sprintf("{%v}", v.V)
@@ -527,6 +596,10 @@ type Property struct {
 // maps, like we do when performing serialization, to ensure recoverability of type identities later on.
 const SigKey = PropertyKey("4dabf18193072939515e22adb298388d")
 
+// SecretSig is the unique secret signature.  It is stored under SigKey in the objects that represent secret values
+// when they are marshaled or serialized.
+const SecretSig = "1b47061264138c4ac30d75fd1eb44270"
+
 // HasSig checks to see if the given property map contains the specific signature match.
 func HasSig(obj PropertyMap, match string) bool {
 	if sig, hassig := obj[SigKey]; hassig {
@@ -282,6 +282,19 @@ func (v PropertyValue) DeepEquals(other PropertyValue) bool {
 		return v.ArchiveValue().Equals(other.ArchiveValue())
 	}
 
+	// Secret values are equal if their underlying values are deeply equal.  Secrets that have not been decrypted can
+	// only be compared by their ciphertext.
+	if v.IsSecret() {
+		if !other.IsSecret() {
+			return false
+		}
+		vs, os := v.SecretValue(), other.SecretValue()
+		if vs.IsEncrypted() || os.IsEncrypted() {
+			return vs.Ciphertext == os.Ciphertext
+		}
+		return vs.Element.DeepEquals(os.Element)
+	}
+
 	// Object values are equal if their contents are deeply equal.
 	if v.IsObject() {
 		if !other.IsObject() {
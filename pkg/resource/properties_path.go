@@ -0,0 +1,242 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PropertyPath represents a path to a nested property. The path is composed of strings, which access properties in
+// object values, and integers, which access elements of array values.
+type PropertyPath []interface{}
+
+// ParsePropertyPath parses a property path into a PropertyPath value.
+//
+// A property path string is a sequence of property accesses, much like a JavaScript property access expression in
+// which every element is a literal. A path starts with a property name or an index and is followed by any number of
+// `.name` or `[index]` accessors. An index is either a non-negative integer or a double-quoted property name, which
+// allows names that contain '.' or '[' characters. For example, the following are all valid property paths:
+//
+//     root
+//     root.nested
+//     root["nested.with.dots"]
+//     root.array[0].field
+//     [0]
+func ParsePropertyPath(path string) (PropertyPath, error) {
+	if path == "" {
+		return nil, errors.New("property path cannot be empty")
+	}
+
+	var elements PropertyPath
+	for rest := path; len(rest) > 0; {
+		switch {
+		case rest[0] == '[':
+			element, next, err := parsePropertyPathIndex(rest)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid property path '%s'", path)
+			}
+			elements, rest = append(elements, element), next
+		case rest[0] == '.' && len(elements) > 0:
+			rest = rest[1:]
+			fallthrough
+		case len(elements) == 0:
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, errors.Errorf("invalid property path '%s': missing property name", path)
+			}
+			elements, rest = append(elements, rest[:end]), rest[end:]
+		default:
+			return nil, errors.Errorf("invalid property path '%s': expected '.' or '['", path)
+		}
+	}
+	return elements, nil
+}
+
+// parsePropertyPathIndex parses a bracketed index (either an array index or a quoted property name) from the start of
+// the given string, returning the parsed element and the remainder of the string.
+func parsePropertyPathIndex(s string) (interface{}, string, error) {
+	inner := s[1:]
+	if strings.HasPrefix(inner, "\"") {
+		// Find the closing quote, skipping any escaped quotes.
+		var name bytes.Buffer
+		for i := 1; i < len(inner); i++ {
+			switch c := inner[i]; {
+			case c == '\\' && i+1 < len(inner):
+				i++
+				name.WriteByte(inner[i])
+			case c == '"':
+				if i+1 >= len(inner) || inner[i+1] != ']' {
+					return nil, "", errors.New("missing closing ']'")
+				}
+				return name.String(), inner[i+2:], nil
+			default:
+				name.WriteByte(c)
+			}
+		}
+		return nil, "", errors.New("missing closing '\"'")
+	}
+
+	end := strings.IndexByte(inner, ']')
+	if end == -1 {
+		return nil, "", errors.New("missing closing ']'")
+	}
+	index, err := strconv.Atoi(inner[:end])
+	if err != nil || index < 0 {
+		return nil, "", errors.Errorf("invalid array index '%s'", inner[:end])
+	}
+	return index, inner[end+1:], nil
+}
+
+// Get attempts to get the value located by the path in the given property value. If the path does not locate a value,
+// Get returns false.
+func (p PropertyPath) Get(v PropertyValue) (PropertyValue, bool) {
+	for _, element := range p {
+		switch key := element.(type) {
+		case string:
+			if !v.IsObject() {
+				return PropertyValue{}, false
+			}
+			child, ok := v.ObjectValue()[PropertyKey(key)]
+			if !ok {
+				return PropertyValue{}, false
+			}
+			v = child
+		case int:
+			if !v.IsArray() || key >= len(v.ArrayValue()) {
+				return PropertyValue{}, false
+			}
+			v = v.ArrayValue()[key]
+		default:
+			return PropertyValue{}, false
+		}
+	}
+	return v, true
+}
+
+// Set returns a copy of dest in which the value located by the path has been replaced with v. Any objects that are
+// missing along the path are created; arrays must already contain the indexed element. The original value is never
+// modified. If the value cannot be set, Set returns false.
+func (p PropertyPath) Set(dest, v PropertyValue) (PropertyValue, bool) {
+	if len(p) == 0 {
+		return v, true
+	}
+
+	switch key := p[0].(type) {
+	case string:
+		if dest.IsNull() {
+			dest = NewObjectProperty(PropertyMap{})
+		}
+		if !dest.IsObject() {
+			return dest, false
+		}
+		obj := dest.ObjectValue().Copy()
+		child, ok := p[1:].Set(obj[PropertyKey(key)], v)
+		if !ok {
+			return dest, false
+		}
+		obj[PropertyKey(key)] = child
+		return NewObjectProperty(obj), true
+	case int:
+		if !dest.IsArray() || key >= len(dest.ArrayValue()) {
+			return dest, false
+		}
+		arr := make([]PropertyValue, len(dest.ArrayValue()))
+		copy(arr, dest.ArrayValue())
+		child, ok := p[1:].Set(arr[key], v)
+		if !ok {
+			return dest, false
+		}
+		arr[key] = child
+		return NewArrayProperty(arr), true
+	default:
+		return dest, false
+	}
+}
+
+// Delete returns a copy of dest from which the object property located by the path has been removed. The original
+// value is never modified. If the path does not locate an object property, Delete returns false.
+func (p PropertyPath) Delete(dest PropertyValue) (PropertyValue, bool) {
+	if len(p) == 0 {
+		return dest, false
+	}
+
+	switch key := p[0].(type) {
+	case string:
+		if !dest.IsObject() {
+			return dest, false
+		}
+		child, has := dest.ObjectValue()[PropertyKey(key)]
+		if !has {
+			return dest, false
+		}
+		obj := dest.ObjectValue().Copy()
+		if len(p) == 1 {
+			delete(obj, PropertyKey(key))
+		} else {
+			newChild, ok := p[1:].Delete(child)
+			if !ok {
+				return dest, false
+			}
+			obj[PropertyKey(key)] = newChild
+		}
+		return NewObjectProperty(obj), true
+	case int:
+		if len(p) == 1 || !dest.IsArray() || key >= len(dest.ArrayValue()) {
+			return dest, false
+		}
+		newChild, ok := p[1:].Delete(dest.ArrayValue()[key])
+		if !ok {
+			return dest, false
+		}
+		arr := make([]PropertyValue, len(dest.ArrayValue()))
+		copy(arr, dest.ArrayValue())
+		arr[key] = newChild
+		return NewArrayProperty(arr), true
+	default:
+		return dest, false
+	}
+}
+
+// String returns the string representation of the path, suitable for parsing with ParsePropertyPath.
+func (p PropertyPath) String() string {
+	var sb bytes.Buffer
+	for i, element := range p {
+		switch key := element.(type) {
+		case string:
+			if strings.ContainsAny(key, ".[]\"") || key == "" {
+				sb.WriteString(`["`)
+				sb.WriteString(strings.Replace(strings.Replace(key, `\`, `\\`, -1), `"`, `\"`, -1))
+				sb.WriteString(`"]`)
+			} else {
+				if i > 0 {
+					sb.WriteByte('.')
+				}
+				sb.WriteString(key)
+			}
+		case int:
+			sb.WriteString("[")
+			sb.WriteString(strconv.Itoa(key))
+			sb.WriteString("]")
+		}
+	}
+	return sb.String()
+}
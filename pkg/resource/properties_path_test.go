@@ -0,0 +1,100 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePropertyPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected PropertyPath
+	}{
+		{"root", PropertyPath{"root"}},
+		{"root.nested", PropertyPath{"root", "nested"}},
+		{`root["nested.with.dots"]`, PropertyPath{"root", "nested.with.dots"}},
+		{`root["with \"quotes\""]`, PropertyPath{"root", `with "quotes"`}},
+		{"root.array[0].field", PropertyPath{"root", "array", 0, "field"}},
+		{"root[1][2]", PropertyPath{"root", 1, 2}},
+		{"[0]", PropertyPath{0}},
+	}
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			path, err := ParsePropertyPath(c.path)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, path)
+
+			reparsed, err := ParsePropertyPath(path.String())
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, reparsed)
+		})
+	}
+
+	for _, bad := range []string{"", ".root", "root.", "root..nested", "root[", "root[-1]", "root[a]", `root["a`,
+		`root["a"`, "root[0]nested"} {
+		_, err := ParsePropertyPath(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestPropertyPathGetSetDelete(t *testing.T) {
+	value := NewObjectProperty(NewPropertyMapFromMap(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner": "alice",
+			"team":  "infra",
+		},
+		"ports": []interface{}{float64(80), float64(443)},
+	}))
+
+	// Get
+	owner, ok := PropertyPath{"tags", "owner"}.Get(value)
+	assert.True(t, ok)
+	assert.Equal(t, NewStringProperty("alice"), owner)
+	port, ok := PropertyPath{"ports", 1}.Get(value)
+	assert.True(t, ok)
+	assert.Equal(t, NewNumberProperty(443), port)
+	_, ok = PropertyPath{"tags", "missing"}.Get(value)
+	assert.False(t, ok)
+	_, ok = PropertyPath{"ports", 2}.Get(value)
+	assert.False(t, ok)
+
+	// Set must not modify the original value.
+	updated, ok := PropertyPath{"tags", "owner"}.Set(value, NewStringProperty("bob"))
+	assert.True(t, ok)
+	owner, _ = PropertyPath{"tags", "owner"}.Get(updated)
+	assert.Equal(t, NewStringProperty("bob"), owner)
+	owner, _ = PropertyPath{"tags", "owner"}.Get(value)
+	assert.Equal(t, NewStringProperty("alice"), owner)
+
+	// Set creates missing objects, but not missing array elements.
+	updated, ok = PropertyPath{"labels", "env"}.Set(value, NewStringProperty("prod"))
+	assert.True(t, ok)
+	env, ok := PropertyPath{"labels", "env"}.Get(updated)
+	assert.True(t, ok)
+	assert.Equal(t, NewStringProperty("prod"), env)
+	_, ok = PropertyPath{"ports", 5}.Set(value, NewNumberProperty(8080))
+	assert.False(t, ok)
+
+	// Delete must not modify the original value.
+	updated, ok = PropertyPath{"tags", "team"}.Delete(value)
+	assert.True(t, ok)
+	_, ok = PropertyPath{"tags", "team"}.Get(updated)
+	assert.False(t, ok)
+	_, ok = PropertyPath{"tags", "team"}.Get(value)
+	assert.True(t, ok)
+}
@@ -0,0 +1,28 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+// CustomTimeouts overrides the timeouts, in seconds, of a resource's provisioning operations. A timeout of zero means
+// that the provider's default timeout should be used for that operation.
+type CustomTimeouts struct {
+	Create float64 // the timeout for creating the resource.
+	Update float64 // the timeout for updating the resource.
+	Delete float64 // the timeout for deleting the resource.
+}
+
+// IsZero returns true if none of the timeouts have been overridden.
+func (t CustomTimeouts) IsZero() bool {
+	return t.Create == 0 && t.Update == 0 && t.Delete == 0
+}
@@ -0,0 +1,248 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema defines the JSON document that a resource provider returns from its GetSchema RPC in order to
+// describe the resources, functions, and types that it supports.
+package schema
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+// Version is the current version of the schema format. Providers are asked for a schema of a particular version, and
+// must report the version of the document that they return.
+const Version = 1
+
+// The primitive types that may appear in a TypeSpec's Type field.
+const (
+	BoolType    = "boolean"
+	IntType     = "integer"
+	NumberType  = "number"
+	StringType  = "string"
+	ArrayType   = "array"
+	ObjectType  = "object"
+	AnyType     = "any"
+	typesPrefix = "#/types/"
+)
+
+// TypeSpec describes the type of a property. A type is either a primitive type, an array or map of some element type,
+// or a reference to one of the object types declared by the package (e.g. "#/types/aws:s3:BucketWebsite").
+type TypeSpec struct {
+	// Type is the primitive, array, or map type. It must be empty if Ref is set.
+	Type string `json:"type,omitempty"`
+	// Ref is a reference to an object type declared in the package's Types.
+	Ref string `json:"$ref,omitempty"`
+	// Items is the element type of an array type.
+	Items *TypeSpec `json:"items,omitempty"`
+	// AdditionalProperties is the element type of a map type. Maps are objects with no declared properties.
+	AdditionalProperties *TypeSpec `json:"additionalProperties,omitempty"`
+}
+
+// PropertySpec describes a single input or output property.
+type PropertySpec struct {
+	TypeSpec
+
+	// Description is the documentation for the property.
+	Description string `json:"description,omitempty"`
+	// Secret is true if the property's value is sensitive and must be treated as a secret.
+	Secret bool `json:"secret,omitempty"`
+}
+
+// ObjectTypeSpec describes an object type: a set of properties, some of which may be required.
+type ObjectTypeSpec struct {
+	// Description is the documentation for the type.
+	Description string `json:"description,omitempty"`
+	// Properties maps the names of the object's properties to their descriptions.
+	Properties map[string]PropertySpec `json:"properties,omitempty"`
+	// Required is the list of the names of the object's required properties.
+	Required []string `json:"required,omitempty"`
+}
+
+// ResourceSpec describes a resource. The embedded object type describes the resource's output properties.
+type ResourceSpec struct {
+	ObjectTypeSpec
+
+	// InputProperties maps the names of the resource's input properties to their descriptions.
+	InputProperties map[string]PropertySpec `json:"inputProperties,omitempty"`
+	// RequiredInputs is the list of the names of the resource's required input properties.
+	RequiredInputs []string `json:"requiredInputs,omitempty"`
+}
+
+// FunctionSpec describes a function that may be invoked using the provider's Invoke RPC.
+type FunctionSpec struct {
+	// Description is the documentation for the function.
+	Description string `json:"description,omitempty"`
+	// Inputs describes the function's arguments, if any.
+	Inputs *ObjectTypeSpec `json:"inputs,omitempty"`
+	// Outputs describes the function's results, if any.
+	Outputs *ObjectTypeSpec `json:"outputs,omitempty"`
+}
+
+// PackageSpec is the top-level schema document for a resource provider. Resources, functions, and types are keyed by
+// their tokens.
+type PackageSpec struct {
+	// Version is the version of the schema format used by this document.
+	Version int `json:"version"`
+	// Name is the name of the package.
+	Name string `json:"name"`
+	// Description is the documentation for the package.
+	Description string `json:"description,omitempty"`
+	// Types maps the tokens of the object types declared by the package to their descriptions.
+	Types map[string]ObjectTypeSpec `json:"types,omitempty"`
+	// Resources maps the tokens of the package's resources to their descriptions.
+	Resources map[string]ResourceSpec `json:"resources,omitempty"`
+	// Functions maps the tokens of the package's functions to their descriptions.
+	Functions map[string]FunctionSpec `json:"functions,omitempty"`
+}
+
+// ParsePackageSpec decodes a JSON schema document. It does not validate the contents of the document.
+func ParsePackageSpec(data []byte) (*PackageSpec, error) {
+	var spec PackageSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, errors.Wrap(err, "decoding schema")
+	}
+	return &spec, nil
+}
+
+// Validate checks that the schema is well-formed: that its version is supported, that all tokens belong to the
+// package, that all types are valid and all type references resolve, and that all required properties exist. All of
+// the problems found are returned as a single error.
+func (spec *PackageSpec) Validate() error {
+	var result error
+	fail := func(format string, args ...interface{}) {
+		result = multierror.Append(result, errors.Errorf(format, args...))
+	}
+
+	if spec.Version != Version {
+		fail("unsupported schema version %d; expected %d", spec.Version, Version)
+	}
+	if spec.Name == "" {
+		fail("missing package name")
+	}
+
+	validateToken := func(kind, tok string) {
+		parts := strings.Split(tok, tokens.TokenDelimiter)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			fail("%s '%s' has an invalid token; tokens must be of the form 'pkg:module:member'", kind, tok)
+		} else if parts[0] != spec.Name {
+			fail("%s '%s' does not belong to package '%s'", kind, tok, spec.Name)
+		}
+	}
+
+	var validateType func(context string, t *TypeSpec)
+	validateType = func(context string, t *TypeSpec) {
+		if t.Ref != "" {
+			if t.Type != "" || t.Items != nil || t.AdditionalProperties != nil {
+				fail("%s: a type reference may not also specify a type", context)
+			}
+			if !strings.HasPrefix(t.Ref, typesPrefix) {
+				fail("%s: invalid type reference '%s'; references must start with '%s'", context, t.Ref, typesPrefix)
+			} else if _, ok := spec.Types[strings.TrimPrefix(t.Ref, typesPrefix)]; !ok {
+				fail("%s: type reference '%s' does not refer to a declared type", context, t.Ref)
+			}
+			return
+		}
+
+		switch t.Type {
+		case BoolType, IntType, NumberType, StringType, AnyType:
+			if t.Items != nil || t.AdditionalProperties != nil {
+				fail("%s: primitive type '%s' may not have element types", context, t.Type)
+			}
+		case ArrayType:
+			if t.Items == nil {
+				fail("%s: array types must specify an element type in 'items'", context)
+			} else {
+				validateType(context+"[]", t.Items)
+			}
+		case ObjectType:
+			if t.AdditionalProperties == nil {
+				fail("%s: map types must specify an element type in 'additionalProperties'", context)
+			} else {
+				validateType(context+"{}", t.AdditionalProperties)
+			}
+		case "":
+			fail("%s: missing type", context)
+		default:
+			fail("%s: unknown type '%s'", context, t.Type)
+		}
+	}
+
+	validateProperties := func(context string, props map[string]PropertySpec, required []string) {
+		for _, name := range sortedKeys(props) {
+			prop := props[name]
+			validateType(context+"."+name, &prop.TypeSpec)
+		}
+		for _, name := range required {
+			if _, ok := props[name]; !ok {
+				fail("%s: required property '%s' is not declared", context, name)
+			}
+		}
+	}
+
+	for _, tok := range sortedKeys(spec.Types) {
+		validateToken("type", tok)
+		t := spec.Types[tok]
+		validateProperties(tok, t.Properties, t.Required)
+	}
+	for _, tok := range sortedKeys(spec.Resources) {
+		validateToken("resource", tok)
+		r := spec.Resources[tok]
+		validateProperties(tok, r.Properties, r.Required)
+		validateProperties(tok+" inputs", r.InputProperties, r.RequiredInputs)
+	}
+	for _, tok := range sortedKeys(spec.Functions) {
+		validateToken("function", tok)
+		f := spec.Functions[tok]
+		if f.Inputs != nil {
+			validateProperties(tok+" inputs", f.Inputs.Properties, f.Inputs.Required)
+		}
+		if f.Outputs != nil {
+			validateProperties(tok+" outputs", f.Outputs.Properties, f.Outputs.Required)
+		}
+	}
+
+	return result
+}
+
+// sortedKeys returns the keys of a map of specs in sorted order so that validation errors are deterministic.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]PropertySpec:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]ObjectTypeSpec:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]ResourceSpec:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]FunctionSpec:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
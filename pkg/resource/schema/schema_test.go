@@ -0,0 +1,108 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const validSchema = `{
+	"version": 1,
+	"name": "test",
+	"types": {
+		"test:index:Website": {
+			"properties": {
+				"indexDocument": { "type": "string" }
+			},
+			"required": [ "indexDocument" ]
+		}
+	},
+	"resources": {
+		"test:index:Bucket": {
+			"properties": {
+				"arn": { "type": "string" },
+				"website": { "$ref": "#/types/test:index:Website" }
+			},
+			"required": [ "arn" ],
+			"inputProperties": {
+				"tags": { "type": "object", "additionalProperties": { "type": "string" } },
+				"password": { "type": "string", "secret": true },
+				"website": { "$ref": "#/types/test:index:Website" }
+			},
+			"requiredInputs": [ "password" ]
+		}
+	},
+	"functions": {
+		"test:index:getBuckets": {
+			"outputs": {
+				"properties": {
+					"names": { "type": "array", "items": { "type": "string" } }
+				}
+			}
+		}
+	}
+}`
+
+func TestParseValidSchema(t *testing.T) {
+	spec, err := ParsePackageSpec([]byte(validSchema))
+	assert.NoError(t, err)
+	assert.NoError(t, spec.Validate())
+
+	assert.Equal(t, "test", spec.Name)
+	bucket := spec.Resources["test:index:Bucket"]
+	assert.True(t, bucket.InputProperties["password"].Secret)
+	assert.Equal(t, []string{"password"}, bucket.RequiredInputs)
+	assert.Equal(t, "#/types/test:index:Website", bucket.Properties["website"].Ref)
+	assert.Equal(t, StringType, bucket.InputProperties["tags"].AdditionalProperties.Type)
+}
+
+func TestValidateInvalidSchema(t *testing.T) {
+	spec := &PackageSpec{
+		Version: 2,
+		Name:    "test",
+		Resources: map[string]ResourceSpec{
+			"other:index:Bucket": {},
+			"test:index:Thing": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"list":    {TypeSpec: TypeSpec{Type: ArrayType}},
+						"ref":     {TypeSpec: TypeSpec{Ref: "#/types/test:index:Missing"}},
+						"unknown": {TypeSpec: TypeSpec{Type: "float"}},
+					},
+					Required: []string{"missing"},
+				},
+			},
+		},
+		Functions: map[string]FunctionSpec{
+			"getThing": {},
+		},
+	}
+
+	err := spec.Validate()
+	assert.Error(t, err)
+	for _, msg := range []string{
+		"unsupported schema version 2",
+		"resource 'other:index:Bucket' does not belong to package 'test'",
+		"test:index:Thing.list: array types must specify an element type",
+		"type reference '#/types/test:index:Missing' does not refer to a declared type",
+		"test:index:Thing.unknown: unknown type 'float'",
+		"required property 'missing' is not declared",
+		"function 'getThing' has an invalid token",
+	} {
+		assert.Contains(t, err.Error(), msg)
+	}
+}
@@ -69,17 +69,23 @@ func UnmarshalVersionedCheckpointToLatestCheckpoint(bytes []byte) (*apitype.Chec
 	}
 }
 
-// SerializeCheckpoint turns a snapshot into a data structure suitable for serialization.
-func SerializeCheckpoint(stack tokens.QName, config config.Map, snap *deploy.Snapshot) *apitype.VersionedCheckpoint {
+// SerializeCheckpoint turns a snapshot into a data structure suitable for serialization.  Any secret property values
+// are encrypted using the given encrypter.
+func SerializeCheckpoint(stack tokens.QName, cfg config.Map, snap *deploy.Snapshot,
+	enc config.Encrypter) (*apitype.VersionedCheckpoint, error) {
 	// If snap is nil, that's okay, we will just create an empty deployment; otherwise, serialize the whole snapshot.
 	var latest *apitype.DeploymentV2
 	if snap != nil {
-		latest = SerializeDeployment(snap)
+		dep, err := SerializeDeployment(snap, enc)
+		if err != nil {
+			return nil, errors.Wrap(err, "serializing deployment")
+		}
+		latest = dep
 	}
 
 	b, err := json.Marshal(apitype.CheckpointV2{
 		Stack:  stack,
-		Config: config,
+		Config: cfg,
 		Latest: latest,
 	})
 	contract.AssertNoError(err)
@@ -87,29 +93,62 @@ func SerializeCheckpoint(stack tokens.QName, config config.Map, snap *deploy.Sna
 	return &apitype.VersionedCheckpoint{
 		Version:    apitype.DeploymentSchemaVersionCurrent,
 		Checkpoint: json.RawMessage(b),
-	}
+	}, nil
 }
 
 // DeserializeCheckpoint takes a serialized deployment record and returns its associated snapshot. Returns nil
-// if there have been no deployments performed on this checkpoint.
-func DeserializeCheckpoint(chkpoint *apitype.CheckpointV2) (*deploy.Snapshot, error) {
+// if there have been no deployments performed on this checkpoint.  Any secret property values are decrypted using
+// the given decrypter; if it is nil, secrets are left encrypted and may be decrypted later with DecryptSnapshot.
+func DeserializeCheckpoint(chkpoint *apitype.CheckpointV2, dec config.Decrypter) (*deploy.Snapshot, error) {
 	contract.Require(chkpoint != nil, "chkpoint")
 	if chkpoint.Latest != nil {
-		return DeserializeDeploymentV2(*chkpoint.Latest)
+		return DeserializeDeploymentV2(*chkpoint.Latest, dec)
 	}
 
 	return nil, nil
 }
 
 // GetRootStackResource returns the root stack resource from a given snapshot, or nil if not found.  If the stack
-// exists, its output properties, if any, are also returned in the resulting map.
+// exists, its output properties, if any, are also returned in the resulting map.  Secret outputs are shown as
+// "[secret]".
 func GetRootStackResource(snap *deploy.Snapshot) (*resource.State, map[string]interface{}) {
 	if snap != nil {
 		for _, res := range snap.Resources {
 			if res.Type == resource.RootStackType {
-				return res, SerializeResource(res).Outputs
+				// Now that secrets have been hidden, there is nothing to encrypt.
+				outputs, err := SerializeProperties(hideSecrets(res.Outputs), config.NewPanicCrypter())
+				contract.AssertNoError(err)
+				return res, outputs
 			}
 		}
 	}
 	return nil, nil
 }
+
+// hideSecrets returns a copy of the given property map in which all secret values have been replaced with the
+// string "[secret]".
+func hideSecrets(props resource.PropertyMap) resource.PropertyMap {
+	var hide func(v resource.PropertyValue) resource.PropertyValue
+	hide = func(v resource.PropertyValue) resource.PropertyValue {
+		switch {
+		case v.IsSecret():
+			return resource.NewStringProperty("[secret]")
+		case v.IsArray():
+			arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+			for i, e := range v.ArrayValue() {
+				arr[i] = hide(e)
+			}
+			return resource.NewArrayProperty(arr)
+		case v.IsObject():
+			return resource.NewObjectProperty(hideSecrets(v.ObjectValue()))
+		default:
+			return v
+		}
+	}
+
+	result := make(resource.PropertyMap, len(props))
+	for k, v := range props {
+		result[k] = hide(v)
+	}
+	return result
+}
@@ -20,9 +20,12 @@ import (
 	"reflect"
 
 	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
 	"github.com/pulumi/pulumi/pkg/apitype"
 	"github.com/pulumi/pulumi/pkg/apitype/migrate"
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/workspace"
@@ -46,8 +49,9 @@ var (
 	ErrDeploymentSchemaVersionTooNew = fmt.Errorf("this stack's deployment version is too new")
 )
 
-// SerializeDeployment serializes an entire snapshot as a deploy record.
-func SerializeDeployment(snap *deploy.Snapshot) *apitype.DeploymentV2 {
+// SerializeDeployment serializes an entire snapshot as a deploy record.  Any secret property values are encrypted
+// using the given encrypter.
+func SerializeDeployment(snap *deploy.Snapshot, enc config.Encrypter) (*apitype.DeploymentV2, error) {
 	contract.Require(snap != nil, "snap")
 
 	// Capture the version information into a manifest.
@@ -72,25 +76,35 @@ func SerializeDeployment(snap *deploy.Snapshot) *apitype.DeploymentV2 {
 	// Serialize all vertices and only include a vertex section if non-empty.
 	var resources []apitype.ResourceV2
 	for _, res := range snap.Resources {
-		resources = append(resources, SerializeResource(res))
+		sres, err := SerializeResource(res, enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializing resource %s", res.URN)
+		}
+		resources = append(resources, sres)
 	}
 
 	var operations []apitype.OperationV1
 	for _, op := range snap.PendingOperations {
-		operations = append(operations, SerializeOperation(op))
+		sop, err := SerializeOperation(op, enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializing pending operation on %s", op.Resource.URN)
+		}
+		operations = append(operations, sop)
 	}
 
 	return &apitype.DeploymentV2{
 		Manifest:          manifest,
 		Resources:         resources,
 		PendingOperations: operations,
-	}
+	}, nil
 }
 
 // DeserializeUntypedDeployment deserializes an untyped deployment and produces a `deploy.Snapshot`
 // from it. DeserializeDeployment will return an error if the untyped deployment's version is
 // not within the range `DeploymentSchemaVersionCurrent` and `DeploymentSchemaVersionOldestSupported`.
-func DeserializeUntypedDeployment(deployment *apitype.UntypedDeployment) (*deploy.Snapshot, error) {
+// Any secret property values are decrypted using the given decrypter.
+func DeserializeUntypedDeployment(deployment *apitype.UntypedDeployment,
+	dec config.Decrypter) (*deploy.Snapshot, error) {
 	contract.Require(deployment != nil, "deployment")
 	switch {
 	case deployment.Version > apitype.DeploymentSchemaVersionCurrent:
@@ -116,11 +130,12 @@ func DeserializeUntypedDeployment(deployment *apitype.UntypedDeployment) (*deplo
 		contract.Failf("unrecognized version: %d", deployment.Version)
 	}
 
-	return DeserializeDeploymentV2(v2deployment)
+	return DeserializeDeploymentV2(v2deployment, dec)
 }
 
-// DeserializeDeploymentV2 deserializes a typed DeploymentV2 into a `deploy.Snapshot`.
-func DeserializeDeploymentV2(deployment apitype.DeploymentV2) (*deploy.Snapshot, error) {
+// DeserializeDeploymentV2 deserializes a typed DeploymentV2 into a `deploy.Snapshot`.  Any secret property values
+// are decrypted using the given decrypter, or left encrypted if it is nil.
+func DeserializeDeploymentV2(deployment apitype.DeploymentV2, dec config.Decrypter) (*deploy.Snapshot, error) {
 	// Unpack the versions.
 	manifest := deploy.Manifest{
 		Time:    deployment.Manifest.Time,
@@ -146,7 +161,7 @@ func DeserializeDeploymentV2(deployment apitype.DeploymentV2) (*deploy.Snapshot,
 	// For every serialized resource vertex, create a ResourceDeployment out of it.
 	var resources []*resource.State
 	for _, res := range deployment.Resources {
-		desres, err := DeserializeResource(res)
+		desres, err := DeserializeResource(res, dec)
 		if err != nil {
 			return nil, err
 		}
@@ -155,7 +170,7 @@ func DeserializeDeploymentV2(deployment apitype.DeploymentV2) (*deploy.Snapshot,
 
 	var ops []resource.Operation
 	for _, op := range deployment.PendingOperations {
-		desop, err := DeserializeOperation(op)
+		desop, err := DeserializeOperation(op, dec)
 		if err != nil {
 			return nil, err
 		}
@@ -166,62 +181,89 @@ func DeserializeDeploymentV2(deployment apitype.DeploymentV2) (*deploy.Snapshot,
 }
 
 // SerializeResource turns a resource into a structure suitable for serialization.
-func SerializeResource(res *resource.State) apitype.ResourceV2 {
+func SerializeResource(res *resource.State, enc config.Encrypter) (apitype.ResourceV2, error) {
 	contract.Assert(res != nil)
 	contract.Assertf(string(res.URN) != "", "Unexpected empty resource resource.URN")
 
 	// Serialize all input and output properties recursively, and add them if non-empty.
 	var inputs map[string]interface{}
 	if inp := res.Inputs; inp != nil {
-		inputs = SerializeProperties(inp)
+		sinputs, err := SerializeProperties(inp, enc)
+		if err != nil {
+			return apitype.ResourceV2{}, err
+		}
+		inputs = sinputs
 	}
 	var outputs map[string]interface{}
 	if outp := res.Outputs; outp != nil {
-		outputs = SerializeProperties(outp)
+		soutputs, err := SerializeProperties(outp, enc)
+		if err != nil {
+			return apitype.ResourceV2{}, err
+		}
+		outputs = soutputs
 	}
 
-	return apitype.ResourceV2{
-		URN:          res.URN,
-		Custom:       res.Custom,
-		Delete:       res.Delete,
-		ID:           res.ID,
-		Type:         res.Type,
-		Parent:       res.Parent,
-		Inputs:       inputs,
-		Outputs:      outputs,
-		Protect:      res.Protect,
-		External:     res.External,
-		Dependencies: res.Dependencies,
-		InitErrors:   res.InitErrors,
-		Provider:     res.Provider,
+	// Only record custom timeouts if any have been set.
+	var customTimeouts *apitype.CustomTimeoutsV1
+	if !res.CustomTimeouts.IsZero() {
+		customTimeouts = &apitype.CustomTimeoutsV1{
+			Create: res.CustomTimeouts.Create,
+			Update: res.CustomTimeouts.Update,
+			Delete: res.CustomTimeouts.Delete,
+		}
 	}
+
+	return apitype.ResourceV2{
+		URN:            res.URN,
+		Custom:         res.Custom,
+		Delete:         res.Delete,
+		ID:             res.ID,
+		Type:           res.Type,
+		Parent:         res.Parent,
+		Inputs:         inputs,
+		Outputs:        outputs,
+		Protect:        res.Protect,
+		External:       res.External,
+		Dependencies:   res.Dependencies,
+		InitErrors:     res.InitErrors,
+		Provider:       res.Provider,
+		CustomTimeouts: customTimeouts,
+	}, nil
 }
 
-func SerializeOperation(op resource.Operation) apitype.OperationV1 {
-	res := SerializeResource(op.Resource)
+func SerializeOperation(op resource.Operation, enc config.Encrypter) (apitype.OperationV1, error) {
+	res, err := SerializeResource(op.Resource, enc)
+	if err != nil {
+		return apitype.OperationV1{}, err
+	}
 	return apitype.OperationV1{
 		Resource: res,
 		Type:     apitype.OperationType(op.Type),
-	}
+	}, nil
 }
 
 // SerializeProperties serializes a resource property bag so that it's suitable for serialization.
-func SerializeProperties(props resource.PropertyMap) map[string]interface{} {
+func SerializeProperties(props resource.PropertyMap, enc config.Encrypter) (map[string]interface{}, error) {
 	dst := make(map[string]interface{})
 	for _, k := range props.StableKeys() {
-		if v := SerializePropertyValue(props[k]); v != nil {
+		v, err := SerializePropertyValue(props[k], enc)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
 			dst[string(k)] = v
 		}
 	}
-	return dst
+	return dst, nil
 }
 
-// SerializePropertyValue serializes a resource property value so that it's suitable for serialization.
-func SerializePropertyValue(prop resource.PropertyValue) interface{} {
+// SerializePropertyValue serializes a resource property value so that it's suitable for serialization.  Secret
+// values are serialized as objects that carry the secret signature and the encrypted form of the underlying value.
+func SerializePropertyValue(prop resource.PropertyValue, enc config.Encrypter) (interface{}, error) {
 	// Skip nulls and "outputs"; the former needn't be serialized, and the latter happens if there is an output
 	// that hasn't materialized (either because we're serializing inputs or the provider didn't give us the value).
 	if prop.IsComputed() || !prop.HasValue() {
-		return nil
+		return nil, nil
 	}
 
 	// For arrays, make sure to recurse.
@@ -229,46 +271,89 @@ func SerializePropertyValue(prop resource.PropertyValue) interface{} {
 		srcarr := prop.ArrayValue()
 		dstarr := make([]interface{}, len(srcarr))
 		for i, elem := range prop.ArrayValue() {
-			dstarr[i] = SerializePropertyValue(elem)
+			selem, err := SerializePropertyValue(elem, enc)
+			if err != nil {
+				return nil, err
+			}
+			dstarr[i] = selem
 		}
-		return dstarr
+		return dstarr, nil
 	}
 
 	// Also for objects, recurse and use naked properties.
 	if prop.IsObject() {
-		return SerializeProperties(prop.ObjectValue())
+		return SerializeProperties(prop.ObjectValue(), enc)
 	}
 
 	// For assets, we need to serialize them a little carefully, so we can recover them afterwards.
 	if prop.IsAsset() {
-		return prop.AssetValue().Serialize()
+		return prop.AssetValue().Serialize(), nil
 	} else if prop.IsArchive() {
-		return prop.ArchiveValue().Serialize()
+		return prop.ArchiveValue().Serialize(), nil
+	}
+
+	// Secrets are serialized by first serializing their underlying value to JSON and then encrypting the result.
+	// Secrets that were never decrypted are written back out with their original ciphertext.
+	if prop.IsSecret() {
+		if secret := prop.SecretValue(); secret.IsEncrypted() {
+			return serializeCiphertext(secret.Ciphertext), nil
+		}
+		elem, err := SerializePropertyValue(prop.SecretValue().Element, enc)
+		if err != nil {
+			return nil, err
+		}
+		plaintext, err := json.Marshal(elem)
+		if err != nil {
+			return nil, errors.Wrap(err, "encoding secret value")
+		}
+		ciphertext, err := enc.EncryptValue(string(plaintext))
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypting secret value")
+		}
+		return serializeCiphertext(ciphertext), nil
 	}
 
 	// All others are returned as-is.
-	return prop.V
+	return prop.V, nil
+}
+
+// serializeCiphertext returns the serialized form of a secret with the given ciphertext.
+func serializeCiphertext(ciphertext string) map[string]interface{} {
+	return map[string]interface{}{
+		string(resource.SigKey): resource.SecretSig,
+		"ciphertext":            ciphertext,
+	}
 }
 
 // DeserializeResource turns a serialized resource back into its usual form.
-func DeserializeResource(res apitype.ResourceV2) (*resource.State, error) {
+func DeserializeResource(res apitype.ResourceV2, dec config.Decrypter) (*resource.State, error) {
 	// Deserialize the resource properties, if they exist.
-	inputs, err := DeserializeProperties(res.Inputs)
+	inputs, err := DeserializeProperties(res.Inputs, dec)
 	if err != nil {
 		return nil, err
 	}
-	outputs, err := DeserializeProperties(res.Outputs)
+	outputs, err := DeserializeProperties(res.Outputs, dec)
 	if err != nil {
 		return nil, err
 	}
 
+	var customTimeouts resource.CustomTimeouts
+	if res.CustomTimeouts != nil {
+		customTimeouts = resource.CustomTimeouts{
+			Create: res.CustomTimeouts.Create,
+			Update: res.CustomTimeouts.Update,
+			Delete: res.CustomTimeouts.Delete,
+		}
+	}
+
 	return resource.NewState(
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
-		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider), nil
+		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
+		customTimeouts), nil
 }
 
-func DeserializeOperation(op apitype.OperationV1) (resource.Operation, error) {
-	res, err := DeserializeResource(op.Resource)
+func DeserializeOperation(op apitype.OperationV1, dec config.Decrypter) (resource.Operation, error) {
+	res, err := DeserializeResource(op.Resource, dec)
 	if err != nil {
 		return resource.Operation{}, err
 	}
@@ -276,10 +361,10 @@ func DeserializeOperation(op apitype.OperationV1) (resource.Operation, error) {
 }
 
 // DeserializeProperties deserializes an entire map of deploy properties into a resource property map.
-func DeserializeProperties(props map[string]interface{}) (resource.PropertyMap, error) {
+func DeserializeProperties(props map[string]interface{}, dec config.Decrypter) (resource.PropertyMap, error) {
 	result := make(resource.PropertyMap)
 	for k, prop := range props {
-		desprop, err := DeserializePropertyValue(prop)
+		desprop, err := DeserializePropertyValue(prop, dec)
 		if err != nil {
 			return nil, err
 		}
@@ -289,7 +374,7 @@ func DeserializeProperties(props map[string]interface{}) (resource.PropertyMap,
 }
 
 // DeserializePropertyValue deserializes a single deploy property into a resource property value.
-func DeserializePropertyValue(v interface{}) (resource.PropertyValue, error) {
+func DeserializePropertyValue(v interface{}, dec config.Decrypter) (resource.PropertyValue, error) {
 	if v != nil {
 		switch w := v.(type) {
 		case bool:
@@ -301,7 +386,7 @@ func DeserializePropertyValue(v interface{}) (resource.PropertyValue, error) {
 		case []interface{}:
 			var arr []resource.PropertyValue
 			for _, elem := range w {
-				ev, err := DeserializePropertyValue(elem)
+				ev, err := DeserializePropertyValue(elem, dec)
 				if err != nil {
 					return resource.PropertyValue{}, err
 				}
@@ -309,7 +394,12 @@ func DeserializePropertyValue(v interface{}) (resource.PropertyValue, error) {
 			}
 			return resource.NewArrayProperty(arr), nil
 		case map[string]interface{}:
-			obj, err := DeserializeProperties(w)
+			// This could be a secret; if so, decrypt and deserialize its underlying value.
+			if sig, hasSig := w[string(resource.SigKey)]; hasSig && sig == resource.SecretSig {
+				return deserializeSecret(w, dec)
+			}
+
+			obj, err := DeserializeProperties(w, dec)
 			if err != nil {
 				return resource.PropertyValue{}, err
 			}
@@ -336,3 +426,109 @@ func DeserializePropertyValue(v interface{}) (resource.PropertyValue, error) {
 
 	return resource.NewNullProperty(), nil
 }
+
+// deserializeSecret decrypts and deserializes a secret value that was serialized by SerializePropertyValue.  If dec is
+// nil, the secret is left encrypted.
+func deserializeSecret(v map[string]interface{}, dec config.Decrypter) (resource.PropertyValue, error) {
+	ciphertext, ok := v["ciphertext"].(string)
+	if !ok || ciphertext == "" {
+		return resource.PropertyValue{}, errors.New("malformed secret value: missing ciphertext")
+	}
+	if dec == nil {
+		return resource.NewSecretProperty(&resource.Secret{Ciphertext: ciphertext}), nil
+	}
+	return decryptSecret(ciphertext, dec)
+}
+
+// decryptSecret decrypts and deserializes the given secret ciphertext.
+func decryptSecret(ciphertext string, dec config.Decrypter) (resource.PropertyValue, error) {
+	plaintext, err := dec.DecryptValue(ciphertext)
+	if err != nil {
+		return resource.PropertyValue{}, errors.Wrap(err, "decrypting secret value")
+	}
+	var elem interface{}
+	if err = json.Unmarshal([]byte(plaintext), &elem); err != nil {
+		return resource.PropertyValue{}, errors.Wrap(err, "decoding secret value")
+	}
+	ev, err := DeserializePropertyValue(elem, dec)
+	if err != nil {
+		return resource.PropertyValue{}, err
+	}
+	return resource.MakeSecret(ev), nil
+}
+
+// DecryptSnapshot decrypts, in place, any secrets in the given snapshot that were left encrypted when it was
+// deserialized.
+func DecryptSnapshot(snap *deploy.Snapshot, dec config.Decrypter) error {
+	if snap == nil {
+		return nil
+	}
+	decryptState := func(res *resource.State) error {
+		inputs, err := DecryptProperties(res.Inputs, dec)
+		if err != nil {
+			return errors.Wrapf(err, "decrypting inputs of %s", res.URN)
+		}
+		outputs, err := DecryptProperties(res.Outputs, dec)
+		if err != nil {
+			return errors.Wrapf(err, "decrypting outputs of %s", res.URN)
+		}
+		res.Inputs, res.Outputs = inputs, outputs
+		return nil
+	}
+	for _, res := range snap.Resources {
+		if err := decryptState(res); err != nil {
+			return err
+		}
+	}
+	for _, op := range snap.PendingOperations {
+		if err := decryptState(op.Resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecryptProperties returns a copy of the given property map in which any secrets that were left encrypted when it
+// was deserialized have been decrypted using the given decrypter.  The decrypter is only used if there is at least
+// one such secret.
+func DecryptProperties(props resource.PropertyMap, dec config.Decrypter) (resource.PropertyMap, error) {
+	if props == nil {
+		return nil, nil
+	}
+	result := make(resource.PropertyMap, len(props))
+	for k, v := range props {
+		dv, err := DecryptPropertyValue(v, dec)
+		if err != nil {
+			return nil, err
+		}
+		result[k] = dv
+	}
+	return result, nil
+}
+
+// DecryptPropertyValue decrypts any secrets in the given property value that were left encrypted when it was
+// deserialized.
+func DecryptPropertyValue(v resource.PropertyValue, dec config.Decrypter) (resource.PropertyValue, error) {
+	switch {
+	case v.IsSecret() && v.SecretValue().IsEncrypted():
+		return decryptSecret(v.SecretValue().Ciphertext, dec)
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			de, err := DecryptPropertyValue(e, dec)
+			if err != nil {
+				return resource.PropertyValue{}, err
+			}
+			arr[i] = de
+		}
+		return resource.NewArrayProperty(arr), nil
+	case v.IsObject():
+		obj, err := DecryptProperties(v.ObjectValue(), dec)
+		if err != nil {
+			return resource.PropertyValue{}, err
+		}
+		return resource.NewObjectProperty(obj), nil
+	default:
+		return v, nil
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/apitype"
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/tokens"
 )
 
@@ -76,9 +77,11 @@ func TestDeploymentSerialization(t *testing.T) {
 		},
 		[]string{},
 		"",
+		resource.CustomTimeouts{Create: 600, Delete: 300},
 	)
 
-	dep := SerializeResource(res)
+	dep, err := SerializeResource(res, config.NewPanicCrypter())
+	assert.NoError(t, err)
 
 	// assert some things about the deployment record:
 	assert.NotNil(t, dep)
@@ -145,6 +148,15 @@ func TestDeploymentSerialization(t *testing.T) {
 	assert.Equal(t, float64(999.9), outmap["z"].(float64))
 	assert.NotNil(t, dep.Outputs["out-empty-map"])
 	assert.Equal(t, 0, len(dep.Outputs["out-empty-map"].(map[string]interface{})))
+
+	// assert that custom timeouts survive a round trip:
+	assert.NotNil(t, dep.CustomTimeouts)
+	assert.Equal(t, float64(600), dep.CustomTimeouts.Create)
+	assert.Equal(t, float64(0), dep.CustomTimeouts.Update)
+	assert.Equal(t, float64(300), dep.CustomTimeouts.Delete)
+	des, err := DeserializeResource(dep, config.NewPanicCrypter())
+	assert.NoError(t, err)
+	assert.Equal(t, res.CustomTimeouts, des.CustomTimeouts)
 }
 
 func TestLoadTooNewDeployment(t *testing.T) {
@@ -152,7 +164,7 @@ func TestLoadTooNewDeployment(t *testing.T) {
 		Version: apitype.DeploymentSchemaVersionCurrent + 1,
 	}
 
-	deployment, err := DeserializeUntypedDeployment(untypedDeployment)
+	deployment, err := DeserializeUntypedDeployment(untypedDeployment, config.NewPanicCrypter())
 	assert.Nil(t, deployment)
 	assert.Error(t, err)
 	assert.Equal(t, ErrDeploymentSchemaVersionTooNew, err)
@@ -163,8 +175,78 @@ func TestLoadTooOldDeployment(t *testing.T) {
 		Version: DeploymentSchemaVersionOldestSupported - 1,
 	}
 
-	deployment, err := DeserializeUntypedDeployment(untypedDeployment)
+	deployment, err := DeserializeUntypedDeployment(untypedDeployment, config.NewPanicCrypter())
 	assert.Nil(t, deployment)
 	assert.Error(t, err)
 	assert.Equal(t, ErrDeploymentSchemaVersionTooOld, err)
 }
+
+// TestSecretSerialization ensures that secret values are encrypted when serialized and survive a round trip.
+func TestSecretSerialization(t *testing.T) {
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+
+	props := resource.PropertyMap{
+		"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		"nested": resource.NewObjectProperty(resource.PropertyMap{
+			"keys": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+					"private": resource.NewStringProperty("hunter3"),
+				})),
+			}),
+		}),
+		"plain": resource.NewNumberProperty(42),
+	}
+
+	sprops, err := SerializeProperties(props, crypter)
+	assert.NoError(t, err)
+
+	// The plaintext of a secret must not appear in the serialized form.
+	password, ok := sprops["password"].(map[string]interface{})
+	if assert.True(t, ok) {
+		assert.Equal(t, resource.SecretSig, password[string(resource.SigKey)])
+		assert.NotContains(t, password["ciphertext"], "hunter2")
+	}
+	assert.Equal(t, float64(42), sprops["plain"])
+
+	// Deserializing with the same crypter must yield the original values.
+	dprops, err := DeserializeProperties(sprops, crypter)
+	assert.NoError(t, err)
+	assert.True(t, props.DeepEquals(dprops))
+	assert.True(t, dprops["password"].IsSecret())
+
+	// Deserializing without a way to decrypt the secrets must fail.
+	wrong := config.NewSymmetricCrypter(append(make([]byte, config.SymmetricCrypterKeyBytes-1), 1))
+	_, err = DeserializeProperties(sprops, wrong)
+	assert.Error(t, err)
+}
+
+// TestEncryptedSecretSerialization ensures that secrets can be deserialized without being decrypted, and that such
+// secrets keep their ciphertext until they are decrypted.
+func TestEncryptedSecretSerialization(t *testing.T) {
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+
+	props := resource.PropertyMap{
+		"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		"plain":    resource.NewStringProperty("hello"),
+	}
+	sprops, err := SerializeProperties(props, crypter)
+	assert.NoError(t, err)
+
+	// Without a decrypter, secrets are left encrypted and can't be sent to a plugin, but plain values are intact.
+	eprops, err := DeserializeProperties(sprops, nil)
+	assert.NoError(t, err)
+	if assert.True(t, eprops["password"].IsSecret()) {
+		assert.True(t, eprops["password"].SecretValue().IsEncrypted())
+	}
+	assert.Equal(t, "hello", eprops["plain"].StringValue())
+
+	// Serializing them again reuses their ciphertext rather than encrypting them anew.
+	reprops, err := SerializeProperties(eprops, config.NewPanicCrypter())
+	assert.NoError(t, err)
+	assert.Equal(t, sprops, reprops)
+
+	// Decrypting them recovers the original values.
+	dprops, err := DecryptProperties(eprops, crypter)
+	assert.NoError(t, err)
+	assert.True(t, props.DeepEquals(dprops))
+}
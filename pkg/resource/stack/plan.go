@@ -0,0 +1,164 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+)
+
+// UnmarshalVersionedSavedPlan decodes a versioned saved plan, returning an error if its version is not supported.
+func UnmarshalVersionedSavedPlan(bytes []byte) (*apitype.SavedPlanV1, error) {
+	var versionedPlan apitype.VersionedSavedPlan
+	if err := json.Unmarshal(bytes, &versionedPlan); err != nil {
+		return nil, err
+	}
+
+	switch versionedPlan.Version {
+	case 1:
+		var v1plan apitype.SavedPlanV1
+		if err := json.Unmarshal(versionedPlan.Plan, &v1plan); err != nil {
+			return nil, err
+		}
+		return &v1plan, nil
+	default:
+		return nil, errors.Errorf("unsupported plan version %d", versionedPlan.Version)
+	}
+}
+
+// SerializeSavedPlan turns a saved plan into a data structure suitable for serialization. Property values that are
+// unknown are recorded as objects that carry the plan's unknown signature, and secret property values are encrypted
+// using the given encrypter.
+func SerializeSavedPlan(plan *deploy.SavedPlan, enc config.Encrypter) (*apitype.VersionedSavedPlan, error) {
+	steps := make([]apitype.PlannedStepV1, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		planned := apitype.PlannedStepV1{Op: apitype.OpType(step.Op), URN: step.URN}
+		if step.Inputs != nil {
+			inputs, err := SerializeProperties(markUnknowns(step.Inputs), enc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "serializing the planned inputs of %s", step.URN)
+			}
+			planned.Inputs = inputs
+		}
+		if step.Outputs != nil {
+			outputs, err := SerializeProperties(markUnknowns(step.Outputs), enc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "serializing the planned outputs of %s", step.URN)
+			}
+			planned.Outputs = outputs
+		}
+		steps = append(steps, planned)
+	}
+
+	b, err := json.Marshal(apitype.SavedPlanV1{Steps: steps})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling plan")
+	}
+	return &apitype.VersionedSavedPlan{
+		Version: apitype.SavedPlanSchemaVersionCurrent,
+		Plan:    json.RawMessage(b),
+	}, nil
+}
+
+// DeserializeSavedPlan turns a serialized saved plan back into its usual form. Secret property values are decrypted
+// using the given decrypter.
+func DeserializeSavedPlan(plan apitype.SavedPlanV1, dec config.Decrypter) (*deploy.SavedPlan, error) {
+	steps := make([]deploy.PlannedStep, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		planned := deploy.PlannedStep{Op: deploy.StepOp(step.Op), URN: step.URN}
+		if step.Inputs != nil {
+			inputs, err := DeserializeProperties(step.Inputs, dec)
+			if err != nil {
+				return nil, errors.Wrapf(err, "deserializing the planned inputs of %s", step.URN)
+			}
+			planned.Inputs = restoreUnknowns(inputs)
+		}
+		if step.Outputs != nil {
+			outputs, err := DeserializeProperties(step.Outputs, dec)
+			if err != nil {
+				return nil, errors.Wrapf(err, "deserializing the planned outputs of %s", step.URN)
+			}
+			planned.Outputs = restoreUnknowns(outputs)
+		}
+		steps = append(steps, planned)
+	}
+	return &deploy.SavedPlan{Steps: steps}, nil
+}
+
+// markUnknowns replaces each unknown value in the given properties with an object that carries the plan's unknown
+// signature so that it survives serialization.
+func markUnknowns(props resource.PropertyMap) resource.PropertyMap {
+	result := make(resource.PropertyMap)
+	for k, v := range props {
+		result[k] = markUnknown(v)
+	}
+	return result
+}
+
+func markUnknown(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsComputed() || v.IsOutput():
+		return resource.NewObjectProperty(resource.PropertyMap{
+			resource.SigKey: resource.NewStringProperty(apitype.PlanUnknownSig),
+		})
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, elem := range v.ArrayValue() {
+			arr[i] = markUnknown(elem)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		return resource.NewObjectProperty(markUnknowns(v.ObjectValue()))
+	case v.IsSecret():
+		return resource.MakeSecret(markUnknown(v.SecretValue().Element))
+	default:
+		return v
+	}
+}
+
+// restoreUnknowns replaces each object that carries the plan's unknown signature in the given properties with an
+// unknown value.
+func restoreUnknowns(props resource.PropertyMap) resource.PropertyMap {
+	result := make(resource.PropertyMap)
+	for k, v := range props {
+		result[k] = restoreUnknown(v)
+	}
+	return result
+}
+
+func restoreUnknown(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsObject() && resource.HasSig(v.ObjectValue(), apitype.PlanUnknownSig):
+		return resource.MakeComputed(resource.NewStringProperty(""))
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, elem := range v.ArrayValue() {
+			arr[i] = restoreUnknown(elem)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		return resource.NewObjectProperty(restoreUnknowns(v.ObjectValue()))
+	case v.IsSecret():
+		return resource.MakeSecret(restoreUnknown(v.SecretValue().Element))
+	default:
+		return v
+	}
+}
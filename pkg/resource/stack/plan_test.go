@@ -0,0 +1,93 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+)
+
+func TestSavedPlanSerialization(t *testing.T) {
+	crypter := config.NewSymmetricCrypter(make([]byte, config.SymmetricCrypterKeyBytes))
+	urn := resource.NewURN("stack", "proj", "", "pkgA:m:typA", "resA")
+
+	plan := &deploy.SavedPlan{
+		Steps: []deploy.PlannedStep{{
+			Op:  deploy.OpUpdate,
+			URN: urn,
+			Inputs: resource.PropertyMap{
+				"name":     resource.NewStringProperty("resA"),
+				"literal":  resource.NewStringProperty(plugin.UnknownStringValue),
+				"arn":      resource.MakeComputed(resource.NewStringProperty("")),
+				"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+				"ports": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewNumberProperty(80),
+					resource.MakeComputed(resource.NewStringProperty("")),
+				}),
+			},
+			Outputs: resource.PropertyMap{
+				"name": resource.NewStringProperty("resA"),
+			},
+		}},
+	}
+
+	versioned, err := SerializeSavedPlan(plan, crypter)
+	assert.NoError(t, err)
+	b, err := json.Marshal(versioned)
+	assert.NoError(t, err)
+
+	// Unknowns are recorded explicitly, and secrets are encrypted.
+	assert.Contains(t, string(b), apitype.PlanUnknownSig)
+	assert.NotContains(t, string(b), "hunter2")
+
+	splan, err := UnmarshalVersionedSavedPlan(b)
+	assert.NoError(t, err)
+	dplan, err := DeserializeSavedPlan(*splan, crypter)
+	assert.NoError(t, err)
+	if assert.Len(t, dplan.Steps, 1) {
+		step := dplan.Steps[0]
+		assert.Equal(t, deploy.OpUpdate, step.Op)
+		assert.Equal(t, urn, step.URN)
+		assert.Equal(t, "resA", step.Inputs["name"].StringValue())
+		assert.Equal(t, plugin.UnknownStringValue, step.Inputs["literal"].StringValue())
+		assert.True(t, step.Inputs["arn"].IsComputed())
+		assert.True(t, step.Inputs["password"].IsSecret())
+		assert.Equal(t, "hunter2", step.Inputs["password"].SecretValue().Element.StringValue())
+		ports := step.Inputs["ports"].ArrayValue()
+		if assert.Len(t, ports, 2) {
+			assert.Equal(t, float64(80), ports[0].NumberValue())
+			assert.True(t, ports[1].IsComputed())
+		}
+		assert.True(t, plan.Steps[0].Outputs.DeepEquals(step.Outputs))
+	}
+
+	// A plan that depends on unknown values cannot be checked up front.
+	assert.Error(t, dplan.CheckKnown())
+	delete(dplan.Steps[0].Inputs, "arn")
+	delete(dplan.Steps[0].Inputs, "ports")
+	assert.NoError(t, dplan.CheckKnown())
+
+	// Plans with unsupported versions are rejected.
+	_, err = UnmarshalVersionedSavedPlan([]byte(`{"version": 2, "plan": {}}`))
+	assert.Error(t, err)
+}
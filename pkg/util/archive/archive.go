@@ -57,8 +57,56 @@ func Process(path string, useDefaultExcludes bool) (*bytes.Buffer, error) {
 	return buffer, nil
 }
 
+// Walk calls fn for every directory and regular file beneath root that would be included in an archive of root,
+// honoring any .pulumiignore files and, if useDefaultIgnores is true, the default ignores. Directories are visited
+// before their contents. Paths passed to fn are rooted at root.
+func Walk(root string, useDefaultIgnores bool, fn func(path string, info os.FileInfo) error) error {
+	return walkDirectory(root, useDefaultIgnores, nil, fn)
+}
+
 func addDirectoryToZip(writer *zip.Writer, root string, dir string,
 	useDefaultIgnores bool, ignores *ignoreState) error {
+	return walkDirectory(dir, useDefaultIgnores, ignores, func(fullName string, info os.FileInfo) error {
+		if info.IsDir() {
+			// Work around an issue that will be addressed by pulumi/pulumi-ppc#95, by ensuring
+			// our zip files contain directory entries instead of just having files with paths.
+			// When the PPC fix is everywhere, we can delete this code in favor of just walking
+			// the files.
+			zh, err := zip.FileInfoHeader(info)
+			if err != nil {
+				return err
+			}
+
+			// Add a trailing slash since this is a directory.
+			zh.Name = convertPathsForZip(strings.TrimPrefix(fullName, root)) + "/"
+
+			_, err = writer.CreateHeader(zh)
+			return err
+		}
+
+		logging.V(9).Infof("adding %v to archive", fullName)
+
+		w, err := writer.Create(convertPathsForZip(strings.TrimPrefix(fullName, root)))
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(fullName)
+		if err != nil {
+			return err
+		}
+		// no defer because we want to close file as soon as possible (right after we call Copy)
+
+		_, err = io.Copy(w, file)
+		contract.IgnoreClose(file)
+		return err
+	})
+}
+
+// walkDirectory calls fn for every directory and regular file beneath dir that is not ignored, recursing into
+// directories after they have been visited.
+func walkDirectory(dir string, useDefaultIgnores bool, ignores *ignoreState,
+	fn func(path string, info os.FileInfo) error) error {
 	ignoreFilePath := path.Join(dir, workspace.IgnoreFile)
 
 	// If there is an ignorefile, process it before looking at any child paths.
@@ -109,7 +157,7 @@ func addDirectoryToZip(writer *zip.Writer, root string, dir string,
 		fullName := path.Join(dir, info.Name())
 
 		if !info.IsDir() && ignores.IsIgnored(fullName) {
-			logging.V(9).Infof("skip archiving of %v due to ignore file", fullName)
+			logging.V(9).Infof("skipping %v due to ignore file", fullName)
 			continue
 		}
 
@@ -122,44 +170,14 @@ func addDirectoryToZip(writer *zip.Writer, root string, dir string,
 		}
 
 		if info.Mode().IsDir() {
-			// Work around an issue that will be addressed by pulumi/pulumi-ppc#95, by ensuring
-			// our zip files contain directory entries instead of just having files with paths.
-			// When the PPC fix is everywhere, we can delete this code in favor of just calling
-			// addDirectoryToZip recursively
-			zh, err := zip.FileInfoHeader(info)
-			if err != nil {
-				return err
-			}
-
-			// Add a trailing slash since this is a directory.
-			zh.Name = convertPathsForZip(strings.TrimPrefix(fullName, root)) + "/"
-
-			_, err = writer.CreateHeader(zh)
-			if err != nil {
+			if err = fn(fullName, info); err != nil {
 				return err
 			}
-
-			err = addDirectoryToZip(writer, root, fullName, useDefaultIgnores, ignores)
-			if err != nil {
+			if err = walkDirectory(fullName, useDefaultIgnores, ignores, fn); err != nil {
 				return err
 			}
 		} else if info.Mode().IsRegular() {
-			logging.V(9).Infof("adding %v to archive", fullName)
-
-			w, err := writer.Create(convertPathsForZip(strings.TrimPrefix(fullName, root)))
-			if err != nil {
-				return err
-			}
-
-			file, err := os.Open(fullName)
-			if err != nil {
-				return err
-			}
-			// no defer because we want to close file as soon as possible (right after we call Copy)
-
-			_, err = io.Copy(w, file)
-			contract.IgnoreClose(file)
-			if err != nil {
+			if err = fn(fullName, info); err != nil {
 				return err
 			}
 		} else {
@@ -58,6 +58,43 @@ func TestNested(t *testing.T) {
 		fileContents{name: "node_modules/@pulumi/pulumi-cloud/excluded.txt", shouldRetain: false})
 }
 
+func TestWalk(t *testing.T) {
+	files := []fileContents{
+		{name: ".pulumiignore", contents: []byte("node_modules/pulumi/"), shouldRetain: true},
+		{name: "included.txt", shouldRetain: true},
+		{name: "node_modules/included.txt", shouldRetain: true},
+		{name: "node_modules/pulumi/excluded.txt", shouldRetain: false},
+	}
+
+	dir, err := ioutil.TempDir("", "archive-test")
+	assert.NoError(t, err)
+	defer func() {
+		contract.IgnoreError(os.RemoveAll(dir))
+	}()
+	for _, file := range files {
+		assert.NoError(t, os.MkdirAll(path.Dir(path.Join(dir, file.name)), 0755))
+		assert.NoError(t, ioutil.WriteFile(path.Join(dir, file.name), file.contents, 0644))
+	}
+
+	var expected, actual []string
+	for _, f := range files {
+		if f.shouldRetain {
+			expected = append(expected, f.name)
+		}
+	}
+	err = Walk(dir, false, func(p string, info os.FileInfo) error {
+		if !info.IsDir() {
+			actual = append(actual, strings.TrimPrefix(p, dir+"/"))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	sort.Strings(expected)
+	sort.Strings(actual)
+	assert.Equal(t, expected, actual)
+}
+
 func doArchiveTest(t *testing.T, files ...fileContents) {
 	archive, err := archiveContents(files...)
 	assert.NoError(t, err)
@@ -58,6 +58,20 @@ func DetailedError(err error) string {
 	return msg
 }
 
+// ExitCodeError is an error that causes a command to exit with a specific exit code. If Err is nil, the command exits
+// silently; otherwise, Err is reported before exiting.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e ExitCodeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit code %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
 // runPostCommandHooks runs any post-hooks present on the given cobra.Command. This logic is copied directly from
 // cobra itself; see https://github.com/spf13/cobra/blob/4dab30cb33e6633c33c787106bafbfbfdde7842d/command.go#L768-L785
 // for the original.
@@ -90,10 +104,22 @@ func runPostCommandHooks(c *cobra.Command, args []string) error {
 func RunFunc(run func(cmd *cobra.Command, args []string) error) func(*cobra.Command, []string) {
 	return func(cmd *cobra.Command, args []string) {
 		if err := run(cmd, args); err != nil {
+			code := -1
+			if exitErr, ok := err.(ExitCodeError); ok {
+				code, err = exitErr.Code, exitErr.Err
+			}
+
 			// Sadly, the fact that we hard-exit below means that it's up to us to replicate the Cobra post-run
 			// behavior here.
 			if postRunErr := runPostCommandHooks(cmd, args); postRunErr != nil {
-				err = multierror.Append(err, postRunErr)
+				if err == nil {
+					err = postRunErr
+				} else {
+					err = multierror.Append(err, postRunErr)
+				}
+			}
+			if err == nil {
+				os.Exit(code)
 			}
 
 			// If there is a stack trace, and logging is enabled, append it.  Otherwise, debug logging it.
@@ -105,7 +131,7 @@ func RunFunc(run func(cmd *cobra.Command, args []string) error) func(*cobra.Comm
 				logging.V(3).Infof(DetailedError(err))
 			}
 
-			ExitError(msg)
+			exitErrorCode(code, msg)
 		}
 	}
 }
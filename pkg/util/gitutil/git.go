@@ -66,6 +66,32 @@ func GetGitHubProjectForOrigin(dir string) (string, string, error) {
 	return GetGitHubProjectForOriginByRepo(repo)
 }
 
+// GetGitRemoteURLForOrigin returns the URL of the "origin" remote of the git repository containing dir. Any
+// credentials embedded in the URL are removed.
+func GetGitRemoteURLForOrigin(dir string) (string, error) {
+	repo, err := GetGitRepository(dir)
+	if repo == nil {
+		return "", fmt.Errorf("no git repository found from %v", dir)
+	}
+	if err != nil {
+		return "", err
+	}
+	remote, err := repo.Remote("origin")
+	if err != nil {
+		return "", errors.Wrap(err, "could not read origin information")
+	}
+	if len(remote.Config().URLs) == 0 {
+		return "", errors.New("the origin remote has no URL")
+	}
+
+	remoteURL := remote.Config().URLs[0]
+	if u, err := url.Parse(remoteURL); err == nil && u.User != nil {
+		u.User = nil
+		remoteURL = u.String()
+	}
+	return remoteURL, nil
+}
+
 // GetGitHubProjectForOriginByRepo returns the GitHub login, and GitHub repo name if the "origin" remote is
 // a GitHub URL.
 func GetGitHubProjectForOriginByRepo(repo *git.Repository) (string, string, error) {
@@ -110,6 +110,12 @@ func (c *Config) GetUint64(key string) uint64 {
 	return GetUint64(c.ctx, c.fullKey(key))
 }
 
+// GetSecret loads an optional configuration value by its key, wrapped as a secret, or returns a secret "" if it
+// doesn't exist.
+func (c *Config) GetSecret(key string) pulumi.Secret {
+	return GetSecret(c.ctx, c.fullKey(key))
+}
+
 // Require loads a configuration value by its key, or panics if it doesn't exist.
 func (c *Config) Require(key string) string {
 	return Require(c.ctx, c.fullKey(key))
@@ -180,6 +186,11 @@ func (c *Config) RequireUint64(key string) uint64 {
 	return RequireUint64(c.ctx, c.fullKey(key))
 }
 
+// RequireSecret loads a configuration value by its key, wrapped as a secret, or panics if it doesn't exist.
+func (c *Config) RequireSecret(key string) pulumi.Secret {
+	return RequireSecret(c.ctx, c.fullKey(key))
+}
+
 // Try loads a configuration value by its key, returning a non-nil error if it doesn't exist.
 func (c *Config) Try(key string) (string, error) {
 	return Try(c.ctx, c.fullKey(key))
@@ -249,3 +260,8 @@ func (c *Config) TryUint32(key string) (uint32, error) {
 func (c *Config) TryUint64(key string) (uint64, error) {
 	return TryUint64(c.ctx, c.fullKey(key))
 }
+
+// TrySecret loads a configuration value by its key, wrapped as a secret, or returns an error if it doesn't exist.
+func (c *Config) TrySecret(key string) (pulumi.Secret, error) {
+	return TrySecret(c.ctx, c.fullKey(key))
+}
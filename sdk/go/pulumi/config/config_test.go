@@ -76,4 +76,13 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, 99.963, k4)
 	_, err = cfg.Try("missing")
 	assert.NotNil(t, err)
+
+	// Test the secret variants, which wrap the value so that it is marshaled as a secret.
+	assert.Equal(t, pulumi.Secret{Value: "a string value"}, cfg.GetSecret("sss"))
+	assert.Equal(t, pulumi.Secret{Value: "a string value"}, cfg.RequireSecret("sss"))
+	k5, err := cfg.TrySecret("sss")
+	assert.Nil(t, err)
+	assert.Equal(t, pulumi.Secret{Value: "a string value"}, k5)
+	_, err = cfg.TrySecret("missing")
+	assert.NotNil(t, err)
 }
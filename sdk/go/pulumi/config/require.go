@@ -107,3 +107,8 @@ func RequireUint64(ctx *pulumi.Context, key string) uint64 {
 	v := Require(ctx, key)
 	return cast.ToUint64(v)
 }
+
+// RequireSecret loads a configuration value by its key, wrapped as a secret, or panics if it doesn't exist.
+func RequireSecret(ctx *pulumi.Context, key string) pulumi.Secret {
+	return pulumi.Secret{Value: Require(ctx, key)}
+}
@@ -147,3 +147,12 @@ func TryUint64(ctx *pulumi.Context, key string) (uint64, error) {
 	}
 	return cast.ToUint64(v), nil
 }
+
+// TrySecret loads a configuration value by its key, wrapped as a secret, or returns an error if it doesn't exist.
+func TrySecret(ctx *pulumi.Context, key string) (pulumi.Secret, error) {
+	v, err := Try(ctx, key)
+	if err != nil {
+		return pulumi.Secret{}, err
+	}
+	return pulumi.Secret{Value: v}, nil
+}
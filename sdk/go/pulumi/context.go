@@ -25,6 +25,8 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/tokens"
 	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
 )
 
@@ -190,10 +192,12 @@ func (ctx *Context) ReadResource(
 	go func() {
 		glog.V(9).Infof("ReadResource(%s, %s): Goroutine spawned, RPC call being made", t, name)
 		resp, err := ctx.monitor.ReadResource(ctx.ctx, &pulumirpc.ReadResourceRequest{
-			Type:       t,
-			Name:       name,
-			Parent:     op.parent,
-			Properties: op.rpcProps,
+			Id:           string(id),
+			Type:         t,
+			Name:         name,
+			Parent:       op.parent,
+			Properties:   op.rpcProps,
+			Dependencies: op.deps,
 		})
 		if err != nil {
 			glog.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -242,6 +246,11 @@ func (ctx *Context) RegisterResource(
 		return nil, err
 	}
 
+	// Resolve any aliases to the URNs that the resource may previously have been registered under.
+	aliases := ctx.getOptsAliases(t, name, URN(op.parent), opts...)
+	importID := ctx.getOptsImport(opts...)
+	customTimeouts := ctx.getOptsCustomTimeouts(opts...)
+
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err = ctx.beginRPC(); err != nil {
 		return nil, err
@@ -252,13 +261,17 @@ func (ctx *Context) RegisterResource(
 	go func() {
 		glog.V(9).Infof("RegisterResource(%s, %s): Goroutine spawned, RPC call being made", t, name)
 		resp, err := ctx.monitor.RegisterResource(ctx.ctx, &pulumirpc.RegisterResourceRequest{
-			Type:         t,
-			Name:         name,
-			Parent:       op.parent,
-			Object:       op.rpcProps,
-			Custom:       custom,
-			Protect:      op.protect,
-			Dependencies: op.deps,
+			Type:           t,
+			Name:           name,
+			Parent:         op.parent,
+			Object:         op.rpcProps,
+			Custom:         custom,
+			Protect:        op.protect,
+			Dependencies:   op.deps,
+			IgnoreChanges:  op.ignoreChanges,
+			Aliases:        aliases,
+			ImportId:       string(importID),
+			CustomTimeouts: customTimeouts,
 		})
 		if err != nil {
 			glog.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -296,23 +309,25 @@ func (ctx *Context) RegisterResource(
 
 // resourceOperation reflects all of the inputs necessary to perform core resource RPC operations.
 type resourceOperation struct {
-	ctx      *Context
-	parent   string
-	deps     []string
-	protect  bool
-	props    map[string]interface{}
-	rpcProps *structpb.Struct
-	outURN   *resourceOutput
-	outID    *resourceOutput
-	outState map[string]*resourceOutput
+	ctx           *Context
+	parent        string
+	deps          []string
+	protect       bool
+	ignoreChanges []string
+	props         map[string]interface{}
+	rpcProps      *structpb.Struct
+	outURN        *resourceOutput
+	outID         *resourceOutput
+	outState      map[string]*resourceOutput
 }
 
 // newResourceOperation prepares the inputs for a resource operation, shared between read and register.
 func (ctx *Context) newResourceOperation(custom bool, props map[string]interface{},
 	opts ...ResourceOpt) (*resourceOperation, error) {
-	// Get the parent and dependency URNs from the options, in addition to the protection bit.  If there wasn't an
-	// explicit parent, and a root stack resource exists, we will automatically parent to that.
-	parent, optDeps, protect := ctx.getOpts(opts...)
+	// Get the parent and dependency URNs from the options, in addition to the protection bit and the ignored property
+	// paths.  If there wasn't an explicit parent, and a root stack resource exists, we will automatically parent to
+	// that.
+	parent, optDeps, protect, ignoreChanges := ctx.getOpts(opts...)
 
 	// Serialize all properties, first by awaiting them, and then marshaling them to the requisite gRPC values.
 	keys, rpcProps, rpcDeps, err := marshalInputs(props)
@@ -352,15 +367,16 @@ func (ctx *Context) newResourceOperation(custom bool, props map[string]interface
 	}
 
 	return &resourceOperation{
-		ctx:      ctx,
-		parent:   string(parent),
-		deps:     deps,
-		protect:  protect,
-		props:    props,
-		rpcProps: rpcProps,
-		outURN:   urn,
-		outID:    id,
-		outState: state,
+		ctx:           ctx,
+		parent:        string(parent),
+		deps:          deps,
+		protect:       protect,
+		ignoreChanges: ignoreChanges,
+		props:         props,
+		rpcProps:      rpcProps,
+		outURN:        urn,
+		outID:         id,
+		outState:      state,
 	}, nil
 }
 
@@ -422,11 +438,13 @@ type resourceOutput struct {
 }
 
 // getOpts returns a set of resource options from an array of them.  This includes the parent URN, any
-// dependency URNs, and a boolean indicating whether the resource is to be protected.
-func (ctx *Context) getOpts(opts ...ResourceOpt) (URN, []URN, bool) {
+// dependency URNs, a boolean indicating whether the resource is to be protected, and the property paths whose
+// changes should be ignored.
+func (ctx *Context) getOpts(opts ...ResourceOpt) (URN, []URN, bool, []string) {
 	return ctx.getOptsParentURN(opts...),
 		ctx.getOptsDepURNs(opts...),
-		ctx.getOptsProtect(opts...)
+		ctx.getOptsProtect(opts...),
+		ctx.getOptsIgnoreChanges(opts...)
 }
 
 // getOptsParentURN returns a URN to use for a resource, given its options, defaulting to the current stack resource.
@@ -460,6 +478,83 @@ func (ctx *Context) getOptsProtect(opts ...ResourceOpt) bool {
 	return false
 }
 
+// getOptsIgnoreChanges returns the set of property paths whose changes should be ignored in a resource's options.
+func (ctx *Context) getOptsIgnoreChanges(opts ...ResourceOpt) []string {
+	var paths []string
+	for _, opt := range opts {
+		paths = append(paths, opt.IgnoreChanges...)
+	}
+	return paths
+}
+
+// getOptsAliases resolves the aliases in a resource's options to URNs.  Any unset fields of an alias default to the
+// corresponding values of the resource with the given type, name, and parent.
+func (ctx *Context) getOptsAliases(t, name string, parent URN, opts ...ResourceOpt) []string {
+	var urns []string
+	for _, opt := range opts {
+		for _, alias := range opt.Aliases {
+			if alias.URN != "" {
+				urns = append(urns, string(alias.URN))
+				continue
+			}
+
+			aliasName, aliasType, aliasParent := name, t, parent
+			project, stack := ctx.Project(), ctx.Stack()
+			if alias.Name != "" {
+				aliasName = alias.Name
+			}
+			if alias.Type != "" {
+				aliasType = alias.Type
+			}
+			if alias.NoParent {
+				aliasParent = ""
+			} else if alias.Parent != nil {
+				aliasParent = alias.Parent.URN()
+			}
+			if alias.Project != "" {
+				project = alias.Project
+			}
+			if alias.Stack != "" {
+				stack = alias.Stack
+			}
+
+			// As in the engine, children of the root stack resource do not include its type in their URNs.
+			var parentType tokens.Type
+			if p := resource.URN(aliasParent); p != "" && p.Type() != resource.RootStackType {
+				parentType = p.QualifiedType()
+			}
+			urn := resource.NewURN(tokens.QName(stack), tokens.PackageName(project), parentType,
+				tokens.Type(aliasType), tokens.QName(aliasName))
+			urns = append(urns, string(urn))
+		}
+	}
+	return urns
+}
+
+// getOptsImport returns the ID of the existing resource to import, if any, in a resource's options.
+func (ctx *Context) getOptsImport(opts ...ResourceOpt) ID {
+	for _, opt := range opts {
+		if opt.Import != "" {
+			return opt.Import
+		}
+	}
+	return ""
+}
+
+// getOptsCustomTimeouts returns the custom timeouts, if any, in a resource's options.
+func (ctx *Context) getOptsCustomTimeouts(opts ...ResourceOpt) *pulumirpc.RegisterResourceRequest_CustomTimeouts {
+	for _, opt := range opts {
+		if opt.CustomTimeouts != nil {
+			return &pulumirpc.RegisterResourceRequest_CustomTimeouts{
+				Create: opt.CustomTimeouts.Create,
+				Update: opt.CustomTimeouts.Update,
+				Delete: opt.CustomTimeouts.Delete,
+			}
+		}
+	}
+	return nil
+}
+
 // noMoreRPCs is a sentinel value used to stop subsequent RPCs from occurring.
 const noMoreRPCs = -1
 
@@ -321,6 +321,12 @@ func (out *Output) URN() (URN, error) {
 // Outputs is a map of property name to value, one for each resource output property.
 type Outputs map[string]*Output
 
+// Secret wraps an input value that is sensitive.  A secret is marshaled with a flag that tells the engine to encrypt
+// it in checkpoints and to mask it in its display; it is otherwise used exactly like the value it wraps.
+type Secret struct {
+	Value interface{}
+}
+
 // ArchiveOutput is an Output that is typed to return archive values.
 type ArchiveOutput Output
 
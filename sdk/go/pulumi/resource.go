@@ -51,4 +51,43 @@ type ResourceOpt struct {
 	DependsOn []Resource
 	// Protect, when set to true, ensures that this resource cannot be deleted (without first setting it to false).
 	Protect bool
+	// IgnoreChanges is an optional list of property paths (e.g. "tags.owner") whose changes should be ignored when
+	// updating this resource.
+	IgnoreChanges []string
+	// Aliases is an optional list of identifiers used to find and use existing resources that were previously
+	// registered under a different name, type, parent, project, or stack.
+	Aliases []Alias
+	// Import, when provided with a resource ID, indicates that this resource's provider should import its state from
+	// the cloud resource with the given ID. The inputs to the resource's constructor must match the existing resource
+	// exactly, or the import will fail.
+	Import ID
+	// CustomTimeouts is an optional configuration block used for CRUD operations.
+	CustomTimeouts *CustomTimeouts
+}
+
+// CustomTimeouts overrides the default timeouts of a resource's provisioning operations. Each timeout is a duration
+// string, such as "30s" or "1h30m"; an empty string means that the provider's default timeout is used.
+type CustomTimeouts struct {
+	Create string
+	Update string
+	Delete string
+}
+
+// Alias is a partial description of a prior incarnation of a resource.  Any fields that are left unset default to the
+// corresponding values of the resource being registered.
+type Alias struct {
+	// URN is the exact URN of the prior resource.  If it is set, all other fields are ignored.
+	URN URN
+	// Name is the previous name of the resource.
+	Name string
+	// Type is the previous type of the resource.
+	Type string
+	// Parent is the previous parent of the resource.
+	Parent Resource
+	// NoParent indicates that the resource previously had no parent.  If it is set, Parent is ignored.
+	NoParent bool
+	// Project is the previous project of the resource.
+	Project string
+	// Stack is the previous stack of the resource.
+	Stack string
 }
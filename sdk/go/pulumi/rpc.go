@@ -55,7 +55,7 @@ func marshalInputs(props map[string]interface{}) ([]string, *structpb.Struct, []
 	// Marshal all properties for the RPC call.
 	m, err := plugin.MarshalProperties(
 		resource.NewPropertyMapFromMap(pmap),
-		plugin.MarshalOptions{KeepUnknowns: true},
+		plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true},
 	)
 	return keys, m, depURNs, err
 }
@@ -105,6 +105,10 @@ func marshalInput(v interface{}) (interface{}, []Resource, error) {
 			"path":                t.Path(),
 			"uri":                 t.URI(),
 		}, nil, nil
+	case Secret:
+		return marshalInputSecret(&t)
+	case *Secret:
+		return marshalInputSecret(t)
 	case Output:
 		return marshalInputOutput(&t)
 	case *Output:
@@ -175,6 +179,15 @@ func marshalInput(v interface{}) (interface{}, []Resource, error) {
 	return nil, nil, errors.Errorf("unrecognized input property type: %v (%v)", v, reflect.TypeOf(v))
 }
 
+func marshalInputSecret(s *Secret) (interface{}, []Resource, error) {
+	// Marshal the wrapped value and mark the result as a secret, so that the engine encrypts it.
+	e, d, err := marshalInput(s.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resource.Secret{Element: resource.NewPropertyValue(e)}, d, nil
+}
+
 func marshalInputOutput(out *Output) (interface{}, []Resource, error) {
 	// Await the value and return its raw value.
 	ov, known, err := out.Value()
@@ -19,6 +19,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/go/pulumi/asset"
 )
 
@@ -86,3 +88,32 @@ func TestMarshalRoundtrip(t *testing.T) {
 		}
 	}
 }
+
+// TestMarshalSecrets ensures that values wrapped in Secret, directly or through an output, are marshaled as secrets.
+func TestMarshalSecrets(t *testing.T) {
+	out, resolve, _ := NewOutput(nil)
+	go func() {
+		resolve(Secret{Value: "swordfish"}, true)
+	}()
+
+	_, m, _, err := marshalInputs(map[string]interface{}{
+		"password": Secret{Value: "hunter2"},
+		"region":   "us-west-2",
+		"nested":   map[string]interface{}{"values": []interface{}{out, "plain"}},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	props, err := plugin.UnmarshalProperties(m, plugin.MarshalOptions{KeepSecrets: true})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.True(t, props["password"].IsSecret())
+	assert.Equal(t, resource.NewStringProperty("hunter2"), props["password"].SecretValue().Element)
+	assert.False(t, props["region"].IsSecret())
+	values := props["nested"].ObjectValue()["values"].ArrayValue()
+	assert.True(t, values[0].IsSecret())
+	assert.Equal(t, resource.NewStringProperty("swordfish"), values[0].SecretValue().Element)
+	assert.False(t, values[1].IsSecret())
+}
@@ -0,0 +1,88 @@
+// Copyright 2016-2018, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"github.com/pkg/errors"
+)
+
+// StackReference is a resource that reads the outputs of another stack. The outputs are read each time the program
+// runs, including during previews, and any resource that uses them records a dependency on the reference.
+type StackReference struct {
+	state *ResourceState
+	name  string
+}
+
+// NewStackReference creates a resource named name that reads the outputs of the stack with the given fully-qualified
+// name (e.g. "organization/project/stack" when using the Pulumi service, or just "stack" when using a local backend).
+// If stackName is empty, name is used as the name of the stack.
+func NewStackReference(ctx *Context, name, stackName string, opts ...ResourceOpt) (*StackReference, error) {
+	if stackName == "" {
+		stackName = name
+	}
+
+	state, err := ctx.ReadResource("pulumi:pulumi:StackReference", name, ID(stackName), map[string]interface{}{
+		"name":    stackName,
+		"outputs": nil,
+	}, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading stack reference %s", name)
+	}
+	return &StackReference{state: state, name: stackName}, nil
+}
+
+// URN is this resource's stable logical URN. It blocks until the reference has been read.
+func (r *StackReference) URN() URN {
+	urn, err := (*Output)(r.state.URN).URN()
+	if err != nil {
+		return ""
+	}
+	return urn
+}
+
+// ID is the ID of the reference, which is the name of the referenced stack.
+func (r *StackReference) ID() ID {
+	return ID(r.name)
+}
+
+// Name returns the fully-qualified name of the referenced stack.
+func (r *StackReference) Name() string {
+	return r.name
+}
+
+// Outputs returns all of the referenced stack's outputs, as a map from output name to value.
+func (r *StackReference) Outputs() *Output {
+	return r.GetOutput("")
+}
+
+// GetOutput returns the referenced stack's output with the given name. The value is nil if the stack has no such
+// output. If name is empty, all of the stack's outputs are returned.
+func (r *StackReference) GetOutput(name string) *Output {
+	out, resolve, reject := NewOutput([]Resource{r})
+	go func() {
+		v, known, err := r.state.State["outputs"].Map()
+		switch {
+		case err != nil:
+			reject(err)
+		case !known:
+			resolve(nil, false)
+		case name == "":
+			resolve(v, true)
+		default:
+			resolve(v[name], true)
+		}
+	}()
+	return out
+}
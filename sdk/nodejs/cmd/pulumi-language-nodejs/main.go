@@ -60,6 +60,9 @@ const (
 
 	// The runtime expects the config object to be saved to this environment variable.
 	pulumiConfigVar = "PULUMI_CONFIG"
+
+	// The runtime expects the list of secret config keys to be saved to this environment variable.
+	pulumiConfigSecretKeysVar = "PULUMI_CONFIG_SECRET_KEYS"
 )
 
 // Launches the language host RPC endpoint, which in turn fires
@@ -287,9 +290,15 @@ func (host *nodeLanguageHost) Run(ctx context.Context, req *pulumirpc.RunRequest
 		err = errors.Wrap(err, "failed to serialize configuration")
 		return nil, err
 	}
+	configSecretKeys, err := host.constructConfigSecretKeys(req)
+	if err != nil {
+		err = errors.Wrap(err, "failed to serialize secret configuration keys")
+		return nil, err
+	}
 
 	env := os.Environ()
 	env = append(env, pulumiConfigVar+"="+string(config))
+	env = append(env, pulumiConfigSecretKeysVar+"="+configSecretKeys)
 
 	if host.typescript {
 		env = append(env, "PULUMI_NODEJS_TYPESCRIPT=true")
@@ -390,6 +399,26 @@ func (host *nodeLanguageHost) constructConfig(req *pulumirpc.RunRequest) (string
 	return string(configJSON), nil
 }
 
+// constructConfigSecretKeys json-serializes the list of secret configuration keys given as part of a RunRequest.
+// The keys use the same format as those of the config object returned by constructConfig.
+func (host *nodeLanguageHost) constructConfigSecretKeys(req *pulumirpc.RunRequest) (string, error) {
+	transformedKeys := []string{}
+	for _, k := range req.GetConfigSecretKeys() {
+		pk, err := config.ParseKey(k)
+		if err != nil {
+			return "", err
+		}
+		transformedKeys = append(transformedKeys, pk.Namespace()+":config:"+pk.Name())
+	}
+
+	configSecretKeysJSON, err := json.Marshal(transformedKeys)
+	if err != nil {
+		return "", err
+	}
+
+	return string(configSecretKeysJSON), nil
+}
+
 func (host *nodeLanguageHost) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
 	return &pulumirpc.PluginInfo{
 		Version: version.Version,
@@ -72,3 +72,22 @@ func TestConfig(t *testing.T) {
 		assert.JSONEq(tt, "{}", str)
 	})
 }
+
+func TestConfigSecretKeys(t *testing.T) {
+	t.Parallel()
+	t.Run("ConfigSecretKeys-Empty", func(tt *testing.T) {
+		host := &nodeLanguageHost{}
+		rr := &pulumirpc.RunRequest{Project: "foo"}
+		str, err := host.constructConfigSecretKeys(rr)
+		assert.NoError(tt, err)
+		assert.JSONEq(tt, "[]", str)
+	})
+
+	t.Run("ConfigSecretKeys-OldFormat", func(tt *testing.T) {
+		host := &nodeLanguageHost{}
+		rr := &pulumirpc.RunRequest{Project: "foo", ConfigSecretKeys: []string{"foo:bar"}}
+		str, err := host.constructConfigSecretKeys(rr)
+		assert.NoError(tt, err)
+		assert.JSONEq(tt, `["foo:config:bar"]`, str)
+	})
+}
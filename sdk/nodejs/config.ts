@@ -14,8 +14,10 @@
 
 import * as util from "util";
 import { RunError } from "./errors";
+import * as log from "./log";
 import { getProject } from "./metadata";
-import { getConfig } from "./runtime";
+import { Output, secret } from "./resource";
+import { getConfig, isConfigSecret } from "./runtime";
 
 /**
  * Config is a bag of related configuration state.  Each bag contains any number of configuration variables, indexed by
@@ -50,28 +52,20 @@ export class Config {
      * @param opts An options bag to constrain legal values.
      */
     public get(key: string, opts?: StringConfigOptions): string | undefined {
-        const v = getConfig(this.fullKey(key));
-        if (v === undefined) {
-            return undefined;
-        }
-        if (opts) {
-            if (opts.allowedValues !== undefined && opts.allowedValues.indexOf(v) === -1) {
-                throw new ConfigEnumError(this.fullKey(key), v, opts.allowedValues);
-            } else if (opts.minLength !== undefined && v.length < opts.minLength) {
-                throw new ConfigRangeError(this.fullKey(key), v, opts.minLength, undefined);
-            } else if (opts.maxLength !== undefined && v.length > opts.maxLength) {
-                throw new ConfigRangeError(this.fullKey(key), v, undefined, opts.maxLength);
-            } else if (opts.pattern !== undefined) {
-                let pattern = opts.pattern;
-                if (typeof pattern === "string") {
-                    pattern = new RegExp(pattern);
-                }
-                if (!pattern.test(v)) {
-                    throw new ConfigPatternError(this.fullKey(key), v, pattern);
-                }
-            }
-        }
-        return v;
+        return this.getImpl(key, opts, /*asSecret*/ false);
+    }
+
+    /**
+     * getSecret loads an optional configuration value by its key, marked as a secret, or undefined if it doesn't
+     * exist.  The value is encrypted in checkpoints and masked in the display wherever it, or any output derived from
+     * it, is used.
+     *
+     * @param key The key to lookup.
+     * @param opts An options bag to constrain legal values.
+     */
+    public getSecret(key: string, opts?: StringConfigOptions): Output<string> | undefined {
+        const v = this.getImpl(key, opts, /*asSecret*/ true);
+        return v === undefined ? undefined : secret(v);
     }
 
     /**
@@ -151,6 +145,22 @@ export class Config {
         return v;
     }
 
+    /**
+     * requireSecret loads a configuration value by its given key, marked as a secret.  If it doesn't exist, an error
+     * is thrown.  The value is encrypted in checkpoints and masked in the display wherever it, or any output derived
+     * from it, is used.
+     *
+     * @param key The key to lookup.
+     * @param opts An options bag to constrain legal values.
+     */
+    public requireSecret(key: string, opts?: StringConfigOptions): Output<string> {
+        const v = this.getImpl(key, opts, /*asSecret*/ true);
+        if (v === undefined) {
+            throw new ConfigMissingError(this.fullKey(key));
+        }
+        return secret(v);
+    }
+
     /**
      * requireBoolean loads a configuration value, as a boolean, by its given key.  If it doesn't exist, or the
      * configuration value is not a legal boolean, an error is thrown.
@@ -194,6 +204,40 @@ export class Config {
         return v;
     }
 
+    /**
+     * getImpl loads an optional configuration value by its key and checks it against opts.  Unless the value is being
+     * read as a secret, a warning is issued if it holds a secret.
+     */
+    private getImpl(key: string, opts: StringConfigOptions | undefined, asSecret: boolean): string | undefined {
+        const fullKey = this.fullKey(key);
+        const v = getConfig(fullKey);
+        if (v === undefined) {
+            return undefined;
+        }
+        if (!asSecret && isConfigSecret(fullKey)) {
+            log.warn(`Configuration '${fullKey}' is a secret; read it with 'getSecret' or 'requireSecret' ` +
+                "so that its value is not stored or displayed in plaintext");
+        }
+        if (opts) {
+            if (opts.allowedValues !== undefined && opts.allowedValues.indexOf(v) === -1) {
+                throw new ConfigEnumError(this.fullKey(key), v, opts.allowedValues);
+            } else if (opts.minLength !== undefined && v.length < opts.minLength) {
+                throw new ConfigRangeError(this.fullKey(key), v, opts.minLength, undefined);
+            } else if (opts.maxLength !== undefined && v.length > opts.maxLength) {
+                throw new ConfigRangeError(this.fullKey(key), v, undefined, opts.maxLength);
+            } else if (opts.pattern !== undefined) {
+                let pattern = opts.pattern;
+                if (typeof pattern === "string") {
+                    pattern = new RegExp(pattern);
+                }
+                if (!pattern.test(v)) {
+                    throw new ConfigPatternError(this.fullKey(key), v, pattern);
+                }
+            }
+        }
+        return v;
+    }
+
     /**
      * fullKey turns a simple configuration key into a fully resolved one, by prepending the bag's name.
      *
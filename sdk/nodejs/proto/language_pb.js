@@ -404,7 +404,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.RunRequest.repeatedFields_ = [5];
+proto.pulumirpc.RunRequest.repeatedFields_ = [5,10];
 
 
 
@@ -443,7 +443,8 @@ proto.pulumirpc.RunRequest.toObject = function(includeInstance, msg) {
     configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
     dryrun: jspb.Message.getFieldWithDefault(msg, 7, false),
     parallel: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    monitorAddress: jspb.Message.getFieldWithDefault(msg, 9, "")
+    monitorAddress: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    configsecretkeysList: jspb.Message.getRepeatedField(msg, 10)
   };
 
   if (includeInstance) {
@@ -518,6 +519,10 @@ proto.pulumirpc.RunRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setMonitorAddress(value);
       break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addConfigsecretkeys(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -607,6 +612,13 @@ proto.pulumirpc.RunRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getConfigsecretkeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -764,6 +776,35 @@ proto.pulumirpc.RunRequest.prototype.setMonitorAddress = function(value) {
 };
 
 
+/**
+ * repeated string configSecretKeys = 10;
+ * @return {!Array.<string>}
+ */
+proto.pulumirpc.RunRequest.prototype.getConfigsecretkeysList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.pulumirpc.RunRequest.prototype.setConfigsecretkeysList = function(value) {
+  jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.pulumirpc.RunRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+proto.pulumirpc.RunRequest.prototype.clearConfigsecretkeysList = function() {
+  this.setConfigsecretkeysList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -310,6 +310,13 @@ export class Output<T> {
      */
     /* @internal */ public isKnown: Promise<boolean>;
 
+    /**
+     * Whether or not this 'Output' holds a secret.  Secret outputs, and every output derived from one through .apply
+     * or 'pulumi.all', are sent to the engine marked as secrets, so that their values are encrypted in checkpoints and
+     * masked in the display.  Use 'pulumi.secret' to create one.
+     */
+    /* @internal */ public isSecret: Promise<boolean>;
+
     /**
      * Method that actually produces the concrete value of this output, as well as the total
      * deployment-time set of resources this output depends on.
@@ -380,8 +387,10 @@ export class Output<T> {
     }
 
     /* @internal */ public constructor(
-            resources: Set<Resource>, promise: Promise<T>, isKnown: Promise<boolean>) {
+            resources: Set<Resource>, promise: Promise<T>, isKnown: Promise<boolean>,
+            isSecret: Promise<boolean> = Promise.resolve(false)) {
         this.isKnown = isKnown;
+        this.isSecret = isSecret;
 
         // Always create a copy so that no one accidentally modifies our Resource list.
         this.resources = () => new Set<Resource>(resources);
@@ -399,6 +408,13 @@ export class Output<T> {
             // not known itself, then the result we return should not be known.
             const resultIsKnown = Promise.all([isKnown, innerIsKnown]).then(([k1, k2]) => k1 && k2);
 
+            // Likewise, the result is a secret if we are one, or if the func lifted an inner secret Output.
+            let innerIsSecretResolve: (val: boolean) => void;
+            const innerIsSecret = new Promise<boolean>(resolve => {
+                innerIsSecretResolve = resolve;
+            });
+            const resultIsSecret = Promise.all([isSecret, innerIsSecret]).then(([s1, s2]) => s1 || s2);
+
             return new Output<U>(resources, promise.then(async v => {
                 try {
                     if (runtime.isDryRun()) {
@@ -410,6 +426,7 @@ export class Output<T> {
                             // We didn't actually run the function, our new Output is definitely
                             // **not** known.
                             innerIsKnownResolve(false);
+                            innerIsSecretResolve(false);
                             return <U><any>undefined;
                         }
                     }
@@ -426,10 +443,12 @@ export class Output<T> {
                         // We have to properly forward that along to our outer output.  That way the Outer
                         // output doesn't consider itself 'known' then the inner Output did not.
                         innerIsKnownResolve(await transformed.isKnown);
+                        innerIsSecretResolve(!!(await transformed.isSecret));
                         return await transformed.promise();
                     } else {
                         // We successfully ran the inner function.  Our new Output should be considered known.
                         innerIsKnownResolve(true);
+                        innerIsSecretResolve(false);
                         return transformed;
                     }
                 }
@@ -439,8 +458,9 @@ export class Output<T> {
                     // not-known. Awaiting this Output's promise() will still throw, but await'ing
                     // the isKnown bit will just return 'false'.
                     innerIsKnownResolve(false);
+                    innerIsSecretResolve(false);
                 }
-            }), resultIsKnown);
+            }), resultIsKnown, resultIsSecret);
         };
 
         this.get = () => {
@@ -503,6 +523,21 @@ export function output<T>(val: Input<T | undefined>): Output<Unwrap<T | undefine
     }
 }
 
+/**
+ * [secret] takes any Input value and converts it into an Output that is marked as a secret.  The value is sent to the
+ * engine marked as a secret, so that it is encrypted in checkpoints and masked in the display, and so is every Output
+ * derived from it through .apply or 'pulumi.all'.
+ *
+ * ```ts
+ *      var password = pulumi.secret(process.env.DB_PASSWORD);
+ *      var db = new Database("db", { password: password });
+ * ```
+ */
+export function secret<T>(val: Input<T>): Output<Unwrap<T>> {
+    const o = output(val);
+    return new Output(o.resources(), o.promise(), o.isKnown, /*isSecret*/ Promise.resolve(true));
+}
+
 function createSimpleOutput(val: any) {
     return new Output(new Set(), Promise.resolve(val), /*isKnown*/ Promise.resolve(true));
 }
@@ -537,18 +572,19 @@ export function all<T>(val: Input<T>[] | Record<string, Input<T>>): Output<any>
     if (val instanceof Array) {
         const allOutputs = val.map(v => output(v));
 
-        const [resources, isKnown] = getResourcesAndIsKnown(allOutputs);
+        const [resources, isKnown, isSecret] = getResourcesAndDetails(allOutputs);
         const promisedArray = Promise.all(allOutputs.map(o => o.promise()));
 
-        return new Output<Unwrap<T>[]>(new Set<Resource>(resources), promisedArray, isKnown);
+        return new Output<Unwrap<T>[]>(new Set<Resource>(resources), promisedArray, isKnown, isSecret);
     } else {
         const keysAndOutputs = Object.keys(val).map(key => ({ key, value: output(val[key]) }));
         const allOutputs = keysAndOutputs.map(kvp => kvp.value);
 
-        const [resources, isKnown] = getResourcesAndIsKnown(allOutputs);
+        const [resources, isKnown, isSecret] = getResourcesAndDetails(allOutputs);
         const promisedObject = getPromisedObject(keysAndOutputs);
 
-        return new Output<Record<string, Unwrap<T>>>(new Set<Resource>(resources), promisedObject, isKnown);
+        return new Output<Record<string, Unwrap<T>>>(
+            new Set<Resource>(resources), promisedObject, isKnown, isSecret);
     }
 }
 
@@ -561,13 +597,17 @@ async function getPromisedObject<T>(keysAndOutputs: { key: string, value: Output
     return result;
 }
 
-function getResourcesAndIsKnown<T>(allOutputs: Output<Unwrap<T>>[]): [Resource[], Promise<boolean>] {
+function getResourcesAndDetails<T>(
+        allOutputs: Output<Unwrap<T>>[]): [Resource[], Promise<boolean>, Promise<boolean>] {
     const allResources = allOutputs.reduce<Resource[]>((arr, o) => (arr.push(...o.resources()), arr), []);
 
     // A merged output is known if all of its inputs are known.
     const isKnown = Promise.all(allOutputs.map(o => o.isKnown)).then(ps => ps.every(b => b));
 
-    return [allResources, isKnown];
+    // A merged output is a secret if any of its inputs is a secret.
+    const isSecret = Promise.all(allOutputs.map(o => o.isSecret)).then(ps => ps.some(b => !!b));
+
+    return [allResources, isKnown, isSecret];
 }
 
 /**
@@ -16,8 +16,14 @@
  * configEnvKey is the environment variable key that the language plugin uses to set configuration values.
  */
 const configEnvKey = "PULUMI_CONFIG";
+/**
+ * configSecretKeysEnvKey is the environment variable key that the language plugin uses to list the configuration keys
+ * whose values are secrets.
+ */
+const configSecretKeysEnvKey = "PULUMI_CONFIG_SECRET_KEYS";
 
 const config: {[key: string]: string} = parseConfig();
+const secretKeys: Set<string> = parseSecretKeys();
 
 /**
  * allConfig returns a copy of the full config map.
@@ -40,6 +46,13 @@ export function getConfig(k: string): string | undefined {
     return config[k];
 }
 
+/**
+ * isConfigSecret returns true if the given configuration variable holds a secret value.
+ */
+export function isConfigSecret(k: string): boolean {
+    return secretKeys.has(k);
+}
+
 function parseConfig() {
     const parsedConfig: {[key: string]: string} = {};
     const envConfig = process.env[configEnvKey];
@@ -53,6 +66,18 @@ function parseConfig() {
     return parsedConfig;
 }
 
+function parseSecretKeys() {
+    const keys = new Set<string>();
+    const envKeys = process.env[configSecretKeysEnvKey];
+    if (envKeys) {
+        for (const k of <string[]>JSON.parse(envKeys)) {
+            keys.add(cleanKey(k));
+        }
+    }
+
+    return keys;
+}
+
 /**
  * cleanKey takes a configuration key, and if it is of the form "<string>:config:<string>" removes
  * the ":config:" portion. Previously, our keys always had the string ":config:" in them, and we'd
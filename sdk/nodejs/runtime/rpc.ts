@@ -215,6 +215,10 @@ export const specialAssetSig = "c44067f5952c0a294b673a41bacd8c17";
  * specialArchiveSig is a randomly assigned hash used to identify archives in maps.  See pkg/resource/asset.go.
  */
 export const specialArchiveSig = "0def7320c3a5731c473e5ecbe6d01bc7";
+/**
+ * specialSecretSig is a randomly assigned hash used to identify secrets in maps.  See pkg/resource/properties.go.
+ */
+export const specialSecretSig = "1b47061264138c4ac30d75fd1eb44270";
 
 /**
  * serializeProperty serializes properties deeply.  This understands how to wait on any unresolved promises, as
@@ -283,7 +287,12 @@ export async function serializeProperty(ctx: string, prop: Input<any>, dependent
         // resolve isKnown to true) and for any resource outputs that were resolved with known values.
         const isKnown = await prop.isKnown;
         const value = await serializeProperty(`${ctx}.id`, prop.promise(), dependentResources);
-        return isKnown ? value : unknownValue;
+        const result = isKnown ? value : unknownValue;
+
+        // Outputs that were marked as secrets, or that were derived from one, are wrapped so that the engine knows to
+        // encrypt them.
+        const isSecret = await prop.isSecret;
+        return isSecret && result !== undefined ? { [specialSigKey]: specialSecretSig, value: result } : result;
     } else {
         return await serializeAllKeys(prop, {});
     }
@@ -330,6 +339,8 @@ export function deserializeProperty(prop: any): any {
         const sig: any = prop[specialSigKey];
         if (sig) {
             switch (sig) {
+                case specialSecretSig:
+                    return deserializeProperty(prop["value"]);
                 case specialAssetSig:
                     if (prop["path"]) {
                         return new asset.FileAsset(<string>prop["path"]);
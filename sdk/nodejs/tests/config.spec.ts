@@ -14,6 +14,7 @@
 
 import * as assert from "assert";
 import { Config, runtime } from "../index";
+import { asyncTest } from "./util";
 
 describe("config", () => {
     it("works, basically", () => {
@@ -33,6 +34,16 @@ describe("config", () => {
         assert.strictEqual(undefined, config.get("nothere"));
         assert.throws(() => { config.require("missing"); });
     });
+    it("does secrets", asyncTest(async () => {
+        runtime.setConfig("pkg:password", "hunter2");
+        const config = new Config("pkg");
+        const secret = config.requireSecret("password");
+        assert.strictEqual(true, await secret.isSecret);
+        assert.strictEqual("hunter2", await secret.promise());
+        assert.strictEqual(true, await config.getSecret("password")!.isSecret);
+        assert.strictEqual(undefined, config.getSecret("missing"));
+        assert.throws(() => { config.requireSecret("missing"); });
+    }));
     it("does strongly typed too!", () => {
         // Set up some config and then read them back as typed things.
         runtime.setConfig("pkg:boolf", "false");
@@ -13,7 +13,7 @@
 // limitations under the License.
 
 import * as assert from "assert";
-import { Inputs, runtime } from "../../index";
+import { Inputs, all, runtime, secret } from "../../index";
 import { asyncTest } from "../util";
 
 const gstruct = require("google-protobuf/google/protobuf/struct_pb.js");
@@ -40,6 +40,27 @@ describe("runtime", () => {
             assert.equal(result.id, "foo");
             assert.equal(result.urn, "bar");
         }));
+        it("marshals secrets and outputs derived from them as secrets", asyncTest(async () => {
+            const password = secret("hunter2");
+            const inputs: Inputs = {
+                "aSecret": password,
+                "bDerived": password.apply(p => p.length),
+                "cAll": all([password, "public"]),
+                "dPlain": "public",
+            };
+            const serialized = await runtime.serializeProperties("test", inputs);
+            assert.deepEqual(serialized.aSecret,
+                { [runtime.specialSigKey]: runtime.specialSecretSig, value: "hunter2" });
+            assert.deepEqual(serialized.bDerived, { [runtime.specialSigKey]: runtime.specialSecretSig, value: 7 });
+            assert.deepEqual(serialized.cAll,
+                { [runtime.specialSigKey]: runtime.specialSecretSig, value: [ "hunter2", "public" ] });
+            assert.equal(serialized.dPlain, "public");
+
+            // Secrets are unwrapped when they are deserialized.
+            const result = runtime.deserializeProperties(gstruct.Struct.fromJavaScript(serialized));
+            assert.equal(result.aSecret, "hunter2");
+            assert.equal(result.bDerived, 7);
+        }));
     });
 });
 
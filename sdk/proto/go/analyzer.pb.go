@@ -25,6 +25,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type AnalyzeFailure_Severity int32
+
+const (
+	AnalyzeFailure_MANDATORY AnalyzeFailure_Severity = 0
+	AnalyzeFailure_ADVISORY  AnalyzeFailure_Severity = 1
+)
+
+var AnalyzeFailure_Severity_name = map[int32]string{
+	0: "MANDATORY",
+	1: "ADVISORY",
+}
+var AnalyzeFailure_Severity_value = map[string]int32{
+	"MANDATORY": 0,
+	"ADVISORY":  1,
+}
+
+func (x AnalyzeFailure_Severity) String() string {
+	return proto.EnumName(AnalyzeFailure_Severity_name, int32(x))
+}
+func (AnalyzeFailure_Severity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{2, 0}
+}
+
 type AnalyzeRequest struct {
 	Type                 string          `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	Properties           *_struct.Struct `protobuf:"bytes,2,opt,name=properties" json:"properties,omitempty"`
@@ -37,7 +60,7 @@ func (m *AnalyzeRequest) Reset()         { *m = AnalyzeRequest{} }
 func (m *AnalyzeRequest) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeRequest) ProtoMessage()    {}
 func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_analyzer_4d94ca02b59f2385, []int{0}
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{0}
 }
 func (m *AnalyzeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnalyzeRequest.Unmarshal(m, b)
@@ -82,7 +105,7 @@ func (m *AnalyzeResponse) Reset()         { *m = AnalyzeResponse{} }
 func (m *AnalyzeResponse) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeResponse) ProtoMessage()    {}
 func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_analyzer_4d94ca02b59f2385, []int{1}
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{1}
 }
 func (m *AnalyzeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnalyzeResponse.Unmarshal(m, b)
@@ -110,18 +133,20 @@ func (m *AnalyzeResponse) GetFailures() []*AnalyzeFailure {
 }
 
 type AnalyzeFailure struct {
-	Property             string   `protobuf:"bytes,1,opt,name=property" json:"property,omitempty"`
-	Reason               string   `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Property             string                  `protobuf:"bytes,1,opt,name=property" json:"property,omitempty"`
+	Reason               string                  `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	Severity             AnalyzeFailure_Severity `protobuf:"varint,3,opt,name=severity,enum=pulumirpc.AnalyzeFailure_Severity" json:"severity,omitempty"`
+	Urn                  string                  `protobuf:"bytes,4,opt,name=urn" json:"urn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *AnalyzeFailure) Reset()         { *m = AnalyzeFailure{} }
 func (m *AnalyzeFailure) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeFailure) ProtoMessage()    {}
 func (*AnalyzeFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_analyzer_4d94ca02b59f2385, []int{2}
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{2}
 }
 func (m *AnalyzeFailure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnalyzeFailure.Unmarshal(m, b)
@@ -155,10 +180,152 @@ func (m *AnalyzeFailure) GetReason() string {
 	return ""
 }
 
+func (m *AnalyzeFailure) GetSeverity() AnalyzeFailure_Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return AnalyzeFailure_MANDATORY
+}
+
+func (m *AnalyzeFailure) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+type AnalyzeStackRequest struct {
+	Resources            []*AnalyzerResource `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AnalyzeStackRequest) Reset()         { *m = AnalyzeStackRequest{} }
+func (m *AnalyzeStackRequest) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeStackRequest) ProtoMessage()    {}
+func (*AnalyzeStackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{3}
+}
+func (m *AnalyzeStackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzeStackRequest.Unmarshal(m, b)
+}
+func (m *AnalyzeStackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzeStackRequest.Marshal(b, m, deterministic)
+}
+func (dst *AnalyzeStackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeStackRequest.Merge(dst, src)
+}
+func (m *AnalyzeStackRequest) XXX_Size() int {
+	return xxx_messageInfo_AnalyzeStackRequest.Size(m)
+}
+func (m *AnalyzeStackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeStackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzeStackRequest proto.InternalMessageInfo
+
+func (m *AnalyzeStackRequest) GetResources() []*AnalyzerResource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// AnalyzerResource describes a single resource in a stack for the purposes of stack analysis.
+type AnalyzerResource struct {
+	Urn                  string          `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
+	Type                 string          `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Inputs               *_struct.Struct `protobuf:"bytes,3,opt,name=inputs" json:"inputs,omitempty"`
+	Outputs              *_struct.Struct `protobuf:"bytes,4,opt,name=outputs" json:"outputs,omitempty"`
+	Parent               string          `protobuf:"bytes,5,opt,name=parent" json:"parent,omitempty"`
+	Dependencies         []string        `protobuf:"bytes,6,rep,name=dependencies" json:"dependencies,omitempty"`
+	Provider             string          `protobuf:"bytes,7,opt,name=provider" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AnalyzerResource) Reset()         { *m = AnalyzerResource{} }
+func (m *AnalyzerResource) String() string { return proto.CompactTextString(m) }
+func (*AnalyzerResource) ProtoMessage()    {}
+func (*AnalyzerResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_analyzer_d26c6c4ff67c99b0, []int{4}
+}
+func (m *AnalyzerResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzerResource.Unmarshal(m, b)
+}
+func (m *AnalyzerResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzerResource.Marshal(b, m, deterministic)
+}
+func (dst *AnalyzerResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzerResource.Merge(dst, src)
+}
+func (m *AnalyzerResource) XXX_Size() int {
+	return xxx_messageInfo_AnalyzerResource.Size(m)
+}
+func (m *AnalyzerResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzerResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzerResource proto.InternalMessageInfo
+
+func (m *AnalyzerResource) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *AnalyzerResource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AnalyzerResource) GetInputs() *_struct.Struct {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *AnalyzerResource) GetOutputs() *_struct.Struct {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *AnalyzerResource) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *AnalyzerResource) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *AnalyzerResource) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AnalyzeRequest)(nil), "pulumirpc.AnalyzeRequest")
 	proto.RegisterType((*AnalyzeResponse)(nil), "pulumirpc.AnalyzeResponse")
 	proto.RegisterType((*AnalyzeFailure)(nil), "pulumirpc.AnalyzeFailure")
+	proto.RegisterType((*AnalyzeStackRequest)(nil), "pulumirpc.AnalyzeStackRequest")
+	proto.RegisterType((*AnalyzerResource)(nil), "pulumirpc.AnalyzerResource")
+	proto.RegisterEnum("pulumirpc.AnalyzeFailure_Severity", AnalyzeFailure_Severity_name, AnalyzeFailure_Severity_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +341,9 @@ const _ = grpc.SupportPackageIsVersion4
 type AnalyzerClient interface {
 	// Analyze analyzes a single resource object, and returns any errors that it finds.
 	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	// AnalyzeStack analyzes all resources within a stack at the end of a successful preview or update, and returns
+	// any errors that it finds. Unlike Analyze, this allows policies that span multiple resources.
+	AnalyzeStack(ctx context.Context, in *AnalyzeStackRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PluginInfo, error)
 }
@@ -195,6 +365,15 @@ func (c *analyzerClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts .
 	return out, nil
 }
 
+func (c *analyzerClient) AnalyzeStack(ctx context.Context, in *AnalyzeStackRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := grpc.Invoke(ctx, "/pulumirpc.Analyzer/AnalyzeStack", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerClient) GetPluginInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PluginInfo, error) {
 	out := new(PluginInfo)
 	err := grpc.Invoke(ctx, "/pulumirpc.Analyzer/GetPluginInfo", in, out, c.cc, opts...)
@@ -209,6 +388,9 @@ func (c *analyzerClient) GetPluginInfo(ctx context.Context, in *empty.Empty, opt
 type AnalyzerServer interface {
 	// Analyze analyzes a single resource object, and returns any errors that it finds.
 	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	// AnalyzeStack analyzes all resources within a stack at the end of a successful preview or update, and returns
+	// any errors that it finds. Unlike Analyze, this allows policies that span multiple resources.
+	AnalyzeStack(context.Context, *AnalyzeStackRequest) (*AnalyzeResponse, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(context.Context, *empty.Empty) (*PluginInfo, error)
 }
@@ -235,6 +417,24 @@ func _Analyzer_Analyze_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Analyzer_AnalyzeStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServer).AnalyzeStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Analyzer/AnalyzeStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServer).AnalyzeStack(ctx, req.(*AnalyzeStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Analyzer_GetPluginInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -261,6 +461,10 @@ var _Analyzer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Analyze",
 			Handler:    _Analyzer_Analyze_Handler,
 		},
+		{
+			MethodName: "AnalyzeStack",
+			Handler:    _Analyzer_AnalyzeStack_Handler,
+		},
 		{
 			MethodName: "GetPluginInfo",
 			Handler:    _Analyzer_GetPluginInfo_Handler,
@@ -270,26 +474,39 @@ var _Analyzer_serviceDesc = grpc.ServiceDesc{
 	Metadata: "analyzer.proto",
 }
 
-func init() { proto.RegisterFile("analyzer.proto", fileDescriptor_analyzer_4d94ca02b59f2385) }
-
-var fileDescriptor_analyzer_4d94ca02b59f2385 = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0x1b, 0x95, 0x9a, 0x8c, 0x5a, 0x61, 0xc0, 0x5a, 0x57, 0x0f, 0x25, 0x27, 0x4f, 0x5b,
-	0xa8, 0x88, 0x57, 0x15, 0xff, 0xde, 0x24, 0x9e, 0x3d, 0xa4, 0x65, 0x12, 0x02, 0x69, 0x76, 0xdd,
-	0x3f, 0x87, 0xf8, 0x29, 0xfc, 0xc8, 0xc6, 0xdd, 0x35, 0x16, 0xed, 0x6d, 0x86, 0xf7, 0xf8, 0xcd,
-	0x9b, 0x19, 0x18, 0xe5, 0x4d, 0x5e, 0xb7, 0x1f, 0xa4, 0xb8, 0x54, 0xc2, 0x08, 0x4c, 0xa4, 0xad,
-	0xed, 0xaa, 0x52, 0x72, 0xc9, 0xf6, 0x65, 0x6d, 0xcb, 0xaa, 0xf1, 0x02, 0x3b, 0x2d, 0x85, 0x28,
-	0x6b, 0x9a, 0xb9, 0x6e, 0x61, 0x8b, 0x19, 0xad, 0xa4, 0x69, 0x83, 0x78, 0xf6, 0x57, 0xd4, 0x46,
-	0xd9, 0xa5, 0xf1, 0x6a, 0xfa, 0x06, 0xa3, 0x1b, 0x3f, 0x25, 0xa3, 0x77, 0x4b, 0xda, 0x20, 0xc2,
-	0x8e, 0x69, 0x25, 0x4d, 0xa2, 0x69, 0x74, 0x9e, 0x64, 0xae, 0xc6, 0x2b, 0x80, 0xce, 0x2e, 0x49,
-	0x99, 0x8a, 0xf4, 0x64, 0xab, 0x53, 0xf6, 0xe6, 0xc7, 0xdc, 0x83, 0xf9, 0x0f, 0x98, 0xbf, 0x3a,
-	0x70, 0xb6, 0x66, 0x4d, 0x9f, 0xe0, 0xb0, 0xc7, 0x6b, 0x29, 0x1a, 0x4d, 0x78, 0x09, 0x71, 0x91,
-	0x57, 0xb5, 0x55, 0x1d, 0x29, 0x9a, 0x6e, 0x77, 0xa4, 0x13, 0xde, 0x2f, 0xc6, 0x83, 0xfb, 0xc1,
-	0x3b, 0xb2, 0xde, 0x9a, 0xde, 0xf5, 0x41, 0x83, 0x86, 0x0c, 0xe2, 0x30, 0xa9, 0x0d, 0x61, 0xfb,
-	0x1e, 0xc7, 0x30, 0x54, 0x94, 0x6b, 0xd1, 0xb8, 0xb0, 0x49, 0x16, 0xba, 0xf9, 0x67, 0x04, 0x71,
-	0xc0, 0x28, 0xbc, 0x85, 0xdd, 0x50, 0xe3, 0x86, 0x08, 0xe1, 0x1e, 0x8c, 0x6d, 0x92, 0xfc, 0x2e,
-	0xe9, 0x00, 0xaf, 0xe1, 0xe0, 0x91, 0xcc, 0x8b, 0xfb, 0xc6, 0x73, 0x53, 0x08, 0x1c, 0xff, 0x3b,
-	0xcb, 0xfd, 0xf7, 0x33, 0xd8, 0xd1, 0x1a, 0xe6, 0xd7, 0x9e, 0x0e, 0x16, 0x43, 0x67, 0xbc, 0xf8,
-	0x0a, 0x00, 0x00, 0xff, 0xff, 0xff, 0x6d, 0x9c, 0x46, 0xee, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("analyzer.proto", fileDescriptor_analyzer_d26c6c4ff67c99b0) }
+
+var fileDescriptor_analyzer_d26c6c4ff67c99b0 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0xe7, 0xb5, 0xb4, 0xc9, 0x59, 0x57, 0x2a, 0x23, 0x46, 0xc8, 0x10, 0xaa, 0x7c, 0x43,
+	0xaf, 0x52, 0x51, 0x84, 0x10, 0x37, 0x88, 0xa2, 0xf1, 0x31, 0x89, 0x8f, 0xc9, 0x45, 0x48, 0x5c,
+	0x70, 0x91, 0xb5, 0xa7, 0x55, 0x44, 0x67, 0x1b, 0x7f, 0x4c, 0x2a, 0x2f, 0xc7, 0x9b, 0xf0, 0x14,
+	0x3c, 0x00, 0x4a, 0xe2, 0x64, 0xd9, 0x3a, 0xc6, 0x9d, 0x4f, 0xfe, 0x3f, 0xff, 0x7d, 0x72, 0xfe,
+	0x36, 0xf4, 0x53, 0x91, 0xae, 0x37, 0x3f, 0x51, 0x27, 0x4a, 0x4b, 0x2b, 0x69, 0xa8, 0xdc, 0xda,
+	0x9d, 0x65, 0x5a, 0xcd, 0xe3, 0x9e, 0x5a, 0xbb, 0x55, 0x26, 0x4a, 0x21, 0x3e, 0x5c, 0x49, 0xb9,
+	0x5a, 0xe3, 0xb8, 0xa8, 0x4e, 0xdd, 0x72, 0x8c, 0x67, 0xca, 0x6e, 0xbc, 0xf8, 0xe0, 0xaa, 0x68,
+	0xac, 0x76, 0x73, 0x5b, 0xaa, 0xec, 0x1b, 0xf4, 0xa7, 0xe5, 0x29, 0x1c, 0x7f, 0x38, 0x34, 0x96,
+	0x52, 0x68, 0xdb, 0x8d, 0xc2, 0x88, 0x0c, 0xc9, 0x28, 0xe4, 0xc5, 0x9a, 0x3e, 0x03, 0x50, 0x5a,
+	0x2a, 0xd4, 0x36, 0x43, 0x13, 0xed, 0x0e, 0xc9, 0x68, 0x6f, 0x72, 0x2f, 0x29, 0x8d, 0x93, 0xca,
+	0x38, 0x99, 0x15, 0xc6, 0xbc, 0x81, 0xb2, 0x77, 0x70, 0xbb, 0xb6, 0x37, 0x4a, 0x0a, 0x83, 0xf4,
+	0x29, 0x04, 0xcb, 0x34, 0x5b, 0x3b, 0x8d, 0x26, 0x22, 0xc3, 0xd6, 0x68, 0x6f, 0x72, 0x3f, 0xa9,
+	0x7f, 0x2c, 0xf1, 0xf4, 0x9b, 0x92, 0xe0, 0x35, 0xca, 0x7e, 0x11, 0xe8, 0x5f, 0x16, 0x69, 0x0c,
+	0x81, 0x3f, 0x6a, 0xe3, 0xbb, 0xad, 0x6b, 0x7a, 0x00, 0x1d, 0x8d, 0xa9, 0x91, 0xa2, 0xe8, 0x36,
+	0xe4, 0xbe, 0xa2, 0x2f, 0x20, 0x30, 0x78, 0x8e, 0x3a, 0xb3, 0x9b, 0xa8, 0x35, 0x24, 0xa3, 0xfe,
+	0x84, 0xfd, 0xf3, 0xf4, 0x64, 0xe6, 0x49, 0x5e, 0xef, 0xa1, 0x03, 0x68, 0x39, 0x2d, 0xa2, 0x76,
+	0x61, 0x9a, 0x2f, 0xd9, 0x23, 0x08, 0x2a, 0x8e, 0xee, 0x43, 0xf8, 0x61, 0xfa, 0xf1, 0x68, 0xfa,
+	0xf9, 0x13, 0xff, 0x3a, 0xd8, 0xa1, 0x3d, 0x08, 0xa6, 0x47, 0x5f, 0x8e, 0x67, 0x79, 0x45, 0xd8,
+	0x09, 0xdc, 0xf1, 0xfe, 0x33, 0x9b, 0xce, 0xbf, 0x57, 0xf3, 0x7e, 0x0e, 0xa1, 0x46, 0x23, 0x9d,
+	0x9e, 0xd7, 0x03, 0x39, 0xdc, 0x6e, 0x49, 0x73, 0xcf, 0xf0, 0x0b, 0x9a, 0xfd, 0x21, 0x30, 0xb8,
+	0xaa, 0x57, 0x1d, 0x92, 0xba, 0xc3, 0x3a, 0xd1, 0xdd, 0x46, 0xa2, 0x63, 0xe8, 0x64, 0x42, 0x39,
+	0x6b, 0xa2, 0xd6, 0xcd, 0x69, 0x7a, 0x8c, 0x3e, 0x86, 0xae, 0x74, 0xb6, 0xd8, 0xd1, 0xbe, 0x79,
+	0x47, 0xc5, 0xe5, 0x19, 0xa8, 0x54, 0xa3, 0xb0, 0xd1, 0xad, 0x32, 0x83, 0xb2, 0xa2, 0x0c, 0x7a,
+	0x0b, 0x54, 0x28, 0x16, 0x28, 0xe6, 0xf9, 0x7d, 0xea, 0x0c, 0x5b, 0xa3, 0x90, 0x5f, 0xfa, 0xe6,
+	0xb3, 0x3d, 0xcf, 0x16, 0xa8, 0xa3, 0x6e, 0x9d, 0x6d, 0x51, 0x4f, 0x7e, 0x13, 0x08, 0xaa, 0xdf,
+	0xa6, 0xaf, 0xa0, 0xeb, 0xd7, 0xf4, 0x9a, 0x7b, 0xe4, 0x87, 0x1c, 0xc7, 0xd7, 0x49, 0xe5, 0x85,
+	0x64, 0x3b, 0xf4, 0x3d, 0xf4, 0x9a, 0xc9, 0xd0, 0x87, 0xdb, 0x74, 0x33, 0xb2, 0xff, 0xb8, 0xbd,
+	0x84, 0xfd, 0xb7, 0x68, 0x4f, 0x8a, 0x07, 0x7a, 0x2c, 0x96, 0x92, 0x1e, 0x6c, 0x4d, 0xea, 0x75,
+	0xfe, 0x3e, 0xe3, 0xbb, 0x0d, 0x9b, 0x0b, 0x9c, 0xed, 0x9c, 0x76, 0x0a, 0xf0, 0xc9, 0xdf, 0x01,
+	0x00, 0x24, 0x98, 0xab, 0x0f, 0x01, 0x04, 0x00, 0x00,
 }
@@ -37,7 +37,7 @@ func (m *GetRequiredPluginsRequest) Reset()         { *m = GetRequiredPluginsReq
 func (m *GetRequiredPluginsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequiredPluginsRequest) ProtoMessage()    {}
 func (*GetRequiredPluginsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_language_840dd930e81005a9, []int{0}
+	return fileDescriptor_language_0019791915231a20, []int{0}
 }
 func (m *GetRequiredPluginsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequiredPluginsRequest.Unmarshal(m, b)
@@ -89,7 +89,7 @@ func (m *GetRequiredPluginsResponse) Reset()         { *m = GetRequiredPluginsRe
 func (m *GetRequiredPluginsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRequiredPluginsResponse) ProtoMessage()    {}
 func (*GetRequiredPluginsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_language_840dd930e81005a9, []int{1}
+	return fileDescriptor_language_0019791915231a20, []int{1}
 }
 func (m *GetRequiredPluginsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequiredPluginsResponse.Unmarshal(m, b)
@@ -127,6 +127,7 @@ type RunRequest struct {
 	DryRun               bool              `protobuf:"varint,7,opt,name=dryRun" json:"dryRun,omitempty"`
 	Parallel             int32             `protobuf:"varint,8,opt,name=parallel" json:"parallel,omitempty"`
 	MonitorAddress       string            `protobuf:"bytes,9,opt,name=monitor_address,json=monitorAddress" json:"monitor_address,omitempty"`
+	ConfigSecretKeys     []string          `protobuf:"bytes,10,rep,name=configSecretKeys" json:"configSecretKeys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -136,7 +137,7 @@ func (m *RunRequest) Reset()         { *m = RunRequest{} }
 func (m *RunRequest) String() string { return proto.CompactTextString(m) }
 func (*RunRequest) ProtoMessage()    {}
 func (*RunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_language_840dd930e81005a9, []int{2}
+	return fileDescriptor_language_0019791915231a20, []int{2}
 }
 func (m *RunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunRequest.Unmarshal(m, b)
@@ -219,6 +220,13 @@ func (m *RunRequest) GetMonitorAddress() string {
 	return ""
 }
 
+func (m *RunRequest) GetConfigSecretKeys() []string {
+	if m != nil {
+		return m.ConfigSecretKeys
+	}
+	return nil
+}
+
 // RunResponse is the response back from the interpreter/source back to the monitor.
 type RunResponse struct {
 	Error                string   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
@@ -231,7 +239,7 @@ func (m *RunResponse) Reset()         { *m = RunResponse{} }
 func (m *RunResponse) String() string { return proto.CompactTextString(m) }
 func (*RunResponse) ProtoMessage()    {}
 func (*RunResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_language_840dd930e81005a9, []int{3}
+	return fileDescriptor_language_0019791915231a20, []int{3}
 }
 func (m *RunResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunResponse.Unmarshal(m, b)
@@ -410,37 +418,38 @@ var _LanguageRuntime_serviceDesc = grpc.ServiceDesc{
 	Metadata: "language.proto",
 }
 
-func init() { proto.RegisterFile("language.proto", fileDescriptor_language_840dd930e81005a9) }
-
-var fileDescriptor_language_840dd930e81005a9 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x93, 0xdf, 0x6e, 0xd3, 0x3e,
-	0x14, 0xc7, 0xd7, 0x66, 0xed, 0xda, 0xd3, 0xdf, 0x6f, 0x43, 0x16, 0xab, 0x4c, 0x76, 0x03, 0x01,
-	0xc4, 0xae, 0x32, 0x69, 0x08, 0xc4, 0xb8, 0x02, 0xc1, 0x84, 0x90, 0xb8, 0x40, 0xe1, 0x01, 0x90,
-	0x97, 0x9c, 0x46, 0x61, 0x89, 0x6d, 0x1c, 0x1b, 0xd4, 0xa7, 0xe4, 0x55, 0x78, 0x04, 0xfc, 0x2f,
-	0xa5, 0xb0, 0xa2, 0xdd, 0xf9, 0x7b, 0xfc, 0x3d, 0x3e, 0x1f, 0x1f, 0xfb, 0xc0, 0x61, 0xcb, 0x78,
-	0x6d, 0x58, 0x8d, 0xb9, 0x54, 0x42, 0x0b, 0x32, 0x97, 0xa6, 0x35, 0x5d, 0xa3, 0x64, 0x99, 0xfe,
-	0x27, 0x5b, 0x53, 0x37, 0x3c, 0x6c, 0xa4, 0x27, 0xb5, 0x10, 0x75, 0x8b, 0x67, 0x5e, 0x5d, 0x99,
-	0xd5, 0x19, 0x76, 0x52, 0xaf, 0xc3, 0x66, 0xc6, 0xe0, 0xde, 0x3b, 0xd4, 0x05, 0x7e, 0x35, 0x8d,
-	0xc2, 0xea, 0xa3, 0xcf, 0xeb, 0x9d, 0xc4, 0x5e, 0x13, 0x0a, 0x07, 0xd6, 0xf5, 0x05, 0x4b, 0x4d,
-	0x47, 0xf7, 0x47, 0xa7, 0xf3, 0x62, 0x90, 0xe4, 0x0e, 0x24, 0xf2, 0x7b, 0x45, 0xc7, 0x3e, 0xea,
-	0x96, 0xd1, 0x5b, 0x2b, 0xd6, 0xd1, 0x64, 0xe3, 0x75, 0x32, 0xfb, 0x04, 0xe9, 0xae, 0x12, 0xbd,
-	0x14, 0xbc, 0x47, 0xf2, 0xcc, 0xe6, 0x85, 0x90, 0xad, 0x91, 0x9c, 0x2e, 0xce, 0x4f, 0xf2, 0xcd,
-	0x45, 0xf2, 0x60, 0x7e, 0x8b, 0x12, 0x79, 0x85, 0xbc, 0x5c, 0x17, 0x83, 0x37, 0xfb, 0x31, 0x06,
-	0x28, 0x0c, 0xbf, 0x9d, 0xf4, 0x2e, 0x4c, 0x7a, 0xcd, 0xca, 0xeb, 0xc8, 0x1a, 0xc4, 0xc0, 0x9f,
-	0xec, 0xe4, 0xdf, 0xff, 0x83, 0x9f, 0x10, 0xd8, 0x67, 0xaa, 0xee, 0xe9, 0xc4, 0xe2, 0xcd, 0x0b,
-	0xbf, 0x26, 0x17, 0x30, 0x2d, 0x05, 0x5f, 0x35, 0x35, 0x9d, 0x7a, 0xe8, 0x07, 0x5b, 0xd0, 0xbf,
-	0xb1, 0xf2, 0x37, 0xde, 0x73, 0xc9, 0xb5, 0x5a, 0x17, 0x31, 0x81, 0x2c, 0x61, 0x5a, 0x59, 0x69,
-	0x38, 0x3d, 0xb0, 0x75, 0x66, 0x45, 0x54, 0x24, 0x85, 0x99, 0x64, 0x8a, 0xb5, 0x2d, 0xb6, 0x74,
-	0x66, 0x77, 0x26, 0xc5, 0x46, 0x93, 0x27, 0x70, 0xd4, 0x09, 0xde, 0x68, 0xa1, 0x3e, 0xb3, 0xaa,
-	0x52, 0xd8, 0xf7, 0x74, 0xee, 0x21, 0x0f, 0x63, 0xf8, 0x75, 0x88, 0xa6, 0x17, 0xb0, 0xd8, 0xaa,
-	0xe9, 0xae, 0x79, 0x8d, 0xeb, 0xd8, 0x12, 0xb7, 0x74, 0xed, 0xf8, 0xc6, 0x5a, 0x83, 0x43, 0x3b,
-	0xbc, 0x78, 0x39, 0x7e, 0x31, 0xca, 0x1e, 0xc2, 0xc2, 0x93, 0xc7, 0x77, 0xb1, 0x46, 0x54, 0x4a,
-	0xa8, 0x98, 0x1c, 0xc4, 0xf9, 0xcf, 0x11, 0x1c, 0x7d, 0x88, 0xff, 0xce, 0xba, 0x75, 0xd3, 0x21,
-	0x29, 0x81, 0xdc, 0x7c, 0x5f, 0xf2, 0x68, 0xab, 0x23, 0xff, 0xfc, 0x61, 0xe9, 0xe3, 0x5b, 0x5c,
-	0x01, 0x26, 0xdb, 0x23, 0xcf, 0x21, 0x71, 0x4d, 0x3a, 0xde, 0xd9, 0xe7, 0x74, 0xf9, 0x77, 0x78,
-	0x93, 0xf7, 0x0a, 0xfe, 0xb7, 0xe7, 0x86, 0xf3, 0xde, 0xf3, 0x95, 0x20, 0xcb, 0x3c, 0x8c, 0x43,
-	0x3e, 0x8c, 0x43, 0x7e, 0xe9, 0xc6, 0x21, 0x3d, 0xbe, 0xf1, 0xed, 0x9c, 0x3d, 0xdb, 0xbb, 0x9a,
-	0x7a, 0xe3, 0xd3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x86, 0xf5, 0x91, 0x70, 0x03, 0x00,
-	0x00,
+func init() { proto.RegisterFile("language.proto", fileDescriptor_language_0019791915231a20) }
+
+var fileDescriptor_language_0019791915231a20 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x93, 0x5f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc5, 0x97, 0x66, 0xfd, 0x77, 0x0b, 0xdb, 0x64, 0x6d, 0x95, 0xc9, 0x5e, 0x4a, 0x00, 0x51,
+	0xf1, 0x90, 0x49, 0x43, 0x20, 0xc6, 0x13, 0x08, 0xa6, 0x09, 0xc1, 0x03, 0xf2, 0x3e, 0x00, 0xf2,
+	0x92, 0xdb, 0x28, 0x2c, 0xb5, 0x8d, 0xff, 0x80, 0xf2, 0xad, 0x79, 0xe7, 0x05, 0xc5, 0x4e, 0x4b,
+	0xa1, 0x45, 0x7b, 0xf3, 0xb9, 0x39, 0x37, 0xfe, 0xf9, 0xf8, 0x1a, 0x0e, 0x6a, 0x2e, 0x4a, 0xc7,
+	0x4b, 0xcc, 0x94, 0x96, 0x56, 0x92, 0xb1, 0x72, 0xb5, 0x5b, 0x56, 0x5a, 0xe5, 0xc9, 0x3d, 0x55,
+	0xbb, 0xb2, 0x12, 0xe1, 0x43, 0x72, 0x5a, 0x4a, 0x59, 0xd6, 0x78, 0xe6, 0xd5, 0x8d, 0x5b, 0x9c,
+	0xe1, 0x52, 0xd9, 0x26, 0x7c, 0x4c, 0x39, 0x3c, 0xb8, 0x42, 0xcb, 0xf0, 0x9b, 0xab, 0x34, 0x16,
+	0x9f, 0x7d, 0x9f, 0x69, 0x25, 0x1a, 0x4b, 0x28, 0x0c, 0x95, 0x96, 0x5f, 0x31, 0xb7, 0x34, 0x9a,
+	0x45, 0xf3, 0x31, 0x5b, 0x49, 0x72, 0x04, 0xb1, 0xfa, 0x51, 0xd0, 0x9e, 0xaf, 0xb6, 0xcb, 0xce,
+	0x5b, 0x6a, 0xbe, 0xa4, 0xf1, 0xda, 0xdb, 0xca, 0xf4, 0x1a, 0x92, 0x5d, 0x5b, 0x18, 0x25, 0x85,
+	0x41, 0xf2, 0x02, 0x86, 0x81, 0xd6, 0xd0, 0x68, 0x16, 0xcf, 0x27, 0xe7, 0xa7, 0xd9, 0xfa, 0x20,
+	0x59, 0x30, 0xbf, 0x47, 0x85, 0xa2, 0x40, 0x91, 0x37, 0x6c, 0xe5, 0x4d, 0x7f, 0xf5, 0x00, 0x98,
+	0x13, 0x77, 0x93, 0x1e, 0x43, 0xdf, 0x58, 0x9e, 0xdf, 0x76, 0xac, 0x41, 0xac, 0xf8, 0xe3, 0x9d,
+	0xfc, 0xfb, 0x7f, 0xf1, 0x13, 0x02, 0xfb, 0x5c, 0x97, 0x86, 0xf6, 0x67, 0xf1, 0x7c, 0xcc, 0xfc,
+	0x9a, 0x5c, 0xc0, 0x20, 0x97, 0x62, 0x51, 0x95, 0x74, 0xe0, 0xa1, 0x1f, 0x6e, 0x40, 0xff, 0xc1,
+	0xca, 0xde, 0x79, 0xcf, 0xa5, 0xb0, 0xba, 0x61, 0x5d, 0x03, 0x99, 0xc2, 0xa0, 0xd0, 0x0d, 0x73,
+	0x82, 0x0e, 0x67, 0xd1, 0x7c, 0xc4, 0x3a, 0x45, 0x12, 0x18, 0x29, 0xae, 0x79, 0x5d, 0x63, 0x4d,
+	0x47, 0xb3, 0x68, 0xde, 0x67, 0x6b, 0x4d, 0x9e, 0xc2, 0xe1, 0x52, 0x8a, 0xca, 0x4a, 0xfd, 0x85,
+	0x17, 0x85, 0x46, 0x63, 0xe8, 0xd8, 0x43, 0x1e, 0x74, 0xe5, 0xb7, 0xa1, 0x4a, 0x9e, 0xc1, 0x51,
+	0xd8, 0xe6, 0x1a, 0x73, 0x8d, 0xf6, 0x23, 0x36, 0x86, 0x82, 0xe7, 0xde, 0xaa, 0x27, 0x17, 0x30,
+	0xd9, 0xe0, 0x6b, 0x23, 0xb9, 0xc5, 0xa6, 0x8b, 0xaf, 0x5d, 0xb6, 0xd1, 0x7d, 0xe7, 0xb5, 0xc3,
+	0x55, 0x74, 0x5e, 0xbc, 0xee, 0xbd, 0x8a, 0xd2, 0x47, 0x30, 0xf1, 0xa7, 0xec, 0xee, 0xf0, 0x18,
+	0xfa, 0xa8, 0xb5, 0xd4, 0x5d, 0x73, 0x10, 0xe7, 0x3f, 0x23, 0x38, 0xfc, 0xd4, 0xcd, 0x28, 0x73,
+	0xc2, 0x56, 0x4b, 0x24, 0x39, 0x90, 0xed, 0x59, 0x20, 0x8f, 0x37, 0xd2, 0xfb, 0xef, 0x34, 0x26,
+	0x4f, 0xee, 0x70, 0x05, 0x98, 0x74, 0x8f, 0xbc, 0x84, 0xb8, 0x0d, 0xf4, 0x64, 0xe7, 0x9d, 0x24,
+	0xd3, 0x7f, 0xcb, 0xeb, 0xbe, 0x37, 0x70, 0xff, 0x0a, 0x6d, 0xf8, 0xdf, 0x07, 0xb1, 0x90, 0x64,
+	0x9a, 0x85, 0xa7, 0x93, 0xad, 0x9e, 0x4e, 0x76, 0xd9, 0x3e, 0x9d, 0xe4, 0x64, 0x6b, 0x44, 0x5b,
+	0x7b, 0xba, 0x77, 0x33, 0xf0, 0xc6, 0xe7, 0xbf, 0x07, 0x00, 0xaf, 0xbf, 0xb8, 0xe7, 0x9c, 0x03,
+	0x00, 0x00,
 }
@@ -48,7 +48,42 @@ func (x DiffResponse_DiffChanges) String() string {
 	return proto.EnumName(DiffResponse_DiffChanges_name, int32(x))
 }
 func (DiffResponse_DiffChanges) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{8, 0}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{8, 0}
+}
+
+type PropertyDiff_Kind int32
+
+const (
+	PropertyDiff_ADD            PropertyDiff_Kind = 0
+	PropertyDiff_ADD_REPLACE    PropertyDiff_Kind = 1
+	PropertyDiff_DELETE         PropertyDiff_Kind = 2
+	PropertyDiff_DELETE_REPLACE PropertyDiff_Kind = 3
+	PropertyDiff_UPDATE         PropertyDiff_Kind = 4
+	PropertyDiff_UPDATE_REPLACE PropertyDiff_Kind = 5
+)
+
+var PropertyDiff_Kind_name = map[int32]string{
+	0: "ADD",
+	1: "ADD_REPLACE",
+	2: "DELETE",
+	3: "DELETE_REPLACE",
+	4: "UPDATE",
+	5: "UPDATE_REPLACE",
+}
+var PropertyDiff_Kind_value = map[string]int32{
+	"ADD":            0,
+	"ADD_REPLACE":    1,
+	"DELETE":         2,
+	"DELETE_REPLACE": 3,
+	"UPDATE":         4,
+	"UPDATE_REPLACE": 5,
+}
+
+func (x PropertyDiff_Kind) String() string {
+	return proto.EnumName(PropertyDiff_Kind_name, int32(x))
+}
+func (PropertyDiff_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{9, 0}
 }
 
 type ConfigureRequest struct {
@@ -62,7 +97,7 @@ func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
 func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureRequest) ProtoMessage()    {}
 func (*ConfigureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{0}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{0}
 }
 func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureRequest.Unmarshal(m, b)
@@ -101,7 +136,7 @@ func (m *ConfigureErrorMissingKeys) Reset()         { *m = ConfigureErrorMissing
 func (m *ConfigureErrorMissingKeys) String() string { return proto.CompactTextString(m) }
 func (*ConfigureErrorMissingKeys) ProtoMessage()    {}
 func (*ConfigureErrorMissingKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{1}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{1}
 }
 func (m *ConfigureErrorMissingKeys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureErrorMissingKeys.Unmarshal(m, b)
@@ -140,7 +175,7 @@ func (m *ConfigureErrorMissingKeys_MissingKey) Reset()         { *m = ConfigureE
 func (m *ConfigureErrorMissingKeys_MissingKey) String() string { return proto.CompactTextString(m) }
 func (*ConfigureErrorMissingKeys_MissingKey) ProtoMessage()    {}
 func (*ConfigureErrorMissingKeys_MissingKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{1, 0}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{1, 0}
 }
 func (m *ConfigureErrorMissingKeys_MissingKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureErrorMissingKeys_MissingKey.Unmarshal(m, b)
@@ -187,7 +222,7 @@ func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
 func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRequest) ProtoMessage()    {}
 func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{2}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{2}
 }
 func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvokeRequest.Unmarshal(m, b)
@@ -240,7 +275,7 @@ func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
 func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeResponse) ProtoMessage()    {}
 func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{3}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{3}
 }
 func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvokeResponse.Unmarshal(m, b)
@@ -287,7 +322,7 @@ func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
 func (m *CheckRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckRequest) ProtoMessage()    {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{4}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{4}
 }
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckRequest.Unmarshal(m, b)
@@ -340,7 +375,7 @@ func (m *CheckResponse) Reset()         { *m = CheckResponse{} }
 func (m *CheckResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResponse) ProtoMessage()    {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{5}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{5}
 }
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckResponse.Unmarshal(m, b)
@@ -386,7 +421,7 @@ func (m *CheckFailure) Reset()         { *m = CheckFailure{} }
 func (m *CheckFailure) String() string { return proto.CompactTextString(m) }
 func (*CheckFailure) ProtoMessage()    {}
 func (*CheckFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{6}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{6}
 }
 func (m *CheckFailure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckFailure.Unmarshal(m, b)
@@ -434,7 +469,7 @@ func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
 func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffRequest) ProtoMessage()    {}
 func (*DiffRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{7}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{7}
 }
 func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
@@ -487,6 +522,8 @@ type DiffResponse struct {
 	Stables              []string                 `protobuf:"bytes,2,rep,name=stables" json:"stables,omitempty"`
 	DeleteBeforeReplace  bool                     `protobuf:"varint,3,opt,name=deleteBeforeReplace" json:"deleteBeforeReplace,omitempty"`
 	Changes              DiffResponse_DiffChanges `protobuf:"varint,4,opt,name=changes,enum=pulumirpc.DiffResponse_DiffChanges" json:"changes,omitempty"`
+	DetailedDiff         map[string]*PropertyDiff `protobuf:"bytes,5,rep,name=detailedDiff" json:"detailedDiff,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HasDetailedDiff      bool                     `protobuf:"varint,6,opt,name=hasDetailedDiff" json:"hasDetailedDiff,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -496,7 +533,7 @@ func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
 func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffResponse) ProtoMessage()    {}
 func (*DiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{8}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{8}
 }
 func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiffResponse.Unmarshal(m, b)
@@ -544,9 +581,70 @@ func (m *DiffResponse) GetChanges() DiffResponse_DiffChanges {
 	return DiffResponse_DIFF_UNKNOWN
 }
 
+func (m *DiffResponse) GetDetailedDiff() map[string]*PropertyDiff {
+	if m != nil {
+		return m.DetailedDiff
+	}
+	return nil
+}
+
+func (m *DiffResponse) GetHasDetailedDiff() bool {
+	if m != nil {
+		return m.HasDetailedDiff
+	}
+	return false
+}
+
+type PropertyDiff struct {
+	Kind                 PropertyDiff_Kind `protobuf:"varint,1,opt,name=kind,enum=pulumirpc.PropertyDiff_Kind" json:"kind,omitempty"`
+	InputDiff            bool              `protobuf:"varint,2,opt,name=inputDiff" json:"inputDiff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PropertyDiff) Reset()         { *m = PropertyDiff{} }
+func (m *PropertyDiff) String() string { return proto.CompactTextString(m) }
+func (*PropertyDiff) ProtoMessage()    {}
+func (*PropertyDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{9}
+}
+func (m *PropertyDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PropertyDiff.Unmarshal(m, b)
+}
+func (m *PropertyDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PropertyDiff.Marshal(b, m, deterministic)
+}
+func (dst *PropertyDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropertyDiff.Merge(dst, src)
+}
+func (m *PropertyDiff) XXX_Size() int {
+	return xxx_messageInfo_PropertyDiff.Size(m)
+}
+func (m *PropertyDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropertyDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropertyDiff proto.InternalMessageInfo
+
+func (m *PropertyDiff) GetKind() PropertyDiff_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return PropertyDiff_ADD
+}
+
+func (m *PropertyDiff) GetInputDiff() bool {
+	if m != nil {
+		return m.InputDiff
+	}
+	return false
+}
+
 type CreateRequest struct {
 	Urn                  string          `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
 	Properties           *_struct.Struct `protobuf:"bytes,2,opt,name=properties" json:"properties,omitempty"`
+	Timeout              float64         `protobuf:"fixed64,3,opt,name=timeout" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -556,7 +654,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{9}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{10}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
@@ -590,6 +688,13 @@ func (m *CreateRequest) GetProperties() *_struct.Struct {
 	return nil
 }
 
+func (m *CreateRequest) GetTimeout() float64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type CreateResponse struct {
 	Id                   string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Properties           *_struct.Struct `protobuf:"bytes,2,opt,name=properties" json:"properties,omitempty"`
@@ -602,7 +707,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{10}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{11}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
@@ -649,7 +754,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{11}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{12}
 }
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadRequest.Unmarshal(m, b)
@@ -702,7 +807,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{12}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{13}
 }
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResponse.Unmarshal(m, b)
@@ -741,6 +846,7 @@ type UpdateRequest struct {
 	Urn                  string          `protobuf:"bytes,2,opt,name=urn" json:"urn,omitempty"`
 	Olds                 *_struct.Struct `protobuf:"bytes,3,opt,name=olds" json:"olds,omitempty"`
 	News                 *_struct.Struct `protobuf:"bytes,4,opt,name=news" json:"news,omitempty"`
+	Timeout              float64         `protobuf:"fixed64,5,opt,name=timeout" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -750,7 +856,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{13}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{14}
 }
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
@@ -798,6 +904,13 @@ func (m *UpdateRequest) GetNews() *_struct.Struct {
 	return nil
 }
 
+func (m *UpdateRequest) GetTimeout() float64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	Properties           *_struct.Struct `protobuf:"bytes,1,opt,name=properties" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -809,7 +922,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{14}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{15}
 }
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
@@ -840,6 +953,7 @@ type DeleteRequest struct {
 	Id                   string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Urn                  string          `protobuf:"bytes,2,opt,name=urn" json:"urn,omitempty"`
 	Properties           *_struct.Struct `protobuf:"bytes,3,opt,name=properties" json:"properties,omitempty"`
+	Timeout              float64         `protobuf:"fixed64,4,opt,name=timeout" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -849,7 +963,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{15}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{16}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -890,6 +1004,13 @@ func (m *DeleteRequest) GetProperties() *_struct.Struct {
 	return nil
 }
 
+func (m *DeleteRequest) GetTimeout() float64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 // ErrorResourceInitFailed is sent as a Detail `ResourceProvider.{Create, Update}` fail because a
 // resource was created successfully, but failed to initialize.
 type ErrorResourceInitFailed struct {
@@ -905,7 +1026,7 @@ func (m *ErrorResourceInitFailed) Reset()         { *m = ErrorResourceInitFailed
 func (m *ErrorResourceInitFailed) String() string { return proto.CompactTextString(m) }
 func (*ErrorResourceInitFailed) ProtoMessage()    {}
 func (*ErrorResourceInitFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_5951afc12b1894bc, []int{16}
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{17}
 }
 func (m *ErrorResourceInitFailed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ErrorResourceInitFailed.Unmarshal(m, b)
@@ -946,6 +1067,82 @@ func (m *ErrorResourceInitFailed) GetReasons() []string {
 	return nil
 }
 
+type GetSchemaRequest struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{18}
+}
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(dst, src)
+}
+func (m *GetSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaRequest.Size(m)
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetSchemaResponse struct {
+	Schema               string   `protobuf:"bytes,1,opt,name=schema" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_c99e6f050a3605c2, []int{19}
+}
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(dst, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaResponse.Size(m)
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ConfigureRequest)(nil), "pulumirpc.ConfigureRequest")
 	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConfigureRequest.VariablesEntry")
@@ -958,6 +1155,8 @@ func init() {
 	proto.RegisterType((*CheckFailure)(nil), "pulumirpc.CheckFailure")
 	proto.RegisterType((*DiffRequest)(nil), "pulumirpc.DiffRequest")
 	proto.RegisterType((*DiffResponse)(nil), "pulumirpc.DiffResponse")
+	proto.RegisterMapType((map[string]*PropertyDiff)(nil), "pulumirpc.DiffResponse.DetailedDiffEntry")
+	proto.RegisterType((*PropertyDiff)(nil), "pulumirpc.PropertyDiff")
 	proto.RegisterType((*CreateRequest)(nil), "pulumirpc.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "pulumirpc.CreateResponse")
 	proto.RegisterType((*ReadRequest)(nil), "pulumirpc.ReadRequest")
@@ -966,7 +1165,10 @@ func init() {
 	proto.RegisterType((*UpdateResponse)(nil), "pulumirpc.UpdateResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "pulumirpc.DeleteRequest")
 	proto.RegisterType((*ErrorResourceInitFailed)(nil), "pulumirpc.ErrorResourceInitFailed")
+	proto.RegisterType((*GetSchemaRequest)(nil), "pulumirpc.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "pulumirpc.GetSchemaResponse")
 	proto.RegisterEnum("pulumirpc.DiffResponse_DiffChanges", DiffResponse_DiffChanges_name, DiffResponse_DiffChanges_value)
+	proto.RegisterEnum("pulumirpc.PropertyDiff_Kind", PropertyDiff_Kind_name, PropertyDiff_Kind_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1006,6 +1208,8 @@ type ResourceProviderClient interface {
 	Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PluginInfo, error)
+	// GetSchema fetches the schema for this resource provider.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
 }
 
 type resourceProviderClient struct {
@@ -1106,6 +1310,15 @@ func (c *resourceProviderClient) GetPluginInfo(ctx context.Context, in *empty.Em
 	return out, nil
 }
 
+func (c *resourceProviderClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := grpc.Invoke(ctx, "/pulumirpc.ResourceProvider/GetSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ResourceProvider service
 
 type ResourceProviderServer interface {
@@ -1135,6 +1348,8 @@ type ResourceProviderServer interface {
 	Cancel(context.Context, *empty.Empty) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(context.Context, *empty.Empty) (*PluginInfo, error)
+	// GetSchema fetches the schema for this resource provider.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
 }
 
 func RegisterResourceProviderServer(s *grpc.Server, srv ResourceProviderServer) {
@@ -1321,6 +1536,24 @@ func _ResourceProvider_GetPluginInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pulumirpc.ResourceProvider",
 	HandlerType: (*ResourceProviderServer)(nil),
@@ -1365,69 +1598,88 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPluginInfo",
 			Handler:    _ResourceProvider_GetPluginInfo_Handler,
 		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _ResourceProvider_GetSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provider.proto",
 }
 
-func init() { proto.RegisterFile("provider.proto", fileDescriptor_provider_5951afc12b1894bc) }
-
-var fileDescriptor_provider_5951afc12b1894bc = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x5e, 0x27, 0xd9, 0x74, 0x73, 0xf2, 0xa3, 0x68, 0x80, 0xdd, 0x34, 0xe5, 0xa2, 0x32, 0x37,
-	0x15, 0x48, 0x0e, 0x4a, 0x2f, 0x80, 0xaa, 0x15, 0x28, 0xdd, 0x2c, 0x44, 0x55, 0xb3, 0xc5, 0x55,
-	0x59, 0xc1, 0x0d, 0xf2, 0xda, 0x93, 0xac, 0x89, 0x63, 0x9b, 0xf1, 0x38, 0x28, 0x88, 0x17, 0x58,
-	0xf1, 0x06, 0x3c, 0x06, 0xcf, 0xc6, 0x03, 0x30, 0x9e, 0x1f, 0xc7, 0x93, 0x6c, 0xb2, 0x61, 0xb5,
-	0xa2, 0x77, 0x73, 0x7c, 0x7e, 0xbe, 0xf3, 0x9d, 0x73, 0xe6, 0x8c, 0xa1, 0x15, 0x93, 0x68, 0xe1,
-	0x7b, 0x98, 0x58, 0xec, 0x40, 0x23, 0x54, 0x8b, 0xd3, 0x20, 0x9d, 0xfb, 0x24, 0x76, 0xbb, 0x8d,
-	0x38, 0x48, 0xa7, 0x7e, 0x28, 0x14, 0xdd, 0x47, 0xd3, 0x28, 0x9a, 0x06, 0xb8, 0xc7, 0xa5, 0xcb,
-	0x74, 0xd2, 0xc3, 0xf3, 0x98, 0x2e, 0xa5, 0xf2, 0xe3, 0x75, 0x65, 0x42, 0x49, 0xea, 0x52, 0xa1,
-	0x35, 0xff, 0x32, 0xa0, 0xfd, 0x32, 0x0a, 0x27, 0xfe, 0x34, 0x25, 0xd8, 0xc6, 0xbf, 0xa6, 0x38,
-	0xa1, 0xe8, 0x3b, 0xa8, 0x2d, 0x1c, 0xe2, 0x3b, 0x97, 0x01, 0x4e, 0x3a, 0xc6, 0xe3, 0xf2, 0x93,
-	0x7a, 0xff, 0x53, 0x2b, 0x07, 0xb7, 0xd6, 0xed, 0xad, 0x1f, 0x94, 0xf1, 0x30, 0xa4, 0x64, 0x69,
-	0xaf, 0x9c, 0xbb, 0xcf, 0xa1, 0xa5, 0x2b, 0x51, 0x1b, 0xca, 0x33, 0xbc, 0x64, 0x51, 0x8d, 0x27,
-	0x35, 0x3b, 0x3b, 0xa2, 0x0f, 0xe1, 0x70, 0xe1, 0x04, 0x29, 0xee, 0x94, 0xf8, 0x37, 0x21, 0x3c,
-	0x2b, 0x7d, 0x69, 0x98, 0x7f, 0x1b, 0xf0, 0x30, 0x07, 0x1b, 0x12, 0x12, 0x91, 0xd7, 0x7e, 0x92,
-	0xf8, 0xe1, 0xf4, 0x15, 0x5e, 0x26, 0xe8, 0x7b, 0xa8, 0xcf, 0x57, 0xa2, 0xcc, 0xb3, 0x77, 0x53,
-	0x9e, 0xeb, 0xae, 0xd6, 0xea, 0x6c, 0x17, 0x63, 0x74, 0x07, 0x00, 0x2b, 0x15, 0x42, 0x50, 0x09,
-	0x9d, 0x39, 0x96, 0xb9, 0xf2, 0x33, 0x7a, 0x0c, 0x75, 0x0f, 0x27, 0x2e, 0xf1, 0x63, 0xea, 0x47,
-	0xa1, 0x4c, 0xb9, 0xf8, 0xc9, 0xfc, 0x05, 0x9a, 0xa3, 0x70, 0x11, 0xcd, 0xf2, 0x6a, 0x32, 0xc6,
-	0x34, 0x9a, 0x29, 0xc6, 0xec, 0x88, 0x3e, 0x83, 0x8a, 0x43, 0xa6, 0x09, 0xf7, 0xae, 0xf7, 0x4f,
-	0x2c, 0xd1, 0x21, 0x4b, 0x75, 0xc8, 0x7a, 0xcb, 0x3b, 0x64, 0x73, 0x23, 0xd4, 0x85, 0x23, 0x35,
-	0x07, 0x9d, 0x32, 0x8f, 0x91, 0xcb, 0xe6, 0x02, 0x5a, 0x0a, 0x2b, 0x89, 0xa3, 0x30, 0xc1, 0xa8,
-	0x07, 0x55, 0x82, 0x69, 0x4a, 0x42, 0x8e, 0xb7, 0x23, 0xb8, 0x34, 0x43, 0x4f, 0xe1, 0x68, 0xe2,
-	0xf8, 0x01, 0xab, 0x52, 0x96, 0x4f, 0x99, 0xbb, 0x14, 0x4a, 0x78, 0x85, 0xdd, 0xd9, 0x99, 0xd0,
-	0xdb, 0xb9, 0xa1, 0xf9, 0x3b, 0x34, 0xb8, 0xa6, 0x40, 0x51, 0x41, 0x32, 0x8a, 0x59, 0x58, 0x46,
-	0x31, 0x0a, 0xbc, 0xdb, 0x29, 0x66, 0x46, 0x99, 0x71, 0x88, 0x7f, 0x4b, 0x38, 0xbd, 0x5d, 0xc6,
-	0x99, 0x91, 0x99, 0x42, 0x53, 0x62, 0xaf, 0x28, 0xfb, 0x61, 0x9c, 0xd2, 0xe4, 0x56, 0xca, 0xc2,
-	0xec, 0x6e, 0x94, 0x07, 0x92, 0xb2, 0xd4, 0xc8, 0xb6, 0xc4, 0x98, 0x50, 0x35, 0xcc, 0xb9, 0x8c,
-	0x8e, 0xb3, 0x26, 0x38, 0x49, 0x3e, 0x1f, 0x52, 0x32, 0xaf, 0x0d, 0xa8, 0x9f, 0xfa, 0x93, 0x89,
-	0x2a, 0x5b, 0x0b, 0x4a, 0xbe, 0x27, 0xbd, 0xd9, 0x49, 0x95, 0xb1, 0xb4, 0x59, 0xc6, 0xf2, 0x7f,
-	0x29, 0x63, 0x65, 0x9f, 0x32, 0xfe, 0x63, 0x40, 0x43, 0xe4, 0x22, 0xcb, 0xc8, 0x08, 0x11, 0x1c,
-	0x07, 0x8e, 0x2b, 0xef, 0x3c, 0x23, 0xa4, 0x64, 0xd4, 0x81, 0x07, 0x09, 0x15, 0xeb, 0xa0, 0xc4,
-	0x55, 0x4a, 0x44, 0x9f, 0xc3, 0x07, 0x1e, 0x0e, 0x30, 0xc5, 0x03, 0x3c, 0x89, 0xb2, 0x8d, 0xc0,
-	0x3d, 0x78, 0xbe, 0x47, 0xf6, 0x4d, 0x2a, 0xf4, 0x02, 0x1e, 0xb8, 0x57, 0x4e, 0x38, 0xc5, 0x22,
-	0xd1, 0x56, 0xff, 0x93, 0x42, 0xf1, 0x8b, 0x19, 0x71, 0xe1, 0xa5, 0x30, 0xb5, 0x95, 0x8f, 0xf9,
-	0x42, 0x94, 0x50, 0x7e, 0x67, 0x25, 0x6b, 0x9c, 0x8e, 0xce, 0xce, 0x7e, 0x7e, 0x37, 0x7e, 0x35,
-	0x3e, 0xbf, 0x18, 0xb7, 0x0f, 0x50, 0x13, 0x6a, 0xfc, 0xcb, 0xf8, 0x7c, 0x3c, 0x6c, 0x1b, 0xb9,
-	0xf8, 0xf6, 0xfc, 0xf5, 0xb0, 0x5d, 0x32, 0x7f, 0x62, 0xd3, 0xc3, 0xba, 0x41, 0xf1, 0xf6, 0xd1,
-	0xfd, 0x02, 0x40, 0x76, 0xd2, 0xc7, 0xb7, 0x0e, 0x70, 0xc1, 0xd4, 0xfc, 0x11, 0x5a, 0x2a, 0xb6,
-	0xac, 0xe9, 0x7a, 0x83, 0xef, 0x1c, 0xfa, 0x0a, 0xea, 0x36, 0x76, 0xbc, 0xfd, 0x07, 0x47, 0x47,
-	0x2a, 0xef, 0x8f, 0x74, 0x01, 0x0d, 0x81, 0x74, 0xdf, 0x14, 0xfe, 0x34, 0xa0, 0xf9, 0x2e, 0xf6,
-	0x0a, 0xa5, 0x7f, 0x9f, 0xe3, 0x3f, 0x82, 0x96, 0x4a, 0x46, 0x12, 0xd5, 0x89, 0x19, 0xfb, 0x13,
-	0x63, 0x0b, 0xff, 0x94, 0xcf, 0xf9, 0xff, 0xd0, 0x9d, 0x3f, 0xe0, 0x84, 0x3f, 0x66, 0x2c, 0xeb,
-	0x28, 0x25, 0x2e, 0x1e, 0x85, 0x3e, 0xcd, 0x36, 0x12, 0xf6, 0xee, 0xad, 0x51, 0xd9, 0x65, 0x17,
-	0xfb, 0x2a, 0xcb, 0x8c, 0x5f, 0x76, 0x29, 0xf6, 0xaf, 0x0f, 0xa1, 0xad, 0x90, 0xdf, 0xc8, 0x37,
-	0x08, 0x0d, 0xa0, 0x96, 0x3f, 0xb4, 0xe8, 0xd1, 0x8e, 0xdf, 0x84, 0xee, 0xf1, 0x06, 0xfa, 0x30,
-	0xfb, 0x4f, 0x31, 0x0f, 0xd0, 0xd7, 0x50, 0x15, 0xef, 0x18, 0xea, 0x14, 0x02, 0x68, 0xcf, 0x68,
-	0xf7, 0xe1, 0x0d, 0x1a, 0xd1, 0x3a, 0x16, 0xe0, 0x39, 0x1c, 0xf2, 0xed, 0x8c, 0x36, 0x36, 0xb9,
-	0x72, 0xef, 0x6c, 0x2a, 0x72, 0xef, 0xaf, 0xa0, 0x92, 0xed, 0x14, 0x74, 0xbc, 0xb1, 0x89, 0x84,
-	0xef, 0xc9, 0x96, 0x0d, 0x25, 0x32, 0x17, 0x77, 0x5e, 0xcb, 0x5c, 0x5b, 0x31, 0x5a, 0xe6, 0xfa,
-	0x82, 0x10, 0xd8, 0xd9, 0x7d, 0xd3, 0xb0, 0x0b, 0x57, 0x5d, 0xc3, 0x2e, 0x5e, 0x4c, 0x81, 0x2d,
-	0x66, 0x58, 0xc3, 0xd6, 0xee, 0x98, 0x86, 0xad, 0x0f, 0x3c, 0xaf, 0x5a, 0x55, 0x4c, 0xae, 0x16,
-	0x40, 0x1b, 0xe6, 0x1d, 0x4d, 0x7b, 0xc6, 0xa8, 0x3b, 0xa1, 0x8b, 0x03, 0xb4, 0xc5, 0x66, 0x87,
-	0xef, 0x37, 0xd0, 0xfc, 0x16, 0xd3, 0x37, 0xfc, 0x27, 0x76, 0x14, 0x4e, 0xa2, 0xad, 0x21, 0x3e,
-	0x2a, 0x24, 0xb6, 0x32, 0x37, 0x0f, 0x2e, 0xab, 0xdc, 0xf0, 0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0x82, 0x27, 0xcd, 0x25, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("provider.proto", fileDescriptor_provider_c99e6f050a3605c2) }
+
+var fileDescriptor_provider_c99e6f050a3605c2 = []byte{
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdb, 0x54,
+	0x14, 0x8e, 0x2c, 0xdb, 0x89, 0x8f, 0x7f, 0xaa, 0x5e, 0x20, 0x51, 0xd4, 0x2c, 0x32, 0x62, 0x13,
+	0x28, 0x38, 0x9d, 0x74, 0x01, 0x74, 0xda, 0x81, 0x24, 0x76, 0x4a, 0x26, 0xcd, 0x0f, 0x4a, 0x43,
+	0x61, 0x55, 0x14, 0xeb, 0xda, 0x11, 0x96, 0x25, 0x71, 0x75, 0x65, 0x26, 0x0c, 0x4b, 0x36, 0xbc,
+	0x02, 0x7b, 0x5e, 0x80, 0x27, 0x60, 0xc7, 0x03, 0xf0, 0x42, 0xcc, 0xfd, 0x91, 0x7c, 0xe5, 0x9f,
+	0x24, 0x74, 0xca, 0xb0, 0xbb, 0xe7, 0x7e, 0xe7, 0xdc, 0xf3, 0x7f, 0x74, 0x04, 0xad, 0x98, 0x44,
+	0x63, 0xdf, 0xc3, 0xa4, 0x1d, 0x93, 0x88, 0x46, 0xa8, 0x16, 0xa7, 0x41, 0x3a, 0xf2, 0x49, 0xdc,
+	0xb3, 0x1a, 0x71, 0x90, 0x0e, 0xfc, 0x50, 0x00, 0xd6, 0x83, 0x41, 0x14, 0x0d, 0x02, 0xbc, 0xcd,
+	0xa9, 0xcb, 0xb4, 0xbf, 0x8d, 0x47, 0x31, 0xbd, 0x96, 0xe0, 0xc6, 0x34, 0x98, 0x50, 0x92, 0xf6,
+	0xa8, 0x40, 0xed, 0xdf, 0x34, 0x30, 0xf6, 0xa3, 0xb0, 0xef, 0x0f, 0x52, 0x82, 0x1d, 0xfc, 0x43,
+	0x8a, 0x13, 0x8a, 0xbe, 0x84, 0xda, 0xd8, 0x25, 0xbe, 0x7b, 0x19, 0xe0, 0xc4, 0xd4, 0x36, 0xf5,
+	0xad, 0xfa, 0xce, 0x87, 0xed, 0x5c, 0x79, 0x7b, 0x9a, 0xbf, 0xfd, 0x75, 0xc6, 0xdc, 0x0d, 0x29,
+	0xb9, 0x76, 0x26, 0xc2, 0xd6, 0x53, 0x68, 0x15, 0x41, 0x64, 0x80, 0x3e, 0xc4, 0xd7, 0xa6, 0xb6,
+	0xa9, 0x6d, 0xd5, 0x1c, 0x76, 0x44, 0xef, 0x42, 0x65, 0xec, 0x06, 0x29, 0x36, 0x4b, 0xfc, 0x4e,
+	0x10, 0x4f, 0x4a, 0x9f, 0x6a, 0xf6, 0x1f, 0x1a, 0xac, 0xe7, 0xca, 0xba, 0x84, 0x44, 0xe4, 0xd8,
+	0x4f, 0x12, 0x3f, 0x1c, 0x1c, 0xe1, 0xeb, 0x04, 0x7d, 0x05, 0xf5, 0xd1, 0x84, 0x94, 0x76, 0x6e,
+	0xcf, 0xb3, 0x73, 0x5a, 0xb4, 0x3d, 0x39, 0x3b, 0xea, 0x1b, 0xd6, 0x1e, 0xc0, 0x04, 0x42, 0x08,
+	0xca, 0xa1, 0x3b, 0xc2, 0xd2, 0x56, 0x7e, 0x46, 0x9b, 0x50, 0xf7, 0x70, 0xd2, 0x23, 0x7e, 0x4c,
+	0xfd, 0x28, 0x94, 0x26, 0xab, 0x57, 0xf6, 0xf7, 0xd0, 0x3c, 0x0c, 0xc7, 0xd1, 0x30, 0x8f, 0xa6,
+	0x01, 0x3a, 0x8d, 0x86, 0x99, 0xc7, 0x34, 0x1a, 0xa2, 0x87, 0x50, 0x76, 0xc9, 0x20, 0xe1, 0xd2,
+	0xf5, 0x9d, 0xb5, 0xb6, 0xc8, 0x50, 0x3b, 0xcb, 0x50, 0xfb, 0x9c, 0x67, 0xc8, 0xe1, 0x4c, 0xc8,
+	0x82, 0x95, 0xac, 0x0e, 0x4c, 0x9d, 0xbf, 0x91, 0xd3, 0xf6, 0x18, 0x5a, 0x99, 0xae, 0x24, 0x8e,
+	0xc2, 0x04, 0xa3, 0x6d, 0xa8, 0x12, 0x4c, 0x53, 0x12, 0x9a, 0xda, 0xcd, 0x8f, 0x4b, 0x36, 0xf4,
+	0x18, 0x56, 0xfa, 0xae, 0x1f, 0xa4, 0x04, 0x33, 0x7b, 0x74, 0x2e, 0xa2, 0x84, 0xf0, 0x0a, 0xf7,
+	0x86, 0x07, 0x02, 0x77, 0x72, 0x46, 0xfb, 0x27, 0x68, 0x70, 0x44, 0x71, 0x31, 0x53, 0x59, 0x73,
+	0xd8, 0x91, 0xb9, 0x18, 0x05, 0xde, 0xed, 0x2e, 0x32, 0x26, 0xc6, 0x1c, 0xe2, 0x1f, 0x13, 0x53,
+	0xbf, 0x85, 0x99, 0x31, 0xd9, 0x29, 0x34, 0xa5, 0xee, 0x89, 0xcb, 0x7e, 0x18, 0xa7, 0x34, 0xb9,
+	0xd5, 0x65, 0xc1, 0xf6, 0x66, 0x2e, 0xef, 0x41, 0x43, 0x45, 0x64, 0x5a, 0x62, 0x4c, 0x68, 0x56,
+	0xcc, 0x39, 0x8d, 0x56, 0x59, 0x12, 0xdc, 0x24, 0xaf, 0x0f, 0x49, 0xd9, 0xbf, 0x6a, 0x50, 0xef,
+	0xf8, 0xfd, 0x7e, 0x16, 0xb6, 0x16, 0x94, 0x7c, 0x4f, 0x4a, 0x97, 0x7c, 0x2f, 0x0b, 0x63, 0x69,
+	0x36, 0x8c, 0xfa, 0xbf, 0x09, 0x63, 0xf9, 0x2e, 0x61, 0xfc, 0x4b, 0x87, 0x86, 0xb0, 0x45, 0x86,
+	0xd1, 0x82, 0x15, 0x82, 0xe3, 0xc0, 0xed, 0xc9, 0x9e, 0xaf, 0x39, 0x39, 0x8d, 0x4c, 0x58, 0x4e,
+	0xa8, 0x18, 0x07, 0x25, 0x0e, 0x65, 0x24, 0x7a, 0x04, 0xef, 0x78, 0x38, 0xc0, 0x14, 0xef, 0xe1,
+	0x7e, 0xc4, 0x26, 0x02, 0x97, 0xe0, 0xf6, 0xae, 0x38, 0xf3, 0x20, 0xf4, 0x0c, 0x96, 0x7b, 0x57,
+	0x6e, 0x38, 0xc0, 0xc2, 0xd0, 0xd6, 0xce, 0xfb, 0x4a, 0xf0, 0x55, 0x8b, 0x38, 0xb1, 0x2f, 0x58,
+	0x9d, 0x4c, 0x06, 0x1d, 0x43, 0xc3, 0xc3, 0xd4, 0xf5, 0x03, 0xec, 0x31, 0xdc, 0xac, 0xf0, 0x04,
+	0x7e, 0xb0, 0xf0, 0x0d, 0x85, 0x57, 0x4c, 0xa7, 0x82, 0x38, 0xda, 0x82, 0x7b, 0x57, 0x6e, 0xa2,
+	0x72, 0x99, 0x55, 0x6e, 0xfb, 0xf4, 0xb5, 0xf5, 0x0d, 0xdc, 0x9f, 0x79, 0x6c, 0xce, 0x34, 0xfb,
+	0x58, 0x9d, 0x66, 0xc5, 0xca, 0x3a, 0x93, 0xf5, 0xc1, 0x0d, 0x54, 0xc6, 0xdc, 0x33, 0xa8, 0x2b,
+	0xae, 0x22, 0x03, 0x1a, 0x9d, 0xc3, 0x83, 0x83, 0xd7, 0x17, 0x27, 0x47, 0x27, 0xa7, 0xaf, 0x4e,
+	0x8c, 0x25, 0xd4, 0x84, 0x1a, 0xbf, 0x39, 0x39, 0x3d, 0xe9, 0x1a, 0x5a, 0x4e, 0x9e, 0x9f, 0x1e,
+	0x77, 0x8d, 0x92, 0xfd, 0xa7, 0x06, 0x0d, 0xf5, 0x69, 0xf4, 0x08, 0xca, 0x43, 0x3f, 0x14, 0x85,
+	0xd5, 0xda, 0xd9, 0x58, 0x60, 0x41, 0xfb, 0xc8, 0x0f, 0x3d, 0x87, 0x73, 0xa2, 0x0d, 0xa8, 0xf1,
+	0xde, 0xe0, 0xfe, 0x97, 0xb8, 0xff, 0x93, 0x0b, 0xfb, 0x3b, 0x28, 0x33, 0x5e, 0xb4, 0x0c, 0xfa,
+	0x6e, 0xa7, 0x63, 0x2c, 0xa1, 0x7b, 0x50, 0xdf, 0xed, 0x74, 0x5e, 0x3b, 0xdd, 0xb3, 0x17, 0xbb,
+	0xfb, 0xcc, 0x22, 0x80, 0x6a, 0xa7, 0xfb, 0xa2, 0xfb, 0xb2, 0x6b, 0x94, 0x10, 0x82, 0x96, 0x38,
+	0xe7, 0xb8, 0xce, 0xf0, 0x8b, 0xb3, 0xce, 0xee, 0xcb, 0xae, 0x51, 0x66, 0xb8, 0x38, 0xe7, 0x78,
+	0xc5, 0xa6, 0xd0, 0xdc, 0x27, 0xd8, 0xa5, 0x78, 0xf1, 0x40, 0xf9, 0x04, 0x40, 0xf6, 0x97, 0x8f,
+	0x6f, 0x1d, 0x2b, 0x0a, 0x2b, 0xab, 0x5d, 0xea, 0x8f, 0x70, 0x94, 0x52, 0x5e, 0x95, 0x9a, 0x93,
+	0x91, 0xf6, 0xb7, 0xd0, 0xca, 0xb4, 0xca, 0x1e, 0x98, 0x6e, 0xc8, 0x37, 0x55, 0x6a, 0x5f, 0x41,
+	0xdd, 0xc1, 0xae, 0x77, 0xf7, 0x46, 0x2f, 0x6a, 0xd2, 0xef, 0xae, 0xe9, 0x15, 0x34, 0x84, 0xa6,
+	0xb7, 0xed, 0xc2, 0xef, 0x1a, 0x34, 0x2f, 0x62, 0x4f, 0x49, 0xca, 0xff, 0x38, 0xae, 0xd4, 0x2c,
+	0x56, 0x8a, 0x59, 0x3c, 0x84, 0x56, 0x66, 0xa6, 0x0c, 0x41, 0xd1, 0x65, 0xed, 0xee, 0x2e, 0xff,
+	0xa2, 0x41, 0xb3, 0xc3, 0x47, 0xd6, 0x7f, 0x9f, 0x38, 0xd5, 0xa3, 0x72, 0xd1, 0xa3, 0x9f, 0x61,
+	0x8d, 0x6f, 0x2c, 0x0e, 0x4e, 0xa2, 0x94, 0xf4, 0xf0, 0x61, 0xe8, 0xd3, 0x03, 0x3e, 0x78, 0xde,
+	0x5a, 0x76, 0x99, 0x76, 0xf1, 0x51, 0x62, 0x36, 0xf3, 0x89, 0x2e, 0x49, 0xfb, 0x23, 0x30, 0x9e,
+	0x63, 0x7a, 0xde, 0xbb, 0xc2, 0x23, 0x37, 0x0b, 0x83, 0x09, 0xcb, 0x63, 0x4c, 0x12, 0xb6, 0xf1,
+	0x30, 0xdd, 0x15, 0x27, 0x23, 0xed, 0x87, 0x70, 0x5f, 0xe1, 0x96, 0x09, 0x58, 0x85, 0x6a, 0xc2,
+	0x6f, 0xa4, 0xa5, 0x92, 0xda, 0xf9, 0xbb, 0x02, 0x46, 0xe6, 0xd4, 0x99, 0xdc, 0x61, 0xd0, 0x1e,
+	0xd4, 0xf2, 0x45, 0x0d, 0x3d, 0xb8, 0x61, 0xcd, 0xb4, 0x56, 0x67, 0x1c, 0xeb, 0xb2, 0x3d, 0xd7,
+	0x5e, 0x42, 0x9f, 0x43, 0x55, 0xec, 0x41, 0xc8, 0x54, 0x1e, 0x28, 0xac, 0x61, 0xd6, 0xfa, 0x1c,
+	0x44, 0xd8, 0x6b, 0x2f, 0xa1, 0xa7, 0x50, 0xe1, 0x5f, 0x77, 0x34, 0xb3, 0x09, 0x64, 0xe2, 0xe6,
+	0x2c, 0x90, 0x4b, 0x7f, 0x06, 0x65, 0x3e, 0x78, 0x57, 0x67, 0xbe, 0x42, 0x42, 0x76, 0x6d, 0xc1,
+	0xd7, 0x49, 0x58, 0x2e, 0x66, 0x50, 0xc1, 0xf2, 0xc2, 0x30, 0xb4, 0xd6, 0xe7, 0x20, 0xaa, 0x6e,
+	0xd6, 0xff, 0x05, 0xdd, 0xca, 0xe8, 0xb1, 0xd6, 0x66, 0xee, 0x55, 0xdd, 0xa2, 0x73, 0x0a, 0xba,
+	0x0b, 0x3d, 0x6f, 0xad, 0xcf, 0x41, 0x94, 0xa8, 0x55, 0x45, 0xbb, 0x14, 0x1e, 0x28, 0x74, 0xd0,
+	0x0d, 0x49, 0x7b, 0x02, 0xd5, 0x7d, 0x37, 0xec, 0xe1, 0x00, 0x2d, 0xe0, 0xb9, 0x41, 0xf6, 0x0b,
+	0x68, 0x3e, 0xc7, 0xf4, 0x8c, 0xff, 0x04, 0x1d, 0x86, 0xfd, 0x68, 0xe1, 0x13, 0xef, 0xa9, 0x5f,
+	0xbf, 0x9c, 0xdd, 0x5e, 0x62, 0xff, 0x38, 0x79, 0xe1, 0x16, 0xca, 0x6e, 0xba, 0xf8, 0xad, 0x8d,
+	0xf9, 0x60, 0x16, 0x85, 0xcb, 0x2a, 0x57, 0xf9, 0xf8, 0x9f, 0x01, 0x00, 0x60, 0x6a, 0x26, 0x06,
+	0xaf, 0x0d, 0x00, 0x00,
 }
@@ -43,7 +43,7 @@ func (m *ReadResourceRequest) Reset()         { *m = ReadResourceRequest{} }
 func (m *ReadResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadResourceRequest) ProtoMessage()    {}
 func (*ReadResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_resource_5aa1dff965971124, []int{0}
+	return fileDescriptor_resource_986464946fdf8777, []int{0}
 }
 func (m *ReadResourceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResourceRequest.Unmarshal(m, b)
@@ -125,7 +125,7 @@ func (m *ReadResourceResponse) Reset()         { *m = ReadResourceResponse{} }
 func (m *ReadResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResourceResponse) ProtoMessage()    {}
 func (*ReadResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_resource_5aa1dff965971124, []int{1}
+	return fileDescriptor_resource_986464946fdf8777, []int{1}
 }
 func (m *ReadResourceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResourceResponse.Unmarshal(m, b)
@@ -161,24 +161,28 @@ func (m *ReadResourceResponse) GetProperties() *_struct.Struct {
 
 // RegisterResourceRequest contains information about a resource object that was newly allocated.
 type RegisterResourceRequest struct {
-	Type                 string          `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
-	Name                 string          `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Parent               string          `protobuf:"bytes,3,opt,name=parent" json:"parent,omitempty"`
-	Custom               bool            `protobuf:"varint,4,opt,name=custom" json:"custom,omitempty"`
-	Object               *_struct.Struct `protobuf:"bytes,5,opt,name=object" json:"object,omitempty"`
-	Protect              bool            `protobuf:"varint,6,opt,name=protect" json:"protect,omitempty"`
-	Dependencies         []string        `protobuf:"bytes,7,rep,name=dependencies" json:"dependencies,omitempty"`
-	Provider             string          `protobuf:"bytes,8,opt,name=provider" json:"provider,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Type                 string                                  `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Name                 string                                  `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Parent               string                                  `protobuf:"bytes,3,opt,name=parent" json:"parent,omitempty"`
+	Custom               bool                                    `protobuf:"varint,4,opt,name=custom" json:"custom,omitempty"`
+	Object               *_struct.Struct                         `protobuf:"bytes,5,opt,name=object" json:"object,omitempty"`
+	Protect              bool                                    `protobuf:"varint,6,opt,name=protect" json:"protect,omitempty"`
+	Dependencies         []string                                `protobuf:"bytes,7,rep,name=dependencies" json:"dependencies,omitempty"`
+	Provider             string                                  `protobuf:"bytes,8,opt,name=provider" json:"provider,omitempty"`
+	IgnoreChanges        []string                                `protobuf:"bytes,9,rep,name=ignoreChanges" json:"ignoreChanges,omitempty"`
+	Aliases              []string                                `protobuf:"bytes,10,rep,name=aliases" json:"aliases,omitempty"`
+	ImportId             string                                  `protobuf:"bytes,11,opt,name=importId" json:"importId,omitempty"`
+	CustomTimeouts       *RegisterResourceRequest_CustomTimeouts `protobuf:"bytes,12,opt,name=customTimeouts" json:"customTimeouts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
 func (m *RegisterResourceRequest) Reset()         { *m = RegisterResourceRequest{} }
 func (m *RegisterResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterResourceRequest) ProtoMessage()    {}
 func (*RegisterResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_resource_5aa1dff965971124, []int{2}
+	return fileDescriptor_resource_986464946fdf8777, []int{2}
 }
 func (m *RegisterResourceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterResourceRequest.Unmarshal(m, b)
@@ -254,6 +258,92 @@ func (m *RegisterResourceRequest) GetProvider() string {
 	return ""
 }
 
+func (m *RegisterResourceRequest) GetIgnoreChanges() []string {
+	if m != nil {
+		return m.IgnoreChanges
+	}
+	return nil
+}
+
+func (m *RegisterResourceRequest) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *RegisterResourceRequest) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+func (m *RegisterResourceRequest) GetCustomTimeouts() *RegisterResourceRequest_CustomTimeouts {
+	if m != nil {
+		return m.CustomTimeouts
+	}
+	return nil
+}
+
+// CustomTimeouts allows a user to override the timeouts for a resource's provisioning operations. Each timeout is
+// a duration string (e.g. "10m" or "1h30m"); an empty string means that the provider's default should be used.
+type RegisterResourceRequest_CustomTimeouts struct {
+	Create               string   `protobuf:"bytes,1,opt,name=create" json:"create,omitempty"`
+	Update               string   `protobuf:"bytes,2,opt,name=update" json:"update,omitempty"`
+	Delete               string   `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResourceRequest_CustomTimeouts) Reset() {
+	*m = RegisterResourceRequest_CustomTimeouts{}
+}
+func (m *RegisterResourceRequest_CustomTimeouts) String() string { return proto.CompactTextString(m) }
+func (*RegisterResourceRequest_CustomTimeouts) ProtoMessage()    {}
+func (*RegisterResourceRequest_CustomTimeouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_resource_986464946fdf8777, []int{2, 0}
+}
+func (m *RegisterResourceRequest_CustomTimeouts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResourceRequest_CustomTimeouts.Unmarshal(m, b)
+}
+func (m *RegisterResourceRequest_CustomTimeouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResourceRequest_CustomTimeouts.Marshal(b, m, deterministic)
+}
+func (dst *RegisterResourceRequest_CustomTimeouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResourceRequest_CustomTimeouts.Merge(dst, src)
+}
+func (m *RegisterResourceRequest_CustomTimeouts) XXX_Size() int {
+	return xxx_messageInfo_RegisterResourceRequest_CustomTimeouts.Size(m)
+}
+func (m *RegisterResourceRequest_CustomTimeouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResourceRequest_CustomTimeouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResourceRequest_CustomTimeouts proto.InternalMessageInfo
+
+func (m *RegisterResourceRequest_CustomTimeouts) GetCreate() string {
+	if m != nil {
+		return m.Create
+	}
+	return ""
+}
+
+func (m *RegisterResourceRequest_CustomTimeouts) GetUpdate() string {
+	if m != nil {
+		return m.Update
+	}
+	return ""
+}
+
+func (m *RegisterResourceRequest_CustomTimeouts) GetDelete() string {
+	if m != nil {
+		return m.Delete
+	}
+	return ""
+}
+
 // RegisterResourceResponse is returned by the engine after a resource has finished being initialized.  It includes the
 // auto-assigned URN, the provider-assigned ID, and any other properties initialized by the engine.
 type RegisterResourceResponse struct {
@@ -271,7 +361,7 @@ func (m *RegisterResourceResponse) Reset()         { *m = RegisterResourceRespon
 func (m *RegisterResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterResourceResponse) ProtoMessage()    {}
 func (*RegisterResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_resource_5aa1dff965971124, []int{3}
+	return fileDescriptor_resource_986464946fdf8777, []int{3}
 }
 func (m *RegisterResourceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterResourceResponse.Unmarshal(m, b)
@@ -339,7 +429,7 @@ func (m *RegisterResourceOutputsRequest) Reset()         { *m = RegisterResource
 func (m *RegisterResourceOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterResourceOutputsRequest) ProtoMessage()    {}
 func (*RegisterResourceOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_resource_5aa1dff965971124, []int{4}
+	return fileDescriptor_resource_986464946fdf8777, []int{4}
 }
 func (m *RegisterResourceOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterResourceOutputsRequest.Unmarshal(m, b)
@@ -377,6 +467,7 @@ func init() {
 	proto.RegisterType((*ReadResourceRequest)(nil), "pulumirpc.ReadResourceRequest")
 	proto.RegisterType((*ReadResourceResponse)(nil), "pulumirpc.ReadResourceResponse")
 	proto.RegisterType((*RegisterResourceRequest)(nil), "pulumirpc.RegisterResourceRequest")
+	proto.RegisterType((*RegisterResourceRequest_CustomTimeouts)(nil), "pulumirpc.RegisterResourceRequest.CustomTimeouts")
 	proto.RegisterType((*RegisterResourceResponse)(nil), "pulumirpc.RegisterResourceResponse")
 	proto.RegisterType((*RegisterResourceOutputsRequest)(nil), "pulumirpc.RegisterResourceOutputsRequest")
 }
@@ -552,39 +643,45 @@ var _ResourceMonitor_serviceDesc = grpc.ServiceDesc{
 	Metadata: "resource.proto",
 }
 
-func init() { proto.RegisterFile("resource.proto", fileDescriptor_resource_5aa1dff965971124) }
-
-var fileDescriptor_resource_5aa1dff965971124 = []byte{
-	// 491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x6b, 0xbb, 0x38, 0xe9, 0x50, 0x85, 0x6a, 0x41, 0xad, 0x31, 0xa8, 0x54, 0xe6, 0x02,
-	0x17, 0x47, 0x94, 0x03, 0x47, 0x4e, 0x1c, 0x38, 0x20, 0x84, 0x39, 0x83, 0xe4, 0xd8, 0x43, 0x64,
-	0x48, 0xbc, 0xcb, 0x7e, 0x54, 0xea, 0xd3, 0xf0, 0x66, 0x9c, 0x78, 0x0c, 0x0e, 0x78, 0x77, 0xbd,
-	0x26, 0xfe, 0x68, 0xd3, 0x53, 0xe6, 0x6b, 0x67, 0xe7, 0xff, 0xf3, 0x6c, 0x60, 0xc1, 0x51, 0x50,
-	0xc5, 0x0b, 0x4c, 0x19, 0xa7, 0x92, 0x92, 0x23, 0xa6, 0x36, 0x6a, 0x5b, 0x71, 0x56, 0xc4, 0x4f,
-	0xd6, 0x94, 0xae, 0x37, 0xb8, 0x34, 0x89, 0x95, 0xfa, 0xb6, 0xc4, 0x2d, 0x93, 0xd7, 0xb6, 0x2e,
-	0x7e, 0x3a, 0x4c, 0x0a, 0xc9, 0x55, 0x21, 0xdb, 0xec, 0xa2, 0xf9, 0xb9, 0xaa, 0x4a, 0xe4, 0xd6,
-	0x4f, 0x7e, 0x7b, 0xf0, 0x30, 0xc3, 0xbc, 0xcc, 0xda, 0xcb, 0x32, 0xfc, 0xa9, 0x50, 0x48, 0xb2,
-	0x00, 0xbf, 0x2a, 0x23, 0xef, 0xc2, 0x7b, 0x71, 0x94, 0x35, 0x16, 0x21, 0x70, 0x28, 0xaf, 0x19,
-	0x46, 0xbe, 0x89, 0x18, 0x5b, 0xc7, 0xea, 0x7c, 0x8b, 0x51, 0x60, 0x63, 0xda, 0x26, 0xa7, 0x10,
-	0xb2, 0x9c, 0x63, 0x2d, 0xa3, 0x43, 0x13, 0x6d, 0x3d, 0xf2, 0x06, 0xa0, 0xb9, 0x90, 0x21, 0x97,
-	0x15, 0x8a, 0xe8, 0x5e, 0x93, 0xbb, 0x7f, 0x79, 0x96, 0xda, 0x51, 0x53, 0x37, 0x6a, 0xfa, 0xd9,
-	0x8c, 0x9a, 0xed, 0x94, 0x92, 0x04, 0x8e, 0x4b, 0x64, 0x58, 0x97, 0x58, 0x17, 0xfa, 0x68, 0x78,
-	0x11, 0x34, 0x6d, 0x7b, 0x31, 0x12, 0xc3, 0xdc, 0xc9, 0x8a, 0x66, 0xe6, 0xda, 0xce, 0x4f, 0x72,
-	0x78, 0xd4, 0xd7, 0x27, 0x18, 0xad, 0x05, 0x92, 0x13, 0x08, 0x14, 0xaf, 0x5b, 0x85, 0xda, 0x1c,
-	0x8c, 0xe8, 0xdf, 0x79, 0xc4, 0xe4, 0xaf, 0x07, 0x67, 0x19, 0xae, 0x2b, 0x21, 0x91, 0x0f, 0x39,
-	0x3a, 0x6e, 0xde, 0x04, 0x37, 0x7f, 0x92, 0x5b, 0xd0, 0xe3, 0xd6, 0xc4, 0x0b, 0x25, 0x24, 0xdd,
-	0x1a, 0x9e, 0xf3, 0xac, 0xf5, 0xc8, 0x12, 0x42, 0xba, 0xfa, 0x8e, 0x85, 0xdc, 0xc7, 0xb2, 0x2d,
-	0x23, 0x11, 0xcc, 0x74, 0x4a, 0x9f, 0x08, 0x4d, 0x27, 0xe7, 0x8e, 0x08, 0xcf, 0xf6, 0x10, 0x9e,
-	0x0f, 0x08, 0xff, 0xf2, 0x20, 0x1a, 0xcb, 0xbf, 0x11, 0xb3, 0xdd, 0x2c, 0xbf, 0xdb, 0xac, 0xff,
-	0x4a, 0x82, 0xbb, 0x29, 0x69, 0x90, 0x08, 0x99, 0xaf, 0x36, 0xe8, 0x90, 0x58, 0x4f, 0x2b, 0xb4,
-	0x96, 0xde, 0x2f, 0x2d, 0xc1, 0xb9, 0x09, 0xc2, 0xf9, 0x70, 0xc0, 0x8f, 0x4a, 0x32, 0x25, 0x85,
-	0xfb, 0x4c, 0xe3, 0x31, 0x5f, 0xc1, 0x8c, 0xda, 0x9a, 0x7d, 0xab, 0xe0, 0xea, 0x2e, 0xff, 0xf8,
-	0xf0, 0xc0, 0xf5, 0xff, 0x40, 0xeb, 0x4a, 0x52, 0x4e, 0xde, 0x42, 0xf8, 0xbe, 0xbe, 0xa2, 0x3f,
-	0x9a, 0xf1, 0xd2, 0xee, 0x01, 0xa7, 0x36, 0xd4, 0x5e, 0x1e, 0x3f, 0x9e, 0xc8, 0x58, 0x7c, 0xc9,
-	0x01, 0xf9, 0x04, 0xc7, 0xbb, 0xfb, 0x4b, 0xce, 0x77, 0x8a, 0x27, 0x1e, 0x6e, 0xfc, 0xec, 0xc6,
-	0x7c, 0xd7, 0xf2, 0x0b, 0x9c, 0x0c, 0x71, 0x90, 0xa4, 0x77, 0x6c, 0x72, 0x97, 0xe3, 0xe7, 0xb7,
-	0xd6, 0x74, 0xed, 0xbf, 0x8e, 0x5f, 0x43, 0x4b, 0x9b, 0xbc, 0xbc, 0xa5, 0x43, 0xff, 0x8b, 0xc4,
-	0xa7, 0x23, 0xdc, 0xef, 0xf4, 0x9f, 0x5c, 0x72, 0xb0, 0x0a, 0x4d, 0xe4, 0xf5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0x97, 0x3d, 0x93, 0x21, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("resource.proto", fileDescriptor_resource_986464946fdf8777) }
+
+var fileDescriptor_resource_986464946fdf8777 = []byte{
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x9d, 0xe2, 0x34, 0xd3, 0x12, 0xaa, 0x05, 0xa5, 0x8b, 0x41, 0xa5, 0x32, 0x1c, 0xca,
+	0xc5, 0x55, 0xcb, 0x81, 0x23, 0x87, 0x8a, 0x43, 0x0f, 0x08, 0x61, 0x38, 0xc0, 0x01, 0x24, 0xc7,
+	0x1e, 0x82, 0x21, 0xf6, 0x2e, 0xbb, 0xeb, 0x4a, 0x7d, 0x08, 0x9e, 0x81, 0x37, 0xe3, 0xc4, 0x83,
+	0xa0, 0xfd, 0x0b, 0xb5, 0x93, 0x34, 0xbd, 0xed, 0xf7, 0xcd, 0xec, 0xec, 0xcc, 0x37, 0x33, 0x0b,
+	0x63, 0x81, 0x92, 0xb5, 0xa2, 0xc0, 0x94, 0x0b, 0xa6, 0x18, 0x19, 0xf1, 0x76, 0xde, 0xd6, 0x95,
+	0xe0, 0x45, 0xfc, 0x68, 0xc6, 0xd8, 0x6c, 0x8e, 0x27, 0xc6, 0x30, 0x6d, 0xbf, 0x9e, 0x60, 0xcd,
+	0xd5, 0x95, 0xf5, 0x8b, 0x1f, 0xf7, 0x8d, 0x52, 0x89, 0xb6, 0x50, 0xce, 0x3a, 0xe6, 0x82, 0x5d,
+	0x56, 0x25, 0x0a, 0x8b, 0x93, 0x3f, 0x01, 0xdc, 0xcf, 0x30, 0x2f, 0x33, 0xf7, 0x58, 0x86, 0x3f,
+	0x5b, 0x94, 0x8a, 0x8c, 0x21, 0xac, 0x4a, 0x1a, 0x1c, 0x05, 0xc7, 0xa3, 0x2c, 0xac, 0x4a, 0x42,
+	0x60, 0x5b, 0x5d, 0x71, 0xa4, 0xa1, 0x61, 0xcc, 0x59, 0x73, 0x4d, 0x5e, 0x23, 0x1d, 0x58, 0x4e,
+	0x9f, 0xc9, 0x04, 0x22, 0x9e, 0x0b, 0x6c, 0x14, 0xdd, 0x36, 0xac, 0x43, 0xe4, 0x25, 0x00, 0x17,
+	0x8c, 0xa3, 0x50, 0x15, 0x4a, 0x7a, 0xe7, 0x28, 0x38, 0xde, 0x3d, 0x3b, 0x48, 0x6d, 0xaa, 0xa9,
+	0x4f, 0x35, 0x7d, 0x6f, 0x52, 0xcd, 0xae, 0xb9, 0x92, 0x04, 0xf6, 0x4a, 0xe4, 0xd8, 0x94, 0xd8,
+	0x14, 0xfa, 0x6a, 0x74, 0x34, 0x38, 0x1e, 0x65, 0x1d, 0x8e, 0xc4, 0xb0, 0xe3, 0xcb, 0xa2, 0x43,
+	0xf3, 0xec, 0x02, 0x27, 0x39, 0x3c, 0xe8, 0xd6, 0x27, 0x39, 0x6b, 0x24, 0x92, 0x7d, 0x18, 0xb4,
+	0xa2, 0x71, 0x15, 0xea, 0x63, 0x2f, 0xc5, 0xf0, 0xd6, 0x29, 0x26, 0xbf, 0xb6, 0xe1, 0x20, 0xc3,
+	0x59, 0x25, 0x15, 0x8a, 0xbe, 0x8e, 0x5e, 0xb7, 0x60, 0x85, 0x6e, 0xe1, 0x4a, 0xdd, 0x06, 0x1d,
+	0xdd, 0x26, 0x10, 0x15, 0xad, 0x54, 0xac, 0x36, 0x7a, 0xee, 0x64, 0x0e, 0x91, 0x13, 0x88, 0xd8,
+	0xf4, 0x3b, 0x16, 0x6a, 0x93, 0x96, 0xce, 0x8d, 0x50, 0x18, 0x6a, 0x93, 0xbe, 0x11, 0x99, 0x48,
+	0x1e, 0x2e, 0x29, 0x3c, 0xdc, 0xa0, 0xf0, 0x4e, 0x57, 0x61, 0xf2, 0x0c, 0xee, 0x56, 0xb3, 0x86,
+	0x09, 0x3c, 0xff, 0x96, 0x37, 0x33, 0x94, 0x74, 0x64, 0x02, 0x74, 0x49, 0xfd, 0x7e, 0x3e, 0xaf,
+	0x72, 0x89, 0x92, 0x82, 0xb1, 0x7b, 0xa8, 0x63, 0x57, 0x35, 0x67, 0x42, 0x5d, 0x94, 0x74, 0xd7,
+	0xc6, 0xf6, 0x98, 0x7c, 0x82, 0xb1, 0x2d, 0xf8, 0x43, 0x55, 0x23, 0x6b, 0x95, 0xa4, 0x7b, 0xa6,
+	0xdc, 0xd3, 0x74, 0xb1, 0x0d, 0xe9, 0x1a, 0xe9, 0xd3, 0xf3, 0xce, 0xc5, 0xac, 0x17, 0x28, 0xfe,
+	0x08, 0xe3, 0xae, 0x87, 0xd1, 0x5a, 0x60, 0xae, 0x7c, 0xb7, 0x1c, 0xd2, 0x7c, 0xcb, 0xcb, 0x5c,
+	0xf9, 0x8e, 0x39, 0xa4, 0xf9, 0x12, 0xe7, 0xa8, 0xfc, 0x06, 0x38, 0x94, 0xfc, 0x0e, 0x80, 0x2e,
+	0x27, 0xb5, 0x76, 0xee, 0xec, 0xaa, 0x85, 0x8b, 0x55, 0xfb, 0xdf, 0xda, 0xc1, 0xed, 0x5a, 0x3b,
+	0x81, 0x48, 0xaa, 0x7c, 0x3a, 0x47, 0x3f, 0x23, 0x16, 0x69, 0xc9, 0xed, 0x49, 0x2f, 0x9c, 0x91,
+	0xdc, 0xc1, 0x04, 0xe1, 0xb0, 0x9f, 0xe0, 0xdb, 0x56, 0x71, 0x2d, 0x93, 0x9b, 0xdb, 0xe5, 0x34,
+	0x4f, 0x61, 0xc8, 0xac, 0xcf, 0xa6, 0xdd, 0xf0, 0x7e, 0x67, 0x7f, 0x43, 0xb8, 0xe7, 0xe3, 0xbf,
+	0x61, 0x4d, 0xa5, 0x98, 0x20, 0xaf, 0x20, 0xba, 0x68, 0x2e, 0xd9, 0x0f, 0x24, 0xf4, 0x5a, 0x0f,
+	0x2d, 0xe5, 0x1e, 0x8f, 0x1f, 0xae, 0xb0, 0x58, 0xf9, 0x92, 0x2d, 0xf2, 0x0e, 0xf6, 0xae, 0x2f,
+	0x34, 0x39, 0xec, 0x8c, 0xc2, 0xd2, 0x4f, 0x16, 0x3f, 0x59, 0x6b, 0x5f, 0x84, 0xfc, 0x0c, 0xfb,
+	0x7d, 0x39, 0x48, 0xb2, 0x79, 0xc2, 0xe2, 0xa7, 0x37, 0xfa, 0x2c, 0xc2, 0x7f, 0x81, 0x83, 0x35,
+	0x6a, 0x93, 0xe7, 0x37, 0x44, 0xe8, 0x76, 0x24, 0x9e, 0x2c, 0xc9, 0xfd, 0x5a, 0xff, 0xfa, 0xc9,
+	0xd6, 0x34, 0x32, 0xcc, 0x8b, 0x7f, 0x03, 0x00, 0xbb, 0xee, 0x1d, 0x69, 0x32, 0x06, 0x00, 0x00,
 }
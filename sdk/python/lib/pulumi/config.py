@@ -20,6 +20,7 @@ import six
 
 from . import errors
 from .runtime.config import get_config
+from .runtime.secret import Secret
 from .metadata import get_project
 
 class Config(object):
@@ -45,6 +46,16 @@ class Config(object):
         """
         return get_config(self.full_key(key))
 
+    def get_secret(self, key):
+        """
+        Returns an optional configuration value by its key, wrapped as a secret, or None if it doesn't exist.  The
+        value is encrypted in checkpoints and masked in the display wherever it is used as a resource property.
+        """
+        v = self.get(key)
+        if v is None:
+            return None
+        return Secret(v)
+
     def get_bool(self, key):
         """
         Returns an optional configuration value, as a bool, by its key, or None if it doesn't exist.
@@ -95,6 +106,13 @@ class Config(object):
             raise ConfigMissingError(self.full_key(key))
         return v
 
+    def require_secret(self, key):
+        """
+        Returns a configuration value, wrapped as a secret, by its given key.  If it doesn't exist, an error is thrown.
+        The value is encrypted in checkpoints and masked in the display wherever it is used as a resource property.
+        """
+        return Secret(self.require(key))
+
     def require_bool(self, key):
         """
         Returns a configuration value, as a bool, by its given key.  If it doesn't exist, or the
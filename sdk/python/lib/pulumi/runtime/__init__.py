@@ -21,5 +21,6 @@ from __future__ import absolute_import
 from .config import *
 from .resource import *
 from .rpc import *
+from .secret import *
 from .settings import *
 from .stack import *
@@ -21,6 +21,7 @@ import six
 from six.moves import map
 
 from google.protobuf import struct_pb2
+from .secret import Secret
 from .unknown import Unknown
 from . import known_types
 
@@ -36,6 +37,9 @@ _special_asset_sig = "c44067f5952c0a294b673a41bacd8c17"
 _special_archive_sig = "0def7320c3a5731c473e5ecbe6d01bc7"
 """specialArchiveSig is a randomly assigned hash used to identify assets in maps.  See pkg/resource/asset.go."""
 
+_special_secret_sig = "1b47061264138c4ac30d75fd1eb44270"
+"""specialSecretSig is a randomly assigned hash used to identify secrets in maps.  See pkg/resource/properties.go."""
+
 def serialize_resource_props(props):
     """
     Serializes resource properties so that they are ready for marshaling to the gRPC endpoint.
@@ -63,6 +67,13 @@ def serialize_resource_value(value):
     elif isinstance(value, Unknown):
         # Serialize instances of Unknown as the UNKNOWN guid
         return UNKNOWN
+    elif isinstance(value, Secret):
+        # Serialize secrets by wrapping the serialized value with the secret signature, so that the engine knows to
+        # encrypt it.
+        return {
+            _special_sig_key: _special_secret_sig,
+            "value": serialize_resource_value(value.value)
+        }
     elif known_types.is_asset(value):
         # Serializing an asset requires the use of a magical signature key, since otherwise it would look
         # like any old weakly typed object/map when received by the other side of the RPC boundary.
@@ -115,7 +126,10 @@ def deserialize_resource_props(props_struct):
     assert isinstance(props_struct, struct_pb2.Struct)
 
     if _special_sig_key in props_struct:
-        if props_struct[_special_sig_key] == _special_asset_sig:
+        if props_struct[_special_sig_key] == _special_secret_sig:
+            # This is a secret.  Programs see its plain value.
+            return deserialize_property(props_struct["value"])
+        elif props_struct[_special_sig_key] == _special_asset_sig:
             # This is an asset. Re-hydrate this object into an Asset.
             if "path" in props_struct:
                 return known_types.new_file_asset(props_struct["path"])
@@ -0,0 +1,27 @@
+# Copyright 2016-2018, Pulumi Corporation.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+"""
+Support for marking values as secrets.
+"""
+from __future__ import absolute_import
+
+class Secret(object):
+    """
+    Secret wraps a value that is sensitive.  A secret resource property is sent to the engine marked as a secret, so
+    that its value is encrypted in checkpoints and masked in the display; it is otherwise used exactly like the value
+    it wraps.  Use pulumi.Config.get_secret or pulumi.Config.require_secret to read a secret configuration variable.
+    """
+    def __init__(self, value):
+        self.value = value
+        """The wrapped value."""
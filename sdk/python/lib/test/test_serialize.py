@@ -14,8 +14,8 @@
 
 import unittest
 from google.protobuf import struct_pb2
-from pulumi import CustomResource
-from pulumi.runtime import rpc, known_types, Unknown
+from pulumi import Config, CustomResource
+from pulumi.runtime import rpc, known_types, set_config, Secret, Unknown
 from pulumi.asset import FileAsset, StringAsset, RemoteAsset
 
 class PropertySerializeTests(unittest.TestCase):
@@ -98,6 +98,41 @@ class PropertySerializeTests(unittest.TestCase):
         self.assertEqual(rpc._special_asset_sig, asset[rpc._special_sig_key])
         self.assertEqual("https://pulumi.io", asset["uri"])
 
+    def test_secret(self):
+        """
+        Tests that we serialize values wrapped in Secret as secrets.
+        """
+        struct = rpc.serialize_resource_props({
+            "password": Secret("hunter2"),
+            "nested": {"values": [Secret("hunter2"), "public"]},
+            "public": "public",
+        })
+
+        # pylint: disable=unsubscriptable-object
+        secret = struct["password"]
+        self.assertEqual(rpc._special_secret_sig, secret[rpc._special_sig_key])
+        self.assertEqual("hunter2", secret["value"])
+        nested = struct["nested"]["values"]
+        self.assertEqual(rpc._special_secret_sig, nested[0][rpc._special_sig_key])
+        self.assertEqual("public", nested[1])
+        self.assertEqual("public", struct["public"])
+
+        deserialized = rpc.deserialize_resource_props(struct)
+        self.assertEqual("hunter2", deserialized["password"])
+        self.assertEqual(["hunter2", "public"], deserialized["nested"]["values"])
+
+    def test_secret_config_value(self):
+        """
+        Tests that secret configuration getters wrap their values as secrets.
+        """
+        set_config("test:password", "hunter2")
+        config = Config("test")
+        secret = config.require_secret("password")
+        self.assertTrue(isinstance(secret, Secret))
+        self.assertEqual("hunter2", secret.value)
+        self.assertEqual("hunter2", config.get_secret("password").value)
+        self.assertIsNone(config.get_secret("missing"))
+
 
 class FakeCustomResource(object):
     """